@@ -37,7 +37,7 @@ func FirstPostPage() templ.Component {
 
 		article := &schemaorg.Article{
 			Headline:      "First Post Headline",
-			Image:         []string{"https://placehold.co/600x400?text=JD"},
+			Image:         schemaorg.NewImages("https://placehold.co/600x400?text=JD"),
 			Author:        &schemaorg.Person{Name: "Jane Doe"},
 			Publisher:     &schemaorg.Organization{Name: "Example Publisher"},
 			DatePublished: "2024-09-15",
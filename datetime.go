@@ -0,0 +1,247 @@
+package teseo
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Date, DateTime and Duration are string based so that literals such as
+// "2024-09-15" can still be assigned directly to the typed fields, while
+// constructors accept time.Time and time.Duration values.
+
+// dateLayouts lists the ISO 8601 date layouts accepted by Date, from the most to the least precise.
+var dateLayouts = []string{
+	"2006-01-02",
+	"2006-01",
+	"2006",
+}
+
+// dateTimeLayouts lists the ISO 8601 date and time layouts accepted by DateTime,
+// with the offset in the extended (+02:00) or basic (+0200) format.
+var dateTimeLayouts = []string{
+	time.RFC3339Nano,
+	time.RFC3339,
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04:05.999999999-0700",
+	"2006-01-02T15:04-0700",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// isoDurationRe matches an ISO 8601 duration such as "P1DT2H30M" or "PT90.5S".
+var isoDurationRe = regexp.MustCompile(`^P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)W)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+
+// Date represents an ISO 8601 calendar date (e.g. "2024-09-15").
+type Date string
+
+// NewDate formats t as an ISO 8601 date.
+func NewDate(t time.Time) Date {
+	return Date(t.Format("2006-01-02"))
+}
+
+// String returns the date as a string.
+func (d Date) String() string {
+	return string(d)
+}
+
+// Time parses the date into a time.Time.
+func (d Date) Time() (time.Time, error) {
+	s := strings.TrimSpace(string(d))
+	for _, layout := range dateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid ISO 8601 date %q", string(d))
+}
+
+// IsValid reports whether the value is empty or a well-formed ISO 8601 date.
+func (d Date) IsValid() bool {
+	if d == "" {
+		return true
+	}
+	_, err := d.Time()
+	return err == nil
+}
+
+// UnmarshalJSON stores the date as given. Malformed values are kept so that
+// IsValid, and the validators using it, can report them.
+func (d *Date) UnmarshalJSON(data []byte) error {
+	*d = Date(rawString(data))
+	return nil
+}
+
+// DateTime represents an ISO 8601 date and time (e.g. "2024-09-15T09:00:00Z").
+// A plain date is also accepted, as allowed by properties such as datePublished.
+type DateTime string
+
+// NewDateTime formats t as an ISO 8601 date and time.
+func NewDateTime(t time.Time) DateTime {
+	return DateTime(t.Format(time.RFC3339))
+}
+
+// String returns the date and time as a string.
+func (dt DateTime) String() string {
+	return string(dt)
+}
+
+// Time parses the date and time into a time.Time. Values without a time zone are interpreted as UTC.
+func (dt DateTime) Time() (time.Time, error) {
+	s := strings.TrimSpace(string(dt))
+	for _, layout := range dateTimeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid ISO 8601 date-time %q", string(dt))
+}
+
+// IsValid reports whether the value is empty or a well-formed ISO 8601 date and time.
+func (dt DateTime) IsValid() bool {
+	if dt == "" {
+		return true
+	}
+	_, err := dt.Time()
+	return err == nil
+}
+
+// UnmarshalJSON stores the date and time as given. Malformed values are kept
+// so that IsValid, and the validators using it, can report them.
+func (dt *DateTime) UnmarshalJSON(data []byte) error {
+	*dt = DateTime(rawString(data))
+	return nil
+}
+
+// Duration represents a length of time. It holds either an ISO 8601 duration
+// (e.g. "PT2H30M", as used by Schema.org) or a whole number of seconds
+// (e.g. "9000", as used by OpenGraph). Both forms are rendered in the format
+// expected by each output: ISO 8601 in JSON-LD and seconds in meta tags.
+type Duration string
+
+// NewDuration formats d as an ISO 8601 duration.
+func NewDuration(d time.Duration) Duration {
+	return Duration(formatISODuration(d))
+}
+
+// String returns the duration as given.
+func (d Duration) String() string {
+	return string(d)
+}
+
+// Duration parses the value into a time.Duration.
+// Years and months are approximated as 365 and 30 days.
+func (d Duration) Duration() (time.Duration, error) {
+	s := strings.TrimSpace(string(d))
+	if secs, err := strconv.ParseInt(s, 10, 64); err == nil && secs >= 0 {
+		return time.Duration(secs) * time.Second, nil
+	}
+
+	m := isoDurationRe.FindStringSubmatch(s)
+	if m == nil || s == "P" || strings.HasSuffix(s, "T") {
+		return 0, fmt.Errorf("invalid duration %q", string(d))
+	}
+
+	units := []time.Duration{
+		365 * 24 * time.Hour,
+		30 * 24 * time.Hour,
+		7 * 24 * time.Hour,
+		24 * time.Hour,
+		time.Hour,
+		time.Minute,
+	}
+	var total time.Duration
+	for i, unit := range units {
+		if m[i+1] == "" {
+			continue
+		}
+		n, _ := strconv.ParseInt(m[i+1], 10, 64)
+		total += time.Duration(n) * unit
+	}
+	if m[7] != "" {
+		secs, _ := strconv.ParseFloat(m[7], 64)
+		total += time.Duration(secs * float64(time.Second))
+	}
+	return total, nil
+}
+
+// IsValid reports whether the value is empty or a well-formed duration.
+func (d Duration) IsValid() bool {
+	if d == "" {
+		return true
+	}
+	_, err := d.Duration()
+	return err == nil
+}
+
+// ISO8601 returns the duration as an ISO 8601 string, or the raw value if it cannot be parsed.
+func (d Duration) ISO8601() string {
+	v, err := d.Duration()
+	if err != nil || isoDurationRe.MatchString(strings.TrimSpace(string(d))) {
+		return strings.TrimSpace(string(d))
+	}
+	return formatISODuration(v)
+}
+
+// Seconds returns the duration as a whole number of seconds, or the raw value if it cannot be parsed.
+func (d Duration) Seconds() string {
+	v, err := d.Duration()
+	if err != nil {
+		return string(d)
+	}
+	return strconv.FormatInt(int64(v/time.Second), 10)
+}
+
+// MarshalJSON encodes the duration in its ISO 8601 form.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(d.ISO8601())
+}
+
+// UnmarshalJSON stores the duration as given, either an ISO 8601 duration or a
+// number of seconds. Malformed values are kept so that IsValid, and the
+// validators using it, can report them.
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	*d = Duration(rawString(data))
+	return nil
+}
+
+// rawString returns the trimmed value of a JSON string, the JSON text of any
+// other value, e.g. "5400" for a number, or "" for null.
+func rawString(data []byte) string {
+	var s string
+	if err := json.Unmarshal(data, &s); err == nil {
+		return strings.TrimSpace(s)
+	}
+	text := strings.TrimSpace(string(data))
+	if text == "null" {
+		return ""
+	}
+	return text
+}
+
+// formatISODuration formats d as an ISO 8601 duration using hours, minutes and seconds.
+func formatISODuration(d time.Duration) string {
+	if d <= 0 {
+		return "PT0S"
+	}
+
+	var b strings.Builder
+	b.WriteString("PT")
+	if h := d / time.Hour; h > 0 {
+		fmt.Fprintf(&b, "%dH", h)
+		d -= h * time.Hour
+	}
+	if m := d / time.Minute; m > 0 {
+		fmt.Fprintf(&b, "%dM", m)
+		d -= m * time.Minute
+	}
+	if d > 0 {
+		b.WriteString(strconv.FormatFloat(d.Seconds(), 'f', -1, 64))
+		b.WriteString("S")
+	}
+	return b.String()
+}
@@ -0,0 +1,210 @@
+package teseo
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestNewDate(t *testing.T) {
+	d := NewDate(time.Date(2024, 9, 15, 10, 30, 0, 0, time.UTC))
+	if d != "2024-09-15" {
+		t.Errorf("expected 2024-09-15, got %s", d)
+	}
+}
+
+func TestNewDateTime(t *testing.T) {
+	loc := time.FixedZone("CEST", 2*60*60)
+	dt := NewDateTime(time.Date(2024, 9, 15, 10, 30, 0, 0, loc))
+	if dt != "2024-09-15T10:30:00+02:00" {
+		t.Errorf("expected 2024-09-15T10:30:00+02:00, got %s", dt)
+	}
+}
+
+func TestDate_IsValid(t *testing.T) {
+	tests := []struct {
+		value Date
+		want  bool
+	}{
+		{"", true},
+		{"2024-09-15", true},
+		{"2024-09", true},
+		{"2024", true},
+		{"2024-13-01", false},
+		{"15/09/2024", false},
+		{"2024-09-15T10:00:00Z", false},
+	}
+	for _, tt := range tests {
+		if got := tt.value.IsValid(); got != tt.want {
+			t.Errorf("Date(%q).IsValid() = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestDateTime_IsValid(t *testing.T) {
+	tests := []struct {
+		value DateTime
+		want  bool
+	}{
+		{"", true},
+		{"2024-09-15T10:00:00Z", true},
+		{"2024-09-15T10:00:00.123+02:00", true},
+		{"2024-09-15T10:00+02:00", true},
+		{"2024-09-15T10:00:00+0200", true},
+		{"2024-09-15T10:00:00.5-0530", true},
+		{"2024-09-15T10:00+0200", true},
+		{"2024-09-15T10:00:00", true},
+		{"2024-09-15T10:00", true},
+		{"2024-09-15", true},
+		{"2024-09-15 10:00", false},
+		{"yesterday", false},
+	}
+	for _, tt := range tests {
+		if got := tt.value.IsValid(); got != tt.want {
+			t.Errorf("DateTime(%q).IsValid() = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestDateTime_Time(t *testing.T) {
+	got, err := DateTime("2024-09-15T10:00:00+02:00").Time()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := time.Date(2024, 9, 15, 8, 0, 0, 0, time.UTC)
+	if !got.Equal(want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestNewDuration(t *testing.T) {
+	tests := []struct {
+		in   time.Duration
+		want Duration
+	}{
+		{0, "PT0S"},
+		{2*time.Hour + 30*time.Minute, "PT2H30M"},
+		{90 * time.Second, "PT1M30S"},
+		{1500 * time.Millisecond, "PT1.5S"},
+		{26 * time.Hour, "PT26H"},
+	}
+	for _, tt := range tests {
+		if got := NewDuration(tt.in); got != tt.want {
+			t.Errorf("NewDuration(%v) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestDuration_Duration(t *testing.T) {
+	tests := []struct {
+		value   Duration
+		want    time.Duration
+		wantErr bool
+	}{
+		{"PT2H30M", 2*time.Hour + 30*time.Minute, false},
+		{"P1DT1H", 25 * time.Hour, false},
+		{"P1W", 7 * 24 * time.Hour, false},
+		{"PT1.5S", 1500 * time.Millisecond, false},
+		{"7200", 2 * time.Hour, false},
+		{"P", 0, true},
+		{"PT", 0, true},
+		{"2h", 0, true},
+		{"-10", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := tt.value.Duration()
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Duration(%q): unexpected error state: %v", tt.value, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("Duration(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestDuration_Formats(t *testing.T) {
+	tests := []struct {
+		value   Duration
+		iso     string
+		seconds string
+	}{
+		{"7200", "PT2H", "7200"},
+		{"PT2H", "PT2H", "7200"},
+		{"P1D", "P1D", "86400"},
+		{"", "", ""},
+		{"invalid", "invalid", "invalid"},
+	}
+	for _, tt := range tests {
+		if got := tt.value.ISO8601(); got != tt.iso {
+			t.Errorf("Duration(%q).ISO8601() = %q, want %q", tt.value, got, tt.iso)
+		}
+		if got := tt.value.Seconds(); got != tt.seconds {
+			t.Errorf("Duration(%q).Seconds() = %q, want %q", tt.value, got, tt.seconds)
+		}
+	}
+}
+
+func TestTypes_MarshalJSON(t *testing.T) {
+	v := struct {
+		Date     Date     `json:"date"`
+		DateTime DateTime `json:"dateTime"`
+		Duration Duration `json:"duration"`
+	}{"2024-09-15", "2024-09-15T10:00:00Z", "5400"}
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `{"date":"2024-09-15","dateTime":"2024-09-15T10:00:00Z","duration":"PT1H30M"}`
+	if string(data) != expected {
+		t.Errorf("expected %s, got %s", expected, data)
+	}
+}
+
+func TestTypes_UnmarshalJSON(t *testing.T) {
+	var v struct {
+		Date     Date     `json:"date"`
+		DateTime DateTime `json:"dateTime"`
+		Duration Duration `json:"duration"`
+	}
+	if err := json.Unmarshal([]byte(`{"date":"2024-09-15","dateTime":"2024-09-15T10:00:00Z","duration":"PT1H30M"}`), &v); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if v.Date != "2024-09-15" || v.DateTime != "2024-09-15T10:00:00Z" || v.Duration != "PT1H30M" {
+		t.Errorf("unexpected values: %+v", v)
+	}
+
+	if err := json.Unmarshal([]byte(`{"duration":5400}`), &v); err != nil || v.Duration != "5400" {
+		t.Errorf("expected numeric duration to be accepted, got %q (err: %v)", v.Duration, err)
+	}
+}
+
+func TestTypes_UnmarshalJSON_Malformed(t *testing.T) {
+	var v struct {
+		Date     Date     `json:"date"`
+		DateTime DateTime `json:"dateTime"`
+		Duration Duration `json:"duration"`
+	}
+	tests := []struct {
+		input    string
+		value    func() string
+		expected string
+	}{
+		{`{"date":"15/09/2024"}`, func() string { return string(v.Date) }, "15/09/2024"},
+		{`{"date":42}`, func() string { return string(v.Date) }, "42"},
+		{`{"dateTime":" tomorrow "}`, func() string { return string(v.DateTime) }, "tomorrow"},
+		{`{"duration":"two hours"}`, func() string { return string(v.Duration) }, "two hours"},
+	}
+	for _, tt := range tests {
+		if err := json.Unmarshal([]byte(tt.input), &v); err != nil {
+			t.Errorf("expected %s to decode, got %v", tt.input, err)
+		}
+		if got := tt.value(); got != tt.expected {
+			t.Errorf("expected %s to keep %q, got %q", tt.input, tt.expected, got)
+		}
+	}
+	if v.Date.IsValid() || v.DateTime.IsValid() || v.Duration.IsValid() {
+		t.Errorf("expected the malformed values to be reported as invalid: %+v", v)
+	}
+}
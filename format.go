@@ -0,0 +1,233 @@
+package teseo
+
+import (
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strings"
+)
+
+// URLOptions configures how URL fields are validated.
+type URLOptions struct {
+	AllowRelative bool   // accept relative URLs such as "/images/logo.png"
+	BaseURL       string // absolute URL relative URLs are resolved against before being checked
+}
+
+// CheckURL returns an issue when value is not an absolute http(s) URL. Empty
+// values are not checked. Use URLOptions.CheckURL to accept relative URLs.
+func CheckURL(path, value string) *ValidationIssue {
+	return URLOptions{}.CheckURL(path, value)
+}
+
+// CheckURL returns an issue when value is not an http(s) URL with a host.
+// Relative URLs are accepted when AllowRelative is set, or when BaseURL is set
+// and the URL resolved against it is valid. Empty values are not checked.
+func (o URLOptions) CheckURL(path, value string) *ValidationIssue {
+	if value == "" || o.isValidURL(value) {
+		return nil
+	}
+	return formatIssue(path, RuleInvalidURL, value, fmt.Sprintf("invalid URL for %s: %q", path, value))
+}
+
+// ResolveURL resolves ref against BaseURL. ref is returned unchanged when it
+// is absolute, when BaseURL is empty or when either cannot be parsed.
+func (o URLOptions) ResolveURL(ref string) string {
+	if o.BaseURL == "" || ref == "" {
+		return ref
+	}
+	baseURL, err := url.Parse(o.BaseURL)
+	if err != nil || !baseURL.IsAbs() {
+		return ref
+	}
+	refURL, err := url.Parse(ref)
+	if err != nil || refURL.IsAbs() {
+		return ref
+	}
+	return baseURL.ResolveReference(refURL).String()
+}
+
+// Issues returns the issues of v, checking its URL fields with the options
+// instead of requiring absolute URLs:
+//
+//	opts := teseo.URLOptions{BaseURL: "https://www.example.com"}
+//	issues := opts.Issues(product)
+func (o URLOptions) Issues(v Validator) []ValidationIssue {
+	var issues []ValidationIssue
+	for _, issue := range v.ValidationIssues() {
+		if issue.Rule == RuleInvalidURL && o.CheckURL(issue.Path, issue.Value) == nil {
+			continue
+		}
+		issues = append(issues, issue)
+	}
+	return issues
+}
+
+// CheckEmail returns an issue when value is not a valid email address, with or
+// without the "mailto:" scheme. Empty values are not checked.
+func CheckEmail(path, value string) *ValidationIssue {
+	if value == "" {
+		return nil
+	}
+	address := strings.TrimPrefix(value, "mailto:")
+	if addr, err := mail.ParseAddress(address); err == nil && addr.Address == address {
+		return nil
+	}
+	return formatIssue(path, RuleInvalidEmail, value, fmt.Sprintf("invalid email for %s: %q", path, value))
+}
+
+// CheckTelephone returns an issue when value is not a telephone number in
+// international format: an optional leading "+" and 7 to 15 digits, optionally
+// separated by spaces, dashes, dots or parentheses, e.g. "+1-800-555-1212".
+// Empty values are not checked.
+func CheckTelephone(path, value string) *ValidationIssue {
+	if value == "" || isValidTelephone(value) {
+		return nil
+	}
+	return formatIssue(path, RuleInvalidTelephone, value, fmt.Sprintf("invalid telephone number for %s: %q", path, value))
+}
+
+// CheckCurrency returns an issue when value is not an ISO 4217 currency code,
+// e.g. "USD". Empty values are not checked.
+func CheckCurrency(path, value string) *ValidationIssue {
+	if value == "" || currencyCodes[value] {
+		return nil
+	}
+	return formatIssue(path, RuleInvalidCurrency, value, fmt.Sprintf("invalid ISO 4217 currency code for %s: %q", path, value))
+}
+
+// CheckCountry returns an issue when value is not an ISO 3166-1 alpha-2 country
+// code, e.g. "US". Empty values are not checked.
+func CheckCountry(path, value string) *ValidationIssue {
+	if value == "" || countryCodes[value] {
+		return nil
+	}
+	return formatIssue(path, RuleInvalidCountry, value, fmt.Sprintf("invalid ISO 3166-1 country code for %s: %q", path, value))
+}
+
+// CheckDate returns an issue when value is not an ISO 8601 date. Empty values
+// are not checked.
+func CheckDate(path string, value Date) *ValidationIssue {
+	if value.IsValid() {
+		return nil
+	}
+	return formatIssue(path, RuleInvalidDate, string(value), fmt.Sprintf("invalid ISO 8601 date for %s: %q", path, value))
+}
+
+// CheckDateTime returns an issue when value is not an ISO 8601 date or date and
+// time. Empty values are not checked.
+func CheckDateTime(path string, value DateTime) *ValidationIssue {
+	if value.IsValid() {
+		return nil
+	}
+	return formatIssue(path, RuleInvalidDate, string(value), fmt.Sprintf("invalid ISO 8601 date-time for %s: %q", path, value))
+}
+
+// CheckDuration returns an issue when value is neither an ISO 8601 duration nor
+// a number of seconds. Empty values are not checked.
+func CheckDuration(path string, value Duration) *ValidationIssue {
+	if value.IsValid() {
+		return nil
+	}
+	return formatIssue(path, RuleInvalidDuration, string(value), fmt.Sprintf("invalid duration for %s: %q", path, value))
+}
+
+// CheckTimeOrder returns an issue when end is before start. Empty or malformed
+// values are left to CheckDateTime.
+func CheckTimeOrder(startPath string, start DateTime, endPath string, end DateTime) *ValidationIssue {
+	if start == "" || end == "" {
+		return nil
+	}
+	s, err := start.Time()
+	if err != nil {
+		return nil
+	}
+	e, err := end.Time()
+	if err != nil || !e.Before(s) {
+		return nil
+	}
+	return formatIssue(startPath, RuleDateOrder, "", fmt.Sprintf("%s %q is before %s %q", endPath, end, startPath, start))
+}
+
+// formatIssue returns a recommended issue for an invalid field value.
+func formatIssue(path, rule, value, message string) *ValidationIssue {
+	return &ValidationIssue{Severity: SeverityRecommended, Path: path, Rule: rule, Value: value, Message: message}
+}
+
+// isValidURL reports whether value is an http(s) URL with a host, once resolved
+// against the base URL if relative.
+func (o URLOptions) isValidURL(value string) bool {
+	if strings.ContainsAny(value, " \t\r\n") {
+		return false
+	}
+	u, err := url.Parse(value)
+	if err != nil {
+		return false
+	}
+	if !u.IsAbs() {
+		if o.BaseURL == "" {
+			return o.AllowRelative && (u.Host != "" || u.Path != "" || u.RawQuery != "" || u.Fragment != "")
+		}
+		base, err := url.Parse(o.BaseURL)
+		if err != nil {
+			return false
+		}
+		u = base.ResolveReference(u)
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Hostname() != ""
+}
+
+// isValidTelephone reports whether value is a telephone number in international format.
+func isValidTelephone(value string) bool {
+	digits := 0
+	for i, r := range value {
+		switch {
+		case r >= '0' && r <= '9':
+			digits++
+		case r == '+':
+			if i != 0 {
+				return false
+			}
+		case r == ' ' || r == '-' || r == '.' || r == '(' || r == ')':
+		default:
+			return false
+		}
+	}
+	return digits >= 7 && digits <= 15
+}
+
+// codeSet returns the set of the space separated codes.
+func codeSet(codes string) map[string]bool {
+	set := make(map[string]bool)
+	for _, code := range strings.Fields(codes) {
+		set[code] = true
+	}
+	return set
+}
+
+// currencyCodes holds the ISO 4217 currency codes, including funds and precious metals.
+var currencyCodes = codeSet(`
+	AED AFN ALL AMD ANG AOA ARS AUD AWG AZN BAM BBD BDT BGN BHD BIF BMD BND BOB BOV
+	BRL BSD BTN BWP BYN BZD CAD CDF CHE CHF CHW CLF CLP CNY COP COU CRC CUC CUP CVE
+	CZK DJF DKK DOP DZD EGP ERN ETB EUR FJD FKP GBP GEL GHS GIP GMD GNF GTQ GYD HKD
+	HNL HTG HUF IDR ILS INR IQD IRR ISK JMD JOD JPY KES KGS KHR KMF KPW KRW KWD KYD
+	KZT LAK LBP LKR LRD LSL LYD MAD MDL MGA MKD MMK MNT MOP MRU MUR MVR MWK MXN MXV
+	MYR MZN NAD NGN NIO NOK NPR NZD OMR PAB PEN PGK PHP PKR PLN PYG QAR RON RSD RUB
+	RWF SAR SBD SCR SDG SEK SGD SHP SLE SLL SOS SRD SSP STN SVC SYP SZL THB TJS TMT
+	TND TOP TRY TTD TWD TZS UAH UGX USD USN UYI UYU UYW UZS VED VES VND VUV WST XAF
+	XAG XAU XBA XBB XBC XBD XCD XCG XDR XOF XPD XPF XPT XSU XTS XUA XXX YER ZAR ZMW
+	ZWG ZWL
+`)
+
+// countryCodes holds the ISO 3166-1 alpha-2 country codes.
+var countryCodes = codeSet(`
+	AD AE AF AG AI AL AM AO AQ AR AS AT AU AW AX AZ BA BB BD BE BF BG BH BI BJ BL BM
+	BN BO BQ BR BS BT BV BW BY BZ CA CC CD CF CG CH CI CK CL CM CN CO CR CU CV CW CX
+	CY CZ DE DJ DK DM DO DZ EC EE EG EH ER ES ET FI FJ FK FM FO FR GA GB GD GE GF GG
+	GH GI GL GM GN GP GQ GR GS GT GU GW GY HK HM HN HR HT HU ID IE IL IM IN IO IQ IR
+	IS IT JE JM JO JP KE KG KH KI KM KN KP KR KW KY KZ LA LB LC LI LK LR LS LT LU LV
+	LY MA MC MD ME MF MG MH MK ML MM MN MO MP MQ MR MS MT MU MV MW MX MY MZ NA NC NE
+	NF NG NI NL NO NP NR NU NZ OM PA PE PF PG PH PK PL PM PN PR PS PT PW PY QA RE RO
+	RS RU RW SA SB SC SD SE SG SH SI SJ SK SL SM SN SO SR SS ST SV SX SY SZ TC TD TF
+	TG TH TJ TK TL TM TN TO TR TT TV TW TZ UA UG UM US UY UZ VA VC VE VG VI VN VU WF WS
+	YE YT ZA ZM ZW
+`)
@@ -0,0 +1,190 @@
+package teseo
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestCheckURL(t *testing.T) {
+	tests := []struct {
+		name  string
+		opts  URLOptions
+		value string
+		valid bool
+	}{
+		{"empty", URLOptions{}, "", true},
+		{"https", URLOptions{}, "https://www.example.com/logo.png", true},
+		{"http with port", URLOptions{}, "http://localhost:8080/", true},
+		{"relative", URLOptions{}, "/images/logo.png", false},
+		{"other scheme", URLOptions{}, "ftp://example.com/file", false},
+		{"missing host", URLOptions{}, "https:///path", false},
+		{"with spaces", URLOptions{}, "https://www.example.com/my logo.png", false},
+		{"relative allowed", URLOptions{AllowRelative: true}, "/images/logo.png", true},
+		{"relative with base", URLOptions{BaseURL: "https://www.example.com/blog/"}, "images/logo.png", true},
+		{"relative with invalid base", URLOptions{BaseURL: "/blog/"}, "images/logo.png", false},
+		{"absolute with base", URLOptions{BaseURL: "https://www.example.com/"}, "mailto:info@example.com", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issue := tt.opts.CheckURL("url", tt.value)
+			if tt.valid && issue != nil {
+				t.Errorf("expected %q to be valid, got %v", tt.value, issue)
+			}
+			if !tt.valid && (issue == nil || issue.Rule != RuleInvalidURL || issue.Path != "url" || issue.Value != tt.value) {
+				t.Errorf("expected an invalid URL issue for %q, got %v", tt.value, issue)
+			}
+		})
+	}
+
+	if issue := CheckURL("url", "/images/logo.png"); issue == nil {
+		t.Errorf("expected CheckURL to require absolute URLs")
+	}
+}
+
+func TestURLOptions_ResolveURL(t *testing.T) {
+	if got := (URLOptions{}).ResolveURL("/logo.png"); got != "/logo.png" {
+		t.Errorf("expected the URL unchanged without a base URL, got %q", got)
+	}
+
+	opts := URLOptions{BaseURL: "https://www.example.com/blog/"}
+	tests := map[string]string{
+		"/logo.png":                 "https://www.example.com/logo.png",
+		"post-1":                    "https://www.example.com/blog/post-1",
+		"https://cdn.example.com/a": "https://cdn.example.com/a",
+	}
+	for ref, expected := range tests {
+		if got := opts.ResolveURL(ref); got != expected {
+			t.Errorf("ResolveURL(%q): expected %q, got %q", ref, expected, got)
+		}
+	}
+}
+
+func TestURLOptions_Issues(t *testing.T) {
+	v := &mockValidator{issues: []ValidationIssue{
+		*CheckURL("logo", "/logo.png"),
+		*CheckURL("url", "ftp://example.com/"),
+		*CheckEmail("email", "jane"),
+	}}
+
+	opts := URLOptions{BaseURL: "https://www.example.com"}
+	var paths []string
+	for _, issue := range opts.Issues(v) {
+		paths = append(paths, issue.Path)
+	}
+	if expected := []string{"url", "email"}; !slices.Equal(paths, expected) {
+		t.Errorf("expected the relative URL to be resolved against the base URL, got issues for %v", paths)
+	}
+	if got := (URLOptions{}).Issues(v); len(got) != 3 {
+		t.Errorf("expected the zero options to keep every issue, got %v", got)
+	}
+}
+
+func TestCheckEmail(t *testing.T) {
+	tests := map[string]bool{
+		"":                        true,
+		"jane@example.com":        true,
+		"mailto:jane@example.com": true,
+		"jane.example.com":        false,
+		"Jane <jane@example.com>": false,
+		"jane@":                   false,
+	}
+	for value, valid := range tests {
+		issue := CheckEmail("email", value)
+		if valid != (issue == nil) {
+			t.Errorf("CheckEmail(%q): expected valid %v, got %v", value, valid, issue)
+		}
+		if issue != nil && issue.Rule != RuleInvalidEmail {
+			t.Errorf("CheckEmail(%q): expected rule %q, got %q", value, RuleInvalidEmail, issue.Rule)
+		}
+	}
+}
+
+func TestCheckTelephone(t *testing.T) {
+	tests := map[string]bool{
+		"":                   true,
+		"+1-800-555-1212":    true,
+		"+44 20 7946 0958":   true,
+		"(555) 010.0100":     true,
+		"+1-800":             false,
+		"1-800-FLOWERS":      false,
+		"+1+800-555-1212":    false,
+		"+1234567890123456":  false,
+		"call +1 800 555 12": false,
+	}
+	for value, valid := range tests {
+		issue := CheckTelephone("telephone", value)
+		if valid != (issue == nil) {
+			t.Errorf("CheckTelephone(%q): expected valid %v, got %v", value, valid, issue)
+		}
+		if issue != nil && issue.Rule != RuleInvalidTelephone {
+			t.Errorf("CheckTelephone(%q): expected rule %q, got %q", value, RuleInvalidTelephone, issue.Rule)
+		}
+	}
+}
+
+func TestCheckCurrencyAndCountry(t *testing.T) {
+	if issue := CheckCurrency("priceCurrency", "EUR"); issue != nil {
+		t.Errorf("expected EUR to be valid, got %v", issue)
+	}
+	for _, value := range []string{"usd", "US$", "DOLLAR"} {
+		issue := CheckCurrency("priceCurrency", value)
+		if issue == nil || issue.Rule != RuleInvalidCurrency {
+			t.Errorf("expected an invalid currency issue for %q, got %v", value, issue)
+		}
+	}
+
+	if issue := CheckCountry("addressCountry", "VN"); issue != nil {
+		t.Errorf("expected VN to be valid, got %v", issue)
+	}
+	for _, value := range []string{"USA", "us", "United States", "XX"} {
+		issue := CheckCountry("addressCountry", value)
+		if issue == nil || issue.Rule != RuleInvalidCountry {
+			t.Errorf("expected an invalid country issue for %q, got %v", value, issue)
+		}
+	}
+
+	expected := `invalid ISO 4217 currency code for offers.priceCurrency: "usd"`
+	if issue := CheckCurrency("offers.priceCurrency", "usd"); issue.Message != expected || issue.Severity != SeverityRecommended {
+		t.Errorf("expected %q, got %v", expected, issue)
+	}
+}
+
+func TestCheckDateTimeAndDuration(t *testing.T) {
+	if issue := CheckDate("birthDate", "1990-05"); issue != nil {
+		t.Errorf("expected a valid date, got %v", issue)
+	}
+	if issue := CheckDate("birthDate", "May 1990"); issue == nil || issue.Rule != RuleInvalidDate || issue.Message != `invalid ISO 8601 date for birthDate: "May 1990"` {
+		t.Errorf("expected an invalid date issue, got %v", issue)
+	}
+
+	if issue := CheckDateTime("article:published_time", "2024-09-15T10:00:00Z"); issue != nil {
+		t.Errorf("expected a valid date-time, got %v", issue)
+	}
+	issue := CheckDateTime("article:published_time", "not-a-date")
+	if issue == nil || issue.Rule != RuleInvalidDate || issue.Message != `invalid ISO 8601 date-time for article:published_time: "not-a-date"` {
+		t.Errorf("expected an invalid date issue, got %v", issue)
+	}
+
+	for _, value := range []Duration{"", "PT2H", "5400"} {
+		if issue := CheckDuration("video:duration", value); issue != nil {
+			t.Errorf("expected %q to be valid, got %v", value, issue)
+		}
+	}
+	if issue := CheckDuration("video:duration", "2 hours"); issue == nil || issue.Rule != RuleInvalidDuration {
+		t.Errorf("expected an invalid duration issue, got %v", issue)
+	}
+}
+
+func TestCheckTimeOrder(t *testing.T) {
+	issue := CheckTimeOrder("event:start_date", "2024-09-15T18:00:00Z", "event:end_date", "2024-09-10T18:00:00Z")
+	expected := `event:end_date "2024-09-10T18:00:00Z" is before event:start_date "2024-09-15T18:00:00Z"`
+	if issue == nil || issue.Rule != RuleDateOrder || issue.Message != expected {
+		t.Errorf("expected %q, got %v", expected, issue)
+	}
+	for _, end := range []DateTime{"", "2024-09-16", "not-a-date"} {
+		if issue := CheckTimeOrder("event:start_date", "2024-09-15T18:00:00Z", "event:end_date", end); issue != nil {
+			t.Errorf("expected no issue for end %q, got %v", end, issue)
+		}
+	}
+}
@@ -75,12 +75,12 @@ import (
 //	<meta property="article:tag" content="example"/>
 type Article struct {
 	OpenGraphObject
-	PublishedTime  string   // article:published_time, the time the article was first published
-	ModifiedTime   string   // article:modified_time, the time the article was last modified
-	ExpirationTime string   // article:expiration_time, the time the article will expire
-	Author         []string // article:author, URLs to the authors of the article
-	Section        string   // article:section, a high-level section name
-	Tag            []string // article:tag, tags of the article
+	PublishedTime  teseo.DateTime // article:published_time, the time the article was first published
+	ModifiedTime   teseo.DateTime // article:modified_time, the time the article was last modified
+	ExpirationTime teseo.DateTime // article:expiration_time, the time the article will expire
+	Author         []string       // article:author, URLs to the authors of the article
+	Section        string         // article:section, a high-level section name
+	Tag            []string       // article:tag, tags of the article
 }
 
 // NewArticle initializes an Article with the default type "article".
@@ -92,9 +92,9 @@ func NewArticle(title, url, description, image, publishedTime, modifiedTime, exp
 			Description: description,
 			Image:       image,
 		},
-		PublishedTime:  publishedTime,
-		ModifiedTime:   modifiedTime,
-		ExpirationTime: expirationTime,
+		PublishedTime:  teseo.DateTime(publishedTime),
+		ModifiedTime:   teseo.DateTime(modifiedTime),
+		ExpirationTime: teseo.DateTime(expirationTime),
 		Author:         author,
 		Section:        section,
 		Tag:            tags,
@@ -123,6 +123,22 @@ func (art *Article) ToGoHTMLMetaTags() (template.HTML, error) {
 	return teseo.RenderToHTML(art.ToMetaTags())
 }
 
+// Validate returns the messages of the issues found by ValidationIssues.
+func (art *Article) Validate() []string {
+	return issueMessages(art.ValidationIssues())
+}
+
+// ValidationIssues checks the basic metadata and the format of the article:author URLs and of the
+// publication times, which must not be modified before they are published.
+func (art *Article) ValidationIssues() []teseo.ValidationIssue {
+	return appendIssues(validateMetaTags(&art.OpenGraphObject, art.metaTags()),
+		teseo.CheckDateTime("article:published_time", art.PublishedTime),
+		teseo.CheckDateTime("article:modified_time", art.ModifiedTime),
+		teseo.CheckDateTime("article:expiration_time", art.ExpirationTime),
+		teseo.CheckTimeOrder("article:published_time", art.PublishedTime, "article:modified_time", art.ModifiedTime),
+	)
+}
+
 // ensureDefaults sets default values for the Article object.
 func (art *Article) ensureDefaults() {
 	art.OpenGraphObject.ensureDefaults("article")
@@ -136,9 +152,9 @@ func (art *Article) metaTags() []metaTag {
 		{"og:url", art.URL},
 		{"og:description", art.Description},
 		{"og:image", art.Image},
-		{"article:published_time", art.PublishedTime},
-		{"article:modified_time", art.ModifiedTime},
-		{"article:expiration_time", art.ExpirationTime},
+		{"article:published_time", art.PublishedTime.String()},
+		{"article:modified_time", art.ModifiedTime.String()},
+		{"article:expiration_time", art.ExpirationTime.String()},
 		{"article:section", art.Section},
 	}
 
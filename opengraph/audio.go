@@ -61,8 +61,8 @@ import (
 //	<meta property="music:musician" content="https://www.example.com/musicians/jane-doe"/>
 type Audio struct {
 	OpenGraphObject
-	Duration  string // music:duration, duration of the audio in seconds
-	ArtistURL string // music:musician, URL to the musician or artist
+	Duration  teseo.Duration // music:duration, duration of the audio in seconds
+	ArtistURL string         // music:musician, URL to the musician or artist
 }
 
 // NewAudio initializes an Audio with the default type "music.audio".
@@ -74,7 +74,7 @@ func NewAudio(title, url, description, image, duration, artistURL string) *Audio
 			Description: description,
 			Image:       image,
 		},
-		Duration:  duration,
+		Duration:  teseo.Duration(duration),
 		ArtistURL: artistURL,
 	}
 	audio.ensureDefaults()
@@ -101,6 +101,18 @@ func (audio *Audio) ToGoHTMLMetaTags() (template.HTML, error) {
 	return teseo.RenderToHTML(audio.ToMetaTags())
 }
 
+// Validate returns the messages of the issues found by ValidationIssues.
+func (audio *Audio) Validate() []string {
+	return issueMessages(audio.ValidationIssues())
+}
+
+// ValidationIssues checks the basic metadata and the format of the music:musician URL and duration.
+func (audio *Audio) ValidationIssues() []teseo.ValidationIssue {
+	return appendIssues(validateMetaTags(&audio.OpenGraphObject, audio.metaTags()),
+		teseo.CheckDuration("music:duration", audio.Duration),
+	)
+}
+
 // ensureDefaults sets default values for Audio.
 func (audio *Audio) ensureDefaults() {
 	audio.OpenGraphObject.ensureDefaults("music.audio")
@@ -114,12 +126,12 @@ func (audio *Audio) metaTags() []metaTag {
 		{"og:url", audio.URL},
 		{"og:description", audio.Description},
 		{"og:image", audio.Image},
-		{"music:duration", audio.Duration},
+		{"music:duration", audio.Duration.Seconds()},
 		{"music:musician", audio.ArtistURL},
 	}
 
 	if audio.Duration != "" {
-		tags = append(tags, metaTag{"music:duration", audio.Duration})
+		tags = append(tags, metaTag{"music:duration", audio.Duration.Seconds()})
 	}
 	if audio.ArtistURL != "" {
 		tags = append(tags, metaTag{"music:musician", audio.ArtistURL})
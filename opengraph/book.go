@@ -69,10 +69,10 @@ import (
 //	<meta property="book:tag" content="example"/>
 type Book struct {
 	OpenGraphObject
-	Author      []string // book:author, URLs to the authors of the book
-	ISBN        string   // book:isbn, ISBN number of the book
-	ReleaseDate string   // book:release_date, the release date of the book
-	Tag         []string // book:tag, tags for the book
+	Author      []string       // book:author, URLs to the authors of the book
+	ISBN        string         // book:isbn, ISBN number of the book
+	ReleaseDate teseo.DateTime // book:release_date, the release date of the book
+	Tag         []string       // book:tag, tags for the book
 }
 
 // NewBook initializes a Book with the default type "book".
@@ -86,7 +86,7 @@ func NewBook(title, url, description, image, isbn, releaseDate string, author, t
 		},
 		Author:      author,
 		ISBN:        isbn,
-		ReleaseDate: releaseDate,
+		ReleaseDate: teseo.DateTime(releaseDate),
 		Tag:         tags,
 	}
 	book.ensureDefaults()
@@ -113,6 +113,18 @@ func (book *Book) ToGoHTMLMetaTags() (template.HTML, error) {
 	return teseo.RenderToHTML(book.ToMetaTags())
 }
 
+// Validate returns the messages of the issues found by ValidationIssues.
+func (book *Book) Validate() []string {
+	return issueMessages(book.ValidationIssues())
+}
+
+// ValidationIssues checks the basic metadata and the format of the book:author URLs and release date.
+func (book *Book) ValidationIssues() []teseo.ValidationIssue {
+	return appendIssues(validateMetaTags(&book.OpenGraphObject, book.metaTags()),
+		teseo.CheckDateTime("book:release_date", book.ReleaseDate),
+	)
+}
+
 // ensureDefaults sets default values for Book.
 func (book *Book) ensureDefaults() {
 	book.OpenGraphObject.ensureDefaults("book")
@@ -127,7 +139,7 @@ func (book *Book) metaTags() []metaTag {
 		{"og:description", book.Description},
 		{"og:image", book.Image},
 		{"book:isbn", book.ISBN},
-		{"book:release_date", book.ReleaseDate},
+		{"book:release_date", book.ReleaseDate.String()},
 	}
 
 	// Add book:author tags
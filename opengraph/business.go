@@ -131,6 +131,16 @@ func (bus *Business) ToGoHTMLMetaTags() (template.HTML, error) {
 	return teseo.RenderToHTML(bus.ToMetaTags())
 }
 
+// Validate returns the messages of the issues found by ValidationIssues.
+func (bus *Business) Validate() []string {
+	return issueMessages(bus.ValidationIssues())
+}
+
+// ValidationIssues checks the basic metadata and the format of the contact email, phone number and website.
+func (bus *Business) ValidationIssues() []teseo.ValidationIssue {
+	return validateMetaTags(&bus.OpenGraphObject, bus.metaTags())
+}
+
 // ensureDefaults sets default values for Business.
 func (bus *Business) ensureDefaults() {
 	bus.OpenGraphObject.ensureDefaults("business.business")
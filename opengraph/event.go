@@ -64,9 +64,9 @@ import (
 //	<meta property="event:location" content="Anytown Convention Center"/>
 type Event struct {
 	OpenGraphObject
-	StartDate string // event:start_date, the start date and time of the event
-	EndDate   string // event:end_date, the end date and time of the event
-	Location  string // event:location, the location of the event
+	StartDate teseo.DateTime // event:start_date, the start date and time of the event
+	EndDate   teseo.DateTime // event:end_date, the end date and time of the event
+	Location  string         // event:location, the location of the event
 }
 
 // NewEvent initializes an Event with the default type "event".
@@ -78,8 +78,8 @@ func NewEvent(title, url, description, image, startDate, endDate, location strin
 			Description: description,
 			Image:       image,
 		},
-		StartDate: startDate,
-		EndDate:   endDate,
+		StartDate: teseo.DateTime(startDate),
+		EndDate:   teseo.DateTime(endDate),
 		Location:  location,
 	}
 	event.ensureDefaults()
@@ -106,6 +106,21 @@ func (e *Event) ToGoHTMLMetaTags() (template.HTML, error) {
 	return teseo.RenderToHTML(e.ToMetaTags())
 }
 
+// Validate returns the messages of the issues found by ValidationIssues.
+func (e *Event) Validate() []string {
+	return issueMessages(e.ValidationIssues())
+}
+
+// ValidationIssues checks the basic metadata and the format of the start and end
+// dates, which must not end before they start.
+func (e *Event) ValidationIssues() []teseo.ValidationIssue {
+	return appendIssues(validateMetaTags(&e.OpenGraphObject, e.metaTags()),
+		teseo.CheckDateTime("event:start_date", e.StartDate),
+		teseo.CheckDateTime("event:end_date", e.EndDate),
+		teseo.CheckTimeOrder("event:start_date", e.StartDate, "event:end_date", e.EndDate),
+	)
+}
+
 // ensureDefaults sets default values for Event.
 func (e *Event) ensureDefaults() {
 	e.OpenGraphObject.ensureDefaults("event")
@@ -119,8 +134,8 @@ func (e *Event) metaTags() []metaTag {
 		{"og:url", e.URL},
 		{"og:description", e.Description},
 		{"og:image", e.Image},
-		{"event:start_date", e.StartDate},
-		{"event:end_date", e.EndDate},
+		{"event:start_date", e.StartDate.String()},
+		{"event:end_date", e.EndDate.String()},
 		{"event:location", e.Location},
 	}
 }
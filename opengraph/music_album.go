@@ -65,9 +65,9 @@ import (
 //	<meta property="music:musician" content="https://www.example.com/musicians/john-doe"/>
 type MusicAlbum struct {
 	OpenGraphObject
-	Musician    []string // music:musician, URLs to the musicians in the album
-	ReleaseDate string   // music:release_date, the release date of the album
-	Genre       string   // music:genre, genre of the album
+	Musician    []string       // music:musician, URLs to the musicians in the album
+	ReleaseDate teseo.DateTime // music:release_date, the release date of the album
+	Genre       string         // music:genre, genre of the album
 }
 
 // NewMusicAlbum initializes a MusicAlbum with the default type "music.album".
@@ -80,7 +80,7 @@ func NewMusicAlbum(title, url, description, image, releaseDate, genre string, mu
 			Image:       image,
 		},
 		Musician:    musician,
-		ReleaseDate: releaseDate,
+		ReleaseDate: teseo.DateTime(releaseDate),
 		Genre:       genre,
 	}
 	musicAlbum.ensureDefaults()
@@ -107,6 +107,18 @@ func (ma *MusicAlbum) ToGoHTMLMetaTags() (template.HTML, error) {
 	return teseo.RenderToHTML(ma.ToMetaTags())
 }
 
+// Validate returns the messages of the issues found by ValidationIssues.
+func (ma *MusicAlbum) Validate() []string {
+	return issueMessages(ma.ValidationIssues())
+}
+
+// ValidationIssues checks the basic metadata and the format of the music:musician URLs and release date.
+func (ma *MusicAlbum) ValidationIssues() []teseo.ValidationIssue {
+	return appendIssues(validateMetaTags(&ma.OpenGraphObject, ma.metaTags()),
+		teseo.CheckDateTime("music:release_date", ma.ReleaseDate),
+	)
+}
+
 // ensureDefaults sets default values for MusicAlbum.
 func (ma *MusicAlbum) ensureDefaults() {
 	ma.OpenGraphObject.ensureDefaults("music.album")
@@ -120,7 +132,7 @@ func (ma *MusicAlbum) metaTags() []metaTag {
 		{"og:url", ma.URL},
 		{"og:description", ma.Description},
 		{"og:image", ma.Image},
-		{"music:release_date", ma.ReleaseDate},
+		{"music:release_date", ma.ReleaseDate.String()},
 		{"music:genre", ma.Genre},
 	}
 
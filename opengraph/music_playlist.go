@@ -62,8 +62,8 @@ import (
 //	<meta property="music:duration" content="60"/>
 type MusicPlaylist struct {
 	OpenGraphObject
-	SongURLs []string // music:song, URLs to the songs in the playlist
-	Duration string   // music:duration, duration of the playlist in seconds
+	SongURLs []string       // music:song, URLs to the songs in the playlist
+	Duration teseo.Duration // music:duration, duration of the playlist in seconds
 }
 
 // NewMusicPlaylist initializes a MusicPlaylist with the default type "music.playlist".
@@ -76,7 +76,7 @@ func NewMusicPlaylist(title, url, description, image string, songURLs []string,
 			Image:       image,
 		},
 		SongURLs: songURLs,
-		Duration: duration,
+		Duration: teseo.Duration(duration),
 	}
 	musicPlaylist.ensureDefaults()
 	return musicPlaylist
@@ -102,6 +102,18 @@ func (mp *MusicPlaylist) ToGoHTMLMetaTags() (template.HTML, error) {
 	return teseo.RenderToHTML(mp.ToMetaTags())
 }
 
+// Validate returns the messages of the issues found by ValidationIssues.
+func (mp *MusicPlaylist) Validate() []string {
+	return issueMessages(mp.ValidationIssues())
+}
+
+// ValidationIssues checks the basic metadata and the format of the music:song URLs and duration.
+func (mp *MusicPlaylist) ValidationIssues() []teseo.ValidationIssue {
+	return appendIssues(validateMetaTags(&mp.OpenGraphObject, mp.metaTags()),
+		teseo.CheckDuration("music:duration", mp.Duration),
+	)
+}
+
 // ensureDefaults sets default values for MusicPlaylist.
 func (mp *MusicPlaylist) ensureDefaults() {
 	mp.OpenGraphObject.ensureDefaults("music.playlist")
@@ -115,7 +127,7 @@ func (mp *MusicPlaylist) metaTags() []metaTag {
 		{"og:url", mp.URL},
 		{"og:description", mp.Description},
 		{"og:image", mp.Image},
-		{"music:duration", mp.Duration},
+		{"music:duration", mp.Duration.Seconds()},
 	}
 
 	// Add music:song tags for each song URL
@@ -68,9 +68,9 @@ import (
 //	<meta property="music:musician" content="https://www.example.com/musicians/john-doe"/>
 type MusicSong struct {
 	OpenGraphObject
-	Duration     string   // music:duration, duration of the song in seconds
-	AlbumURL     string   // music:album, URL to the album
-	MusicianURLs []string // music:musician, URLs to the musicians
+	Duration     teseo.Duration // music:duration, duration of the song in seconds
+	AlbumURL     string         // music:album, URL to the album
+	MusicianURLs []string       // music:musician, URLs to the musicians
 }
 
 // NewMusicSong initializes a MusicSong with the default type "music.song".
@@ -82,7 +82,7 @@ func NewMusicSong(title, url, description, image, duration, albumURL string, mus
 			Description: description,
 			Image:       image,
 		},
-		Duration:     duration,
+		Duration:     teseo.Duration(duration),
 		AlbumURL:     albumURL,
 		MusicianURLs: musicianURLs,
 	}
@@ -110,6 +110,19 @@ func (ms *MusicSong) ToGoHTMLMetaTags() (template.HTML, error) {
 	return teseo.RenderToHTML(ms.ToMetaTags())
 }
 
+// Validate returns the messages of the issues found by ValidationIssues.
+func (ms *MusicSong) Validate() []string {
+	return issueMessages(ms.ValidationIssues())
+}
+
+// ValidationIssues checks the basic metadata and the format of the music:album and music:musician URLs
+// and duration.
+func (ms *MusicSong) ValidationIssues() []teseo.ValidationIssue {
+	return appendIssues(validateMetaTags(&ms.OpenGraphObject, ms.metaTags()),
+		teseo.CheckDuration("music:duration", ms.Duration),
+	)
+}
+
 // ensureDefaults sets default values for MusicSong.
 func (ms *MusicSong) ensureDefaults() {
 	ms.OpenGraphObject.ensureDefaults("music.song")
@@ -123,7 +136,7 @@ func (ms *MusicSong) metaTags() []metaTag {
 		{"og:url", ms.URL},
 		{"og:description", ms.Description},
 		{"og:image", ms.Image},
-		{"music:duration", ms.Duration},
+		{"music:duration", ms.Duration.Seconds()},
 		{"music:album", ms.AlbumURL},
 	}
 
@@ -101,6 +101,16 @@ func (p *Product) ToGoHTMLMetaTags() (template.HTML, error) {
 	return teseo.RenderToHTML(p.ToMetaTags())
 }
 
+// Validate returns the messages of the issues found by ValidationIssues.
+func (p *Product) Validate() []string {
+	return issueMessages(p.ValidationIssues())
+}
+
+// ValidationIssues checks the basic metadata and the format of the product:price:currency code.
+func (p *Product) ValidationIssues() []teseo.ValidationIssue {
+	return validateMetaTags(&p.OpenGraphObject, p.metaTags())
+}
+
 // ensureDefaults sets default values for Product.
 func (p *Product) ensureDefaults() {
 	p.OpenGraphObject.ensureDefaults("product")
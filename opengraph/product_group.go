@@ -104,6 +104,16 @@ func (pg *ProductGroup) ToGoHTMLMetaTags() (template.HTML, error) {
 	return teseo.RenderToHTML(pg.ToMetaTags())
 }
 
+// Validate returns the messages of the issues found by ValidationIssues.
+func (pg *ProductGroup) Validate() []string {
+	return issueMessages(pg.ValidationIssues())
+}
+
+// ValidationIssues checks the basic metadata and the format of the product:group_item URLs.
+func (pg *ProductGroup) ValidationIssues() []teseo.ValidationIssue {
+	return validateMetaTags(&pg.OpenGraphObject, pg.metaTags())
+}
+
 // ensureDefaults sets default values for ProductGroup.
 func (pg *ProductGroup) ensureDefaults() {
 	pg.OpenGraphObject.ensureDefaults("product.group")
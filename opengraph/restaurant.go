@@ -131,6 +131,16 @@ func (restaurant *Restaurant) ToGoHTMLMetaTags() (template.HTML, error) {
 	return teseo.RenderToHTML(restaurant.ToMetaTags())
 }
 
+// Validate returns the messages of the issues found by ValidationIssues.
+func (restaurant *Restaurant) Validate() []string {
+	return issueMessages(restaurant.ValidationIssues())
+}
+
+// ValidationIssues checks the basic metadata and the format of the phone number and the menu and reservation URLs.
+func (restaurant *Restaurant) ValidationIssues() []teseo.ValidationIssue {
+	return validateMetaTags(&restaurant.OpenGraphObject, restaurant.metaTags())
+}
+
 // ensureDefaults sets default values for Restaurant.
 func (restaurant *Restaurant) ensureDefaults() {
 	restaurant.OpenGraphObject.ensureDefaults("restaurant")
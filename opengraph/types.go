@@ -1,5 +1,11 @@
 package opengraph
 
+import (
+	"strings"
+
+	"github.com/indaco/teseo"
+)
+
 // OpenGraphObject represents common Open Graph metadata.
 // For more details about the meaning of the properties see: https://ogp.me/#metadata
 type OpenGraphObject struct {
@@ -17,6 +23,105 @@ func (og *OpenGraphObject) ensureDefaults(defaultType string) {
 	}
 }
 
+// Validate returns the messages of the issues found by ValidationIssues.
+func (og *OpenGraphObject) Validate() []string {
+	return issueMessages(og.ValidationIssues())
+}
+
+// ValidationIssues checks the basic metadata: og:title, og:url and og:image are
+// required for every page, og:description is recommended. og:type is set by
+// default when the meta tags are rendered. og:url and og:image must be absolute URLs.
+// For more details see: https://ogp.me/#metadata
+func (og *OpenGraphObject) ValidationIssues() []teseo.ValidationIssue {
+	var issues []teseo.ValidationIssue
+	required := []metaTag{{"og:title", og.Title}, {"og:url", og.URL}, {"og:image", og.Image}}
+	for _, tag := range required {
+		if tag.content == "" {
+			issues = append(issues, missingField(teseo.SeverityRequired, tag.property))
+		}
+	}
+	if og.Description == "" {
+		issues = append(issues, missingField(teseo.SeverityRecommended, "og:description"))
+	}
+	return appendFormatIssues(issues, []metaTag{{"og:url", og.URL}, {"og:image", og.Image}})
+}
+
+// formatChecks maps the properties holding URLs, emails, telephone numbers and
+// currency codes to the check of their format.
+var formatChecks = map[string]func(path, value string) *teseo.ValidationIssue{
+	"og:url":                             teseo.CheckURL,
+	"og:image":                           teseo.CheckURL,
+	"article:author":                     teseo.CheckURL,
+	"book:author":                        teseo.CheckURL,
+	"business:contact_data:website":      teseo.CheckURL,
+	"business:contact_data:email":        teseo.CheckEmail,
+	"business:contact_data:phone_number": teseo.CheckTelephone,
+	"music:album":                        teseo.CheckURL,
+	"music:musician":                     teseo.CheckURL,
+	"music:song":                         teseo.CheckURL,
+	"place:contact_data:phone_number":    teseo.CheckTelephone,
+	"product:group_item":                 teseo.CheckURL,
+	"product:price:currency":             teseo.CheckCurrency,
+	"restaurant:menu":                    teseo.CheckURL,
+	"restaurant:reservation":             teseo.CheckURL,
+	"video:actor":                        teseo.CheckURL,
+	"video:director":                     teseo.CheckURL,
+	"video:series":                       teseo.CheckURL,
+}
+
+// validateMetaTags returns the issues of the basic metadata and of the format
+// of the type-specific tags.
+func validateMetaTags(og *OpenGraphObject, tags []metaTag) []teseo.ValidationIssue {
+	var specific []metaTag
+	for _, tag := range tags {
+		if !strings.HasPrefix(tag.property, "og:") {
+			specific = append(specific, tag)
+		}
+	}
+	return appendFormatIssues(og.ValidationIssues(), specific)
+}
+
+// appendFormatIssues appends the issues for the tags whose content has an invalid format.
+func appendFormatIssues(issues []teseo.ValidationIssue, tags []metaTag) []teseo.ValidationIssue {
+	for _, tag := range tags {
+		if check, ok := formatChecks[tag.property]; ok {
+			if issue := check(tag.property, tag.content); issue != nil {
+				issues = append(issues, *issue)
+			}
+		}
+	}
+	return issues
+}
+
+// appendIssues appends the issues that are not nil.
+func appendIssues(issues []teseo.ValidationIssue, found ...*teseo.ValidationIssue) []teseo.ValidationIssue {
+	for _, issue := range found {
+		if issue != nil {
+			issues = append(issues, *issue)
+		}
+	}
+	return issues
+}
+
+// issueMessages returns the messages of the issues.
+func issueMessages(issues []teseo.ValidationIssue) []string {
+	var messages []string
+	for _, issue := range issues {
+		messages = append(messages, issue.Message)
+	}
+	return messages
+}
+
+// missingField returns the issue reported for a missing meta tag.
+func missingField(severity teseo.Severity, property string) teseo.ValidationIssue {
+	return teseo.ValidationIssue{
+		Severity: severity,
+		Path:     property,
+		Rule:     teseo.RuleMissingField,
+		Message:  "missing " + string(severity) + " field: " + property,
+	}
+}
+
 // metaTag represents a single Open Graph meta tag with a property and content.
 // Used internally to collect metadata before rendering as HTML <meta> elements.
 type metaTag struct {
@@ -0,0 +1,126 @@
+package opengraph
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/indaco/teseo"
+)
+
+func TestOpenGraphObject_ValidationIssues(t *testing.T) {
+	tests := []struct {
+		name     string
+		og       OpenGraphObject
+		expected []teseo.ValidationIssue
+	}{
+		{
+			name: "complete",
+			og:   OpenGraphObject{Title: "Title", URL: "https://www.example.com", Image: "https://www.example.com/image.jpg", Description: "Desc"},
+		},
+		{
+			name: "missing fields",
+			og:   OpenGraphObject{Title: "Title"},
+			expected: []teseo.ValidationIssue{
+				{Severity: teseo.SeverityRequired, Path: "og:url", Rule: teseo.RuleMissingField, Message: "missing required field: og:url"},
+				{Severity: teseo.SeverityRequired, Path: "og:image", Rule: teseo.RuleMissingField, Message: "missing required field: og:image"},
+				{Severity: teseo.SeverityRecommended, Path: "og:description", Rule: teseo.RuleMissingField, Message: "missing recommended field: og:description"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.og.ValidationIssues(); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestOpenGraphObject_Validate(t *testing.T) {
+	book := &Book{OpenGraphObject: OpenGraphObject{Title: "Title", URL: "https://www.example.com", Image: "https://www.example.com/image.jpg"}}
+	expected := []string{"missing recommended field: og:description"}
+	if got := book.Validate(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestToMetaTags_StrictMode(t *testing.T) {
+	article := NewArticle("Title", "", "", "", "", "", "", nil, "", nil)
+	var verr *teseo.ValidationError
+	if err := teseo.Strict(article, article.ToMetaTags()).Render(context.Background(), io.Discard); !errors.As(err, &verr) {
+		t.Fatalf("expected *teseo.ValidationError, got %v", err)
+	}
+	if len(verr.Issues) != 2 || verr.Issues[0].Path != "og:url" || verr.Issues[1].Path != "og:image" {
+		t.Errorf("unexpected issues %v", verr.Issues)
+	}
+
+	article.URL = "https://www.example.com"
+	article.Image = "https://www.example.com/image.jpg"
+	if err := teseo.Strict(article, article.ToMetaTags()).Render(context.Background(), io.Discard); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestValidationIssues_Formats(t *testing.T) {
+	og := OpenGraphObject{Title: "Title", URL: "https://www.example.com", Image: "/image.jpg", Description: "Desc"}
+	expected := []teseo.ValidationIssue{
+		{Severity: teseo.SeverityRecommended, Path: "og:image", Rule: teseo.RuleInvalidURL, Message: `invalid URL for og:image: "/image.jpg"`, Value: "/image.jpg"},
+	}
+	if got := og.ValidationIssues(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	business := &Business{OpenGraphObject: og, Email: "info.example.com", PhoneNumber: "+1-800-555-1212", Website: "www.example.com"}
+	expectedMessages := []string{
+		`invalid URL for og:image: "/image.jpg"`,
+		`invalid email for business:contact_data:email: "info.example.com"`,
+		`invalid URL for business:contact_data:website: "www.example.com"`,
+	}
+	if got := business.Validate(); !reflect.DeepEqual(got, expectedMessages) {
+		t.Errorf("expected %v, got %v", expectedMessages, got)
+	}
+
+	product := &Product{OpenGraphObject: og, Price: "10", PriceCurrency: "EURO"}
+	issues := product.ValidationIssues()
+	if len(issues) != 2 || issues[1].Path != "product:price:currency" || issues[1].Rule != teseo.RuleInvalidCurrency {
+		t.Errorf("expected an invalid currency issue, got %v", issues)
+	}
+
+	opts := teseo.URLOptions{BaseURL: "https://www.example.com"}
+	if got := opts.Issues(&og); got != nil {
+		t.Errorf("expected the relative og:image to be resolved against the base URL, got %v", got)
+	}
+}
+
+func TestValidationIssues_DatesAndDurations(t *testing.T) {
+	event := NewEvent("Event", "https://www.example.com/event", "Desc", "https://www.example.com/event.jpg", "2024-09-15T18:00:00Z", "2024-09-10T18:00:00Z", "Anytown")
+	expected := []string{`event:end_date "2024-09-10T18:00:00Z" is before event:start_date "2024-09-15T18:00:00Z"`}
+	if got := event.Validate(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	article := &Article{
+		OpenGraphObject: OpenGraphObject{Title: "Title", URL: "https://www.example.com", Image: "https://www.example.com/image.jpg"},
+		PublishedTime:   "not-a-date",
+	}
+	expected = []string{
+		"missing recommended field: og:description",
+		`invalid ISO 8601 date-time for article:published_time: "not-a-date"`,
+	}
+	if got := article.Validate(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	video := &Video{
+		OpenGraphObject: OpenGraphObject{Title: "Title", URL: "https://www.example.com", Image: "https://www.example.com/image.jpg", Description: "Desc"},
+		Duration:        "2 hours",
+		ReleaseDate:     "2024-09-15",
+	}
+	issues := video.ValidationIssues()
+	if len(issues) != 1 || issues[0].Path != "video:duration" || issues[0].Rule != teseo.RuleInvalidDuration {
+		t.Errorf("expected an invalid duration issue, got %v", issues)
+	}
+}
@@ -70,10 +70,10 @@ import (
 //	<meta property="video:release_date" content="2024-09-15"/>
 type Video struct {
 	OpenGraphObject
-	Duration    string   // video:duration, duration of the video in seconds
-	ActorURLs   []string // video:actor, URLs to the actors in the video
-	DirectorURL string   // video:director, URL to the director of the video
-	ReleaseDate string   // video:release_date, the release date of the video
+	Duration    teseo.Duration // video:duration, duration of the video in seconds
+	ActorURLs   []string       // video:actor, URLs to the actors in the video
+	DirectorURL string         // video:director, URL to the director of the video
+	ReleaseDate teseo.DateTime // video:release_date, the release date of the video
 }
 
 // NewVideo initializes a Video with the default type "video.movie".
@@ -85,10 +85,10 @@ func NewVideo(title, url, description, image, duration string, actorURLs []strin
 			Description: description,
 			Image:       image,
 		},
-		Duration:    duration,
+		Duration:    teseo.Duration(duration),
 		ActorURLs:   actorURLs,
 		DirectorURL: directorURL,
-		ReleaseDate: releaseDate,
+		ReleaseDate: teseo.DateTime(releaseDate),
 	}
 	video.ensureDefaults()
 	return video
@@ -114,6 +114,20 @@ func (video *Video) ToGoHTMLMetaTags() (template.HTML, error) {
 	return teseo.RenderToHTML(video.ToMetaTags())
 }
 
+// Validate returns the messages of the issues found by ValidationIssues.
+func (video *Video) Validate() []string {
+	return issueMessages(video.ValidationIssues())
+}
+
+// ValidationIssues checks the basic metadata and the format of the video:actor and video:director URLs,
+// duration and release date.
+func (video *Video) ValidationIssues() []teseo.ValidationIssue {
+	return appendIssues(validateMetaTags(&video.OpenGraphObject, video.metaTags()),
+		teseo.CheckDuration("video:duration", video.Duration),
+		teseo.CheckDateTime("video:release_date", video.ReleaseDate),
+	)
+}
+
 // ensureDefaults sets default values for Video.
 func (video *Video) ensureDefaults() {
 	video.OpenGraphObject.ensureDefaults("video.movie")
@@ -127,9 +141,9 @@ func (video *Video) metaTags() []metaTag {
 		{"og:url", video.URL},
 		{"og:description", video.Description},
 		{"og:image", video.Image},
-		{"video:duration", video.Duration},
+		{"video:duration", video.Duration.Seconds()},
 		{"video:director", video.DirectorURL},
-		{"video:release_date", video.ReleaseDate},
+		{"video:release_date", video.ReleaseDate.String()},
 	}
 
 	for _, actorURL := range video.ActorURLs {
@@ -75,12 +75,12 @@ import (
 //	<meta property="video:episode" content="1"/>
 type VideoEpisode struct {
 	OpenGraphObject
-	SeriesURL     string   // video:series, URL to the video series
-	Duration      string   // video:duration, duration of the episode in seconds
-	ActorURLs     []string // video:actor, URLs to the actors in the episode
-	DirectorURL   string   // video:director, URL to the director of the episode
-	ReleaseDate   string   // video:release_date, the release date of the episode
-	EpisodeNumber int      // video:episode, the episode number in the series
+	SeriesURL     string         // video:series, URL to the video series
+	Duration      teseo.Duration // video:duration, duration of the episode in seconds
+	ActorURLs     []string       // video:actor, URLs to the actors in the episode
+	DirectorURL   string         // video:director, URL to the director of the episode
+	ReleaseDate   teseo.DateTime // video:release_date, the release date of the episode
+	EpisodeNumber int            // video:episode, the episode number in the series
 }
 
 // NewVideoEpisode initializes a VideoEpisode with the default type "video.episode".
@@ -93,10 +93,10 @@ func NewVideoEpisode(title, url, description, image, duration, seriesURL string,
 			Image:       image,
 		},
 		SeriesURL:     seriesURL,
-		Duration:      duration,
+		Duration:      teseo.Duration(duration),
 		ActorURLs:     actorURLs,
 		DirectorURL:   directorURL,
-		ReleaseDate:   releaseDate,
+		ReleaseDate:   teseo.DateTime(releaseDate),
 		EpisodeNumber: episodeNumber,
 	}
 	videoEpisode.ensureDefaults()
@@ -123,6 +123,20 @@ func (ve *VideoEpisode) ToGoHTMLMetaTags() (template.HTML, error) {
 	return teseo.RenderToHTML(ve.ToMetaTags())
 }
 
+// Validate returns the messages of the issues found by ValidationIssues.
+func (ve *VideoEpisode) Validate() []string {
+	return issueMessages(ve.ValidationIssues())
+}
+
+// ValidationIssues checks the basic metadata and the format of the actor, director and series URLs,
+// duration and release date.
+func (ve *VideoEpisode) ValidationIssues() []teseo.ValidationIssue {
+	return appendIssues(validateMetaTags(&ve.OpenGraphObject, ve.metaTags()),
+		teseo.CheckDuration("video:duration", ve.Duration),
+		teseo.CheckDateTime("video:release_date", ve.ReleaseDate),
+	)
+}
+
 // ensureDefaults sets default values for VideoEpisode.
 func (ve *VideoEpisode) ensureDefaults() {
 	ve.OpenGraphObject.ensureDefaults("video.episode")
@@ -136,9 +150,9 @@ func (ve *VideoEpisode) metaTags() []metaTag {
 		{"og:url", ve.URL},
 		{"og:description", ve.Description},
 		{"og:image", ve.Image},
-		{"video:duration", ve.Duration},
+		{"video:duration", ve.Duration.Seconds()},
 		{"video:director", ve.DirectorURL},
-		{"video:release_date", ve.ReleaseDate},
+		{"video:release_date", ve.ReleaseDate.String()},
 		{"video:series", ve.SeriesURL},
 	}
 
@@ -68,10 +68,10 @@ import (
 //	<meta property="video:release_date" content="2024-09-15"/>
 type VideoMovie struct {
 	OpenGraphObject
-	Duration    string   // video:duration, duration of the movie in seconds
-	ActorURLs   []string // video:actor, URLs to the actors in the movie
-	DirectorURL string   // video:director, URL to the director of the movie
-	ReleaseDate string   // video:release_date, the release date of the movie
+	Duration    teseo.Duration // video:duration, duration of the movie in seconds
+	ActorURLs   []string       // video:actor, URLs to the actors in the movie
+	DirectorURL string         // video:director, URL to the director of the movie
+	ReleaseDate teseo.DateTime // video:release_date, the release date of the movie
 }
 
 // NewVideoMovie initializes a VideoMovie with the default type "video.movie".
@@ -83,10 +83,10 @@ func NewVideoMovie(title, url, description, image, duration string, actorURLs []
 			Description: description,
 			Image:       image,
 		},
-		Duration:    duration,
+		Duration:    teseo.Duration(duration),
 		ActorURLs:   actorURLs,
 		DirectorURL: directorURL,
-		ReleaseDate: releaseDate,
+		ReleaseDate: teseo.DateTime(releaseDate),
 	}
 	videoMovie.ensureDefaults()
 	return videoMovie
@@ -112,6 +112,20 @@ func (vm *VideoMovie) ToGoHTMLMetaTags() (template.HTML, error) {
 	return teseo.RenderToHTML(vm.ToMetaTags())
 }
 
+// Validate returns the messages of the issues found by ValidationIssues.
+func (vm *VideoMovie) Validate() []string {
+	return issueMessages(vm.ValidationIssues())
+}
+
+// ValidationIssues checks the basic metadata and the format of the video:actor and video:director URLs,
+// duration and release date.
+func (vm *VideoMovie) ValidationIssues() []teseo.ValidationIssue {
+	return appendIssues(validateMetaTags(&vm.OpenGraphObject, vm.metaTags()),
+		teseo.CheckDuration("video:duration", vm.Duration),
+		teseo.CheckDateTime("video:release_date", vm.ReleaseDate),
+	)
+}
+
 // ensureDefaults sets default values for VideoMovie.
 func (vm *VideoMovie) ensureDefaults() {
 	vm.OpenGraphObject.ensureDefaults("video.movie")
@@ -125,9 +139,9 @@ func (vm *VideoMovie) metaTags() []metaTag {
 		{"og:url", vm.URL},
 		{"og:description", vm.Description},
 		{"og:image", vm.Image},
-		{"video:duration", vm.Duration},
+		{"video:duration", vm.Duration.Seconds()},
 		{"video:director", vm.DirectorURL},
-		{"video:release_date", vm.ReleaseDate},
+		{"video:release_date", vm.ReleaseDate.String()},
 	}
 
 	for _, actorURL := range vm.ActorURLs {
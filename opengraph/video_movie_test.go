@@ -4,6 +4,9 @@ import (
 	"context"
 	"strings"
 	"testing"
+	"time"
+
+	"github.com/indaco/teseo"
 )
 
 func TestVideoMovie_metaTags_GeneratesCorrectTags(t *testing.T) {
@@ -106,3 +109,24 @@ func TestVideoMovie_ToGoHTMLMetaTags_Render(t *testing.T) {
 	assertContains(`<meta property="og:type" content="video.movie"`)
 	assertContains(`<meta property="video:actor" content="https://www.example.com/actors/john-doe"`)
 }
+
+func TestVideoMovie_metaTags_TypedValues(t *testing.T) {
+	video := &VideoMovie{
+		OpenGraphObject: OpenGraphObject{Title: "Example Movie"},
+		Duration:        teseo.NewDuration(2*time.Hour + 15*time.Minute),
+		ReleaseDate:     teseo.NewDateTime(time.Date(2024, 9, 15, 20, 0, 0, 0, time.UTC)),
+	}
+
+	tags := video.metaTags()
+	found := map[string]string{}
+	for _, tag := range tags {
+		found[tag.property] = tag.content
+	}
+
+	if found["video:duration"] != "8100" {
+		t.Errorf("expected video:duration to be rendered in seconds, got %q", found["video:duration"])
+	}
+	if found["video:release_date"] != "2024-09-15T20:00:00Z" {
+		t.Errorf("expected video:release_date to be ISO 8601, got %q", found["video:release_date"])
+	}
+}
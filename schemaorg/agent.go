@@ -0,0 +1,211 @@
+package schemaorg
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/indaco/teseo"
+)
+
+// Agent is implemented by the values accepted where Schema.org expects a Person
+// or an Organization, such as author, organizer, performer, creator and funder:
+// *Person, *Organization, *EducationalOrganization, *LocalBusiness, *NodeReference
+// and Agents. Decoded nodes of any other type are kept as *RawThing.
+//
+// Example usage:
+//
+//	article.Author = &schemaorg.Person{Name: "Jane Doe"}
+//
+//	article.Author = schemaorg.NewAgents(
+//		&schemaorg.Person{Name: "Jane Doe"},
+//		&schemaorg.Person{Name: "John Doe"},
+//	)
+//
+//	event.Organizer = &schemaorg.NodeReference{ID: "https://www.example.com/#organization"}
+type Agent interface {
+	ensureDefaults()
+	isAgent()
+}
+
+func (*Person) isAgent()                  {}
+func (*Organization) isAgent()            {}
+func (*EducationalOrganization) isAgent() {}
+func (*LocalBusiness) isAgent()           {}
+func (*RawThing) isAgent()                {}
+func (*NodeReference) isAgent()           {}
+func (Agents) isAgent()                   {}
+
+// NodeReference refers to a node described elsewhere in the page by its @id.
+type NodeReference struct {
+	ID string `json:"@id"`
+}
+
+// ensureDefaults is a no-op, a NodeReference has no default values.
+func (nr *NodeReference) ensureDefaults() {}
+
+// Agents holds multiple Person, Organization or @id reference nodes.
+// It is rendered as a JSON object when it holds a single node and as an array otherwise.
+type Agents []Agent
+
+// NewAgents groups the given agents, skipping nil values.
+func NewAgents(agents ...Agent) Agents {
+	var list Agents
+	for _, a := range agents {
+		list = append(list, agentList(a)...)
+	}
+	return list
+}
+
+// MarshalJSON renders a single agent as an object and multiple agents as an array.
+func (a Agents) MarshalJSON() ([]byte, error) {
+	if len(a) == 1 {
+		return json.Marshal(a[0])
+	}
+	return json.Marshal([]Agent(a))
+}
+
+// ensureDefaults sets default values for each agent in the list.
+func (a Agents) ensureDefaults() {
+	for _, agent := range agentList(a) {
+		agent.ensureDefaults()
+	}
+}
+
+// agentList flattens an Agent into its nodes, dropping nil values.
+func agentList(a Agent) []Agent {
+	if isNilAgent(a) {
+		return nil
+	}
+	list, ok := a.(Agents)
+	if !ok {
+		return []Agent{a}
+	}
+	var nodes []Agent
+	for _, item := range list {
+		nodes = append(nodes, agentList(item)...)
+	}
+	return nodes
+}
+
+// isNilAgent reports whether a is nil or holds a nil pointer.
+func isNilAgent(a Agent) bool {
+	return isNilValue(a)
+}
+
+// ensureAgentDefaults sets default values for every node of a, if any.
+func ensureAgentDefaults(a Agent) {
+	for _, agent := range agentList(a) {
+		agent.ensureDefaults()
+	}
+}
+
+// validateAgent checks that every Person or Organization node of a has a name
+// and that every reference has an @id. A missing name has the given severity.
+func validateAgent(field string, severity teseo.Severity, a Agent) []teseo.ValidationIssue {
+	var found issues
+
+	nodes := agentList(a)
+	for i, node := range nodes {
+		path := field
+		if len(nodes) > 1 {
+			path = fmt.Sprintf("%s[%d]", field, i)
+		}
+
+		var name string
+		switch n := node.(type) {
+		case *Person:
+			name = n.Name
+		case *Organization:
+			name = n.Name
+		case *EducationalOrganization:
+			name = n.Name
+		case *LocalBusiness:
+			name = n.Name
+		case *RawThing:
+			continue
+		case *NodeReference:
+			if n.ID == "" {
+				found.required(path + ".@id")
+			}
+			continue
+		}
+		if name == "" {
+			found.missing(severity, path+".name", path+".name")
+		}
+	}
+
+	return found
+}
+
+// unmarshalAgent decodes a JSON object, string or array into an Agent, choosing
+// the concrete type from each node's @type. Nodes without a @type are decoded as
+// references when they carry an @id. Nodes are decoded like Decode does, so an
+// array @type uses its first registered type, and a node of a type that is not
+// a Person or an Organization is kept as *RawThing. A string is decoded as a reference when it
+// is an http(s) URL and as a Person with that name otherwise, e.g. "author": "Jane Doe".
+func unmarshalAgent(data json.RawMessage) (Agent, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+
+	if data[0] == '"' {
+		var text string
+		if err := json.Unmarshal(data, &text); err != nil {
+			return nil, err
+		}
+		text = strings.TrimSpace(text)
+		switch {
+		case text == "":
+			return nil, nil
+		case strings.HasPrefix(text, "http://") || strings.HasPrefix(text, "https://"):
+			return &NodeReference{ID: text}, nil
+		}
+		return &Person{Type: "Person", Name: text}, nil
+	}
+
+	if data[0] == '[' {
+		var items []json.RawMessage
+		if err := json.Unmarshal(data, &items); err != nil {
+			return nil, err
+		}
+		var agents Agents
+		for _, item := range items {
+			agent, err := unmarshalAgent(item)
+			if err != nil {
+				return nil, err
+			}
+			if agent != nil {
+				agents = append(agents, agent)
+			}
+		}
+		return agents, nil
+	}
+
+	var node map[string]json.RawMessage
+	if err := json.Unmarshal(data, &node); err != nil {
+		return nil, err
+	}
+	if _, typed := node["@type"]; !typed {
+		var ref NodeReference
+		if err := json.Unmarshal(data, &ref); err != nil {
+			return nil, err
+		}
+		if ref.ID == "" {
+			return nil, fmt.Errorf("agent has neither @type nor @id: %s", string(data))
+		}
+		return &ref, nil
+	}
+
+	thing, err := decodeNode(node, data)
+	if err != nil {
+		return nil, err
+	}
+	if agent, ok := thing.(Agent); ok {
+		return agent, nil
+	}
+	types, _ := nodeTypes(node["@type"])
+	return &RawThing{Types: types, Data: append(json.RawMessage(nil), data...)}, nil
+}
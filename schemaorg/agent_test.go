@@ -0,0 +1,214 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/indaco/teseo"
+)
+
+func TestAgents_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		agent    Agent
+		expected string
+	}{
+		{
+			name:     "single agent",
+			agent:    NewAgents(&Person{Type: "Person", Name: "Jane"}),
+			expected: `{"@context":"","@type":"Person","name":"Jane"}`,
+		},
+		{
+			name:     "multiple agents",
+			agent:    NewAgents(&Person{Type: "Person", Name: "Jane"}, &NodeReference{ID: "https://example.com/#org"}),
+			expected: `[{"@context":"","@type":"Person","name":"Jane"},{"@id":"https://example.com/#org"}]`,
+		},
+		{
+			name:     "nested lists are flattened",
+			agent:    NewAgents(NewAgents(&NodeReference{ID: "#a"}), nil, (*Person)(nil), &NodeReference{ID: "#b"}),
+			expected: `[{"@id":"#a"},{"@id":"#b"}]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.agent)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(data) != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, data)
+			}
+		})
+	}
+}
+
+func TestArticle_MultipleAuthors(t *testing.T) {
+	article := NewArticle(
+		"Headline",
+		[]string{"https://www.example.com/image.jpg"},
+		NewAgents(&Person{Name: "Jane Doe"}, &Person{Name: "John Doe"}),
+		nil,
+		"2024-09-15",
+		"",
+		"",
+	)
+
+	for i, author := range agentList(article.Author) {
+		if author.(*Person).Type != "Person" {
+			t.Errorf("expected author %d to have type Person, got %s", i, author.(*Person).Type)
+		}
+	}
+
+	data, err := json.Marshal(article)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var decoded Article
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	authors, ok := decoded.Author.(Agents)
+	if !ok || len(authors) != 2 {
+		t.Fatalf("expected two authors, got %#v", decoded.Author)
+	}
+	if authors[1].(*Person).Name != "John Doe" {
+		t.Errorf("expected second author John Doe, got %s", authors[1].(*Person).Name)
+	}
+}
+
+func TestEvent_UnmarshalJSON_Agents(t *testing.T) {
+	data := `{
+		"@context": "https://schema.org",
+		"@type": "Event",
+		"name": "Concert",
+		"organizer": {"@id": "https://www.example.com/#organization"},
+		"performer": [
+			{"@type": "MusicGroup", "name": "The Band"},
+			{"@type": "Person", "name": "Guest Singer"}
+		]
+	}`
+
+	var e Event
+	if err := json.Unmarshal([]byte(data), &e); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if ref, ok := e.Organizer.(*NodeReference); !ok || ref.ID != "https://www.example.com/#organization" {
+		t.Errorf("expected organizer reference, got %#v", e.Organizer)
+	}
+	performers := agentList(e.Performer)
+	if len(performers) != 2 {
+		t.Fatalf("expected two performers, got %d", len(performers))
+	}
+	if org, ok := performers[0].(*Organization); !ok || org.Type != "MusicGroup" {
+		t.Errorf("expected MusicGroup organization, got %#v", performers[0])
+	}
+	if p, ok := performers[1].(*Person); !ok || p.Name != "Guest Singer" {
+		t.Errorf("expected Person performer, got %#v", performers[1])
+	}
+}
+
+func TestUnmarshalAgent_Errors(t *testing.T) {
+	inputs := []string{
+		`{"name": "No type"}`,
+		`[{"@type": "Person"}, 42]`,
+	}
+	for _, in := range inputs {
+		if _, err := unmarshalAgent(json.RawMessage(in)); err == nil {
+			t.Errorf("expected error for %s", in)
+		}
+	}
+}
+
+func TestUnmarshalAgent_Types(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{"person", `{"@type": "Person", "name": "Jane"}`, "*schemaorg.Person"},
+		{"organization subtype", `{"@type": "Corporation", "name": "Acme"}`, "*schemaorg.Organization"},
+		{"educational organization", `{"@type": "CollegeOrUniversity", "name": "Example University"}`, "*schemaorg.EducationalOrganization"},
+		{"local business", `{"@type": "Restaurant", "name": "Trattoria"}`, "*schemaorg.LocalBusiness"},
+		{"@type array", `{"@type": ["Patient", "Person"], "name": "Jane"}`, "*schemaorg.Person"},
+		{"unknown type", `{"@type": "Place", "name": "Venue"}`, "*schemaorg.RawThing"},
+		{"non-agent type", `{"@type": "Product", "name": "Anvil"}`, "*schemaorg.RawThing"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			agent, err := unmarshalAgent(json.RawMessage(tt.data))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := fmt.Sprintf("%T", agent); got != tt.want {
+				t.Fatalf("expected %s, got %s", tt.want, got)
+			}
+			if raw, ok := agent.(*RawThing); ok && string(raw.Data) != tt.data {
+				t.Errorf("expected the node to be preserved, got %s", raw.Data)
+			}
+		})
+	}
+}
+
+func TestUnmarshalAgent_Strings(t *testing.T) {
+	data := `{
+		"@context": "https://schema.org",
+		"@type": "Article",
+		"headline": "Post",
+		"author": ["Jane Doe", "https://www.example.com/#john"]
+	}`
+
+	var a Article
+	if err := json.Unmarshal([]byte(data), &a); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	authors := agentList(a.Author)
+	if len(authors) != 2 {
+		t.Fatalf("expected two authors, got %d", len(authors))
+	}
+	if p, ok := authors[0].(*Person); !ok || p.Name != "Jane Doe" {
+		t.Errorf("expected a Person named Jane Doe, got %#v", authors[0])
+	}
+	if ref, ok := authors[1].(*NodeReference); !ok || ref.ID != "https://www.example.com/#john" {
+		t.Errorf("expected a reference, got %#v", authors[1])
+	}
+
+	var org Organization
+	if err := json.Unmarshal([]byte(`{"@type": "Organization", "name": "Example Corp", "founder": "Jane"}`), &org); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p, ok := org.Founder.(*Person); !ok || p.Name != "Jane" {
+		t.Errorf("expected a name-only founder, got %#v", org.Founder)
+	}
+}
+
+func TestValidateAgent(t *testing.T) {
+	tests := []struct {
+		name     string
+		agent    Agent
+		expected []string
+	}{
+		{"nil", nil, nil},
+		{"named person", &Person{Name: "Jane"}, nil},
+		{"unnamed organization", &Organization{}, []string{"missing recommended field: performer.name"}},
+		{
+			"list",
+			NewAgents(&Person{Name: "Jane"}, &Person{}, &NodeReference{}),
+			[]string{
+				"missing recommended field: performer[1].name",
+				"missing required field: performer[2].@id",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := issueMessages(validateAgent("performer", teseo.SeverityRecommended, tt.agent))
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
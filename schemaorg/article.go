@@ -1,6 +1,8 @@
 package schemaorg
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"html/template"
 
@@ -11,13 +13,32 @@ import (
 // Article represents a Schema.org Article object.
 // For more details about the meaning of the properties see: https://schema.org/Article
 //
+// Author accepts a Person, an Organization, an @id reference or several of
+// them combined with NewAgents (e.g. for co-authored articles).
+//
+// Set Type to a subtype such as TypeNewsArticle or TypeBlogPosting for news and
+// blog content. Paywalled articles set IsAccessibleForFree to false and list the
+// restricted sections in HasPart:
+//
+//	free := false
+//	article := &schemaorg.Article{
+//		Type:                schemaorg.TypeNewsArticle,
+//		Headline:            "Example News Headline",
+//		MainEntityOfPage:    "https://www.example.com/news/example",
+//		ArticleSection:      []string{"Politics"},
+//		Dateline:            "ROME, Sept. 15",
+//		IsAccessibleForFree: &free,
+//		HasPart:             []*schemaorg.WebPageElement{schemaorg.NewPaywalledSection(".paywall")},
+//		Speakable:           &schemaorg.SpeakableSpecification{CSSSelector: []string{".headline", ".summary"}},
+//	}
+//
 // Example usage:
 //
 // Pure struct usage:
 //
 //	article := &schemaorg.Article{
 //		Headline:      "Example Article Headline",
-//		Image:         []string{"https://www.example.com/images/article.jpg"},
+//		Image:         schemaorg.NewImages("https://www.example.com/images/article.jpg"),
 //		Author:        &schemaorg.Person{Name: "Jane Doe"},
 //		Publisher:     &schemaorg.Organization{Name: "Example Publisher"},
 //		DatePublished: "2024-09-15",
@@ -61,51 +82,82 @@ import (
 //		"description": "This is an example article"
 //	}
 type Article struct {
-	Context       string        `json:"@context"`
-	Type          string        `json:"@type"`
-	Headline      string        `json:"headline,omitempty"`
-	Image         []string      `json:"image,omitempty"`
-	Author        *Person       `json:"author,omitempty"`
-	Publisher     *Organization `json:"publisher,omitempty"`
-	DatePublished string        `json:"datePublished,omitempty"`
-	DateModified  string        `json:"dateModified,omitempty"`
-	Description   string        `json:"description,omitempty"`
+	Context             string                  `json:"@context"`
+	Type                ArticleType             `json:"@type"`
+	Headline            string                  `json:"headline,omitempty"`
+	Image               Images                  `json:"image,omitempty"`
+	Author              Agent                   `json:"author,omitempty"`
+	Publisher           *Organization           `json:"publisher,omitempty"`
+	DatePublished       teseo.DateTime          `json:"datePublished,omitempty"`
+	DateModified        teseo.DateTime          `json:"dateModified,omitempty"`
+	Description         string                  `json:"description,omitempty"`
+	MainEntityOfPage    string                  `json:"mainEntityOfPage,omitempty"`
+	ArticleSection      StringList              `json:"articleSection,omitempty"`
+	WordCount           int                     `json:"wordCount,omitempty"`
+	Dateline            string                  `json:"dateline,omitempty"`
+	Speakable           *SpeakableSpecification `json:"speakable,omitempty"`
+	IsAccessibleForFree *bool                   `json:"isAccessibleForFree,omitempty"`
+	HasPart             WebPageElements         `json:"hasPart,omitempty"`
+	Extra               Extra                   `json:"-"`
 }
 
 // NewArticle initializes an Article with default context and type.
-func NewArticle(headline string, images []string, author *Person, publisher *Organization, datePublished, dateModified, description string) *Article {
+func NewArticle(headline string, images []string, author Agent, publisher *Organization, datePublished, dateModified, description string) *Article {
 	article := &Article{
 		Headline:      headline,
-		Image:         images,
+		Image:         NewImages(images...),
 		Author:        author,
 		Publisher:     publisher,
-		DatePublished: datePublished,
-		DateModified:  dateModified,
+		DatePublished: teseo.DateTime(datePublished),
+		DateModified:  teseo.DateTime(dateModified),
 		Description:   description,
 	}
 	article.ensureDefaults()
 	return article
 }
 
-// Validate checks if the Article has the recommended fields for SEO.
-// It returns a slice of warning messages for missing recommended fields.
+// Validate checks if the Article has the headline it needs to be used by search
+// engines and the recommended fields for SEO.
+// It returns a slice of warning messages for missing fields.
 func (art *Article) Validate() []string {
-	var warnings []string
+	return issueMessages(art.ValidationIssues())
+}
+
+// ValidationIssues returns the issues found by Validate on the Article as structured values.
+func (art *Article) ValidationIssues() []teseo.ValidationIssue {
+	var found issues
 
 	if art.Headline == "" {
-		warnings = append(warnings, "missing recommended field: headline")
+		found.required("headline")
 	}
 	if len(art.Image) == 0 {
-		warnings = append(warnings, "missing recommended field: image")
+		found.recommended("image")
 	}
 	if art.DatePublished == "" {
-		warnings = append(warnings, "missing recommended field: datePublished")
+		found.recommended("datePublished")
+	}
+	if isNilAgent(art.Author) && art.Publisher == nil {
+		found.missing(teseo.SeverityRecommended, "author", "author or publisher")
+	}
+	found.add(validateAgent("author", teseo.SeverityRecommended, art.Author)...)
+	if isNearMiss(art.Type, articleParents) {
+		found.warnf(teseo.RuleUnknownType, "@type", "unrecognized Article subtype %q", art.Type)
 	}
-	if art.Author == nil && art.Publisher == nil {
-		warnings = append(warnings, "missing recommended field: author or publisher")
+	if art.WordCount < 0 {
+		found.warnf(teseo.RuleNegativeValue, "wordCount", "wordCount must not be negative, got %d", art.WordCount)
 	}
+	if art.Speakable != nil {
+		found.add(art.Speakable.validate("speakable")...)
+	}
+	found.add(art.validatePaywall()...)
+	found.add(validateDateTime("datePublished", art.DatePublished)...)
+	found.add(validateDateTime("dateModified", art.DateModified)...)
+	found.add(validateTimeOrder("datePublished", art.DatePublished, "dateModified", art.DateModified)...)
+	found.add(art.Image.validate("image")...)
+	found.add(validateImageDimensions("image", art.Image)...)
 
-	return warnings
+	found.add(validateFormats(art)...)
+	return found
 }
 
 // ToJsonLd converts the Article struct to a JSON-LD `templ.Component`.
@@ -120,6 +172,71 @@ func (art *Article) ToGoHTMLJsonLd() (template.HTML, error) {
 	return teseo.RenderToHTML(art.ToJsonLd())
 }
 
+// UnmarshalJSON decodes an Article, resolving `author` to Person, Organization or @id reference nodes based on their `@type`
+// and `mainEntityOfPage` to the URL of the page.
+func (art *Article) UnmarshalJSON(data []byte) error {
+	type alias Article
+	aux := struct {
+		*alias
+		Author           json.RawMessage `json:"author,omitempty"`
+		MainEntityOfPage json.RawMessage `json:"mainEntityOfPage,omitempty"`
+	}{alias: (*alias)(art)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, aux)
+	if err != nil {
+		return err
+	}
+	art.Extra = extra
+
+	author, err := unmarshalAgent(aux.Author)
+	if err != nil {
+		return fmt.Errorf("Article: invalid author: %w", err)
+	}
+	art.Author = author
+
+	page, err := unmarshalPageURL(aux.MainEntityOfPage)
+	if err != nil {
+		return fmt.Errorf("Article: invalid mainEntityOfPage: %w", err)
+	}
+	art.MainEntityOfPage = page
+
+	return nil
+}
+
+// unmarshalPageURL decodes mainEntityOfPage, given either as a URL or as a
+// WebPage object such as {"@type": "WebPage", "@id": "https://www.example.com/post"},
+// into the URL of the page: its @id, or its url when it has no @id.
+func unmarshalPageURL(data json.RawMessage) (string, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || string(data) == "null" {
+		return "", nil
+	}
+	if data[0] == '"' {
+		var url string
+		err := json.Unmarshal(data, &url)
+		return url, err
+	}
+	var page struct {
+		ID  string `json:"@id"`
+		URL string `json:"url"`
+	}
+	if err := json.Unmarshal(data, &page); err != nil {
+		return "", err
+	}
+	if page.ID != "" {
+		return page.ID, nil
+	}
+	return page.URL, nil
+}
+
+// MarshalJSON encodes an Article, merging the Extra properties into the JSON-LD object.
+func (art Article) MarshalJSON() ([]byte, error) {
+	type alias Article
+	return marshalWithExtra(alias(art), art.Extra)
+}
+
 func (art *Article) ensureDefaults() {
 	if art.Context == "" {
 		art.Context = "https://schema.org"
@@ -129,11 +246,44 @@ func (art *Article) ensureDefaults() {
 		art.Type = "Article"
 	}
 
-	if art.Author != nil {
-		art.Author.ensureDefaults()
-	}
+	ensureAgentDefaults(art.Author)
 
 	if art.Publisher != nil {
 		art.Publisher.ensureDefaults()
 	}
+
+	if art.Speakable != nil {
+		art.Speakable.ensureDefaults()
+	}
+
+	for _, part := range art.HasPart {
+		part.ensureDefaults()
+	}
+
+	art.Image.ensureDefaults()
+}
+
+// validatePaywall checks the paywalled content markup: an article that is not
+// accessible for free must mark its restricted sections with hasPart, and
+// restricted sections require the article itself to be marked as not free.
+func (art *Article) validatePaywall() []teseo.ValidationIssue {
+	var found issues
+
+	restricted := false
+	for i, part := range art.HasPart {
+		found.add(part.validate(fmt.Sprintf("hasPart[%d]", i))...)
+		if part.IsAccessibleForFree != nil && !*part.IsAccessibleForFree {
+			restricted = true
+		}
+	}
+
+	paywalled := art.IsAccessibleForFree != nil && !*art.IsAccessibleForFree
+	if paywalled && len(art.HasPart) == 0 {
+		found.addf(teseo.SeverityRequired, teseo.RuleMissingField, "hasPart", "missing required field: hasPart (paywalled articles must mark their restricted sections)")
+	}
+	if restricted && !paywalled {
+		found.warnf(teseo.RuleConstraint, "isAccessibleForFree", "isAccessibleForFree should be false when hasPart marks paywalled sections")
+	}
+
+	return found
 }
@@ -18,10 +18,10 @@ func TestNewArticle_SetsFieldsAndDefaults(t *testing.T) {
 	if article.Headline != "Headline" {
 		t.Errorf("headline not set properly")
 	}
-	if article.Image[0] != "img1.jpg" {
+	if article.Image[0] != ImageURL("img1.jpg") {
 		t.Errorf("image not set properly")
 	}
-	if article.Author == nil || article.Author.Name != "Jane" {
+	if author, ok := article.Author.(*Person); !ok || author.Name != "Jane" {
 		t.Errorf("author not set properly")
 	}
 	if article.Publisher == nil || article.Publisher.Name != "Example Publisher" {
@@ -43,7 +43,7 @@ func TestArticle_EnsureDefaults(t *testing.T) {
 func TestArticle_Validate_AllFieldsPresent(t *testing.T) {
 	article := &Article{
 		Headline:      "Example Headline",
-		Image:         []string{"https://example.com/image.jpg"},
+		Image:         NewImages("https://example.com/image.jpg"),
 		DatePublished: "2024-09-15",
 		Author:        &Person{Name: "Jane"},
 		Publisher:     &Organization{Name: "Example Org"},
@@ -57,13 +57,13 @@ func TestArticle_Validate_AllFieldsPresent(t *testing.T) {
 
 func TestArticle_Validate_MissingHeadline(t *testing.T) {
 	article := &Article{
-		Image:         []string{"https://example.com/image.jpg"},
+		Image:         NewImages("https://example.com/image.jpg"),
 		DatePublished: "2024-09-15",
 		Author:        &Person{Name: "Jane"},
 	}
 
 	warnings := article.Validate()
-	if len(warnings) != 1 || warnings[0] != "missing recommended field: headline" {
+	if len(warnings) != 1 || warnings[0] != "missing required field: headline" {
 		t.Errorf("expected headline warning, got %v", warnings)
 	}
 }
@@ -84,7 +84,7 @@ func TestArticle_Validate_MissingImage(t *testing.T) {
 func TestArticle_Validate_MissingDatePublished(t *testing.T) {
 	article := &Article{
 		Headline: "Title",
-		Image:    []string{"https://example.com/image.jpg"},
+		Image:    NewImages("https://example.com/image.jpg"),
 		Author:   &Person{Name: "Jane"},
 	}
 
@@ -97,7 +97,7 @@ func TestArticle_Validate_MissingDatePublished(t *testing.T) {
 func TestArticle_Validate_MissingAuthorAndPublisher(t *testing.T) {
 	article := &Article{
 		Headline:      "Title",
-		Image:         []string{"https://example.com/image.jpg"},
+		Image:         NewImages("https://example.com/image.jpg"),
 		DatePublished: "2024-09-15",
 	}
 
@@ -112,7 +112,7 @@ func TestArticle_Validate_MultipleWarnings(t *testing.T) {
 
 	warnings := article.Validate()
 	expected := map[string]bool{
-		"missing recommended field: headline":            true,
+		"missing required field: headline":               true,
 		"missing recommended field: image":               true,
 		"missing recommended field: datePublished":       true,
 		"missing recommended field: author or publisher": true,
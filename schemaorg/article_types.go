@@ -0,0 +1,158 @@
+package schemaorg
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/indaco/teseo"
+)
+
+// ArticleType is the `@type` of an Article: Article itself or one of its
+// subtypes, such as NewsArticle for news sites and BlogPosting for blogs.
+// For the full hierarchy see: https://schema.org/Article
+type ArticleType string
+
+const (
+	TypeArticle                  ArticleType = "Article"
+	TypeAdvertiserContentArticle ArticleType = "AdvertiserContentArticle"
+	TypeNewsArticle              ArticleType = "NewsArticle"
+	TypeAnalysisNewsArticle      ArticleType = "AnalysisNewsArticle"
+	TypeBackgroundNewsArticle    ArticleType = "BackgroundNewsArticle"
+	TypeOpinionNewsArticle       ArticleType = "OpinionNewsArticle"
+	TypeReportageNewsArticle     ArticleType = "ReportageNewsArticle"
+	TypeReviewNewsArticle        ArticleType = "ReviewNewsArticle"
+	TypeBlogPosting              ArticleType = "BlogPosting"
+	TypeLiveBlogPosting          ArticleType = "LiveBlogPosting"
+	TypeReport                   ArticleType = "Report"
+	TypeSatiricalArticle         ArticleType = "SatiricalArticle"
+	TypeScholarlyArticle         ArticleType = "ScholarlyArticle"
+	TypeTechArticle              ArticleType = "TechArticle"
+)
+
+// articleParents maps every catalogued Article type to its parent type.
+var articleParents = map[ArticleType]ArticleType{
+	TypeArticle:                  "",
+	TypeAdvertiserContentArticle: TypeArticle,
+	TypeNewsArticle:              TypeArticle,
+	TypeAnalysisNewsArticle:      TypeNewsArticle,
+	TypeBackgroundNewsArticle:    TypeNewsArticle,
+	TypeOpinionNewsArticle:       TypeNewsArticle,
+	TypeReportageNewsArticle:     TypeNewsArticle,
+	TypeReviewNewsArticle:        TypeNewsArticle,
+	TypeBlogPosting:              TypeArticle,
+	TypeLiveBlogPosting:          TypeBlogPosting,
+	TypeReport:                   TypeArticle,
+	TypeSatiricalArticle:         TypeArticle,
+	TypeScholarlyArticle:         TypeArticle,
+	TypeTechArticle:              TypeArticle,
+}
+
+// IsValid reports whether the type is Article or one of the catalogued subtypes.
+func (t ArticleType) IsValid() bool {
+	_, ok := articleParents[t]
+	return ok
+}
+
+// Parent returns the parent type of a catalogued type, or an empty value for
+// Article and for types outside the catalogue.
+func (t ArticleType) Parent() ArticleType {
+	return articleParents[t]
+}
+
+// IsA reports whether the type is ancestor or one of its catalogued subtypes,
+// e.g. TypeOpinionNewsArticle.IsA(TypeNewsArticle) is true.
+func (t ArticleType) IsA(ancestor ArticleType) bool {
+	for current := t; current != ""; current = current.Parent() {
+		if current == ancestor {
+			return true
+		}
+	}
+	return false
+}
+
+// WebPageElement represents a Schema.org WebPageElement object, used in
+// `hasPart` to mark the sections of an article that are behind a paywall.
+// For more details see: https://developers.google.com/search/docs/appearance/structured-data/paywalled-content
+type WebPageElement struct {
+	Type                string `json:"@type"`
+	IsAccessibleForFree *bool  `json:"isAccessibleForFree,omitempty"`
+	CSSSelector         string `json:"cssSelector,omitempty"`
+}
+
+// WebPageElements holds the sections of an article marked with hasPart.
+// It is always rendered as an array and decodes from a single object or an array.
+type WebPageElements []*WebPageElement
+
+// UnmarshalJSON decodes a single WebPageElement or an array of them.
+func (wpe *WebPageElements) UnmarshalJSON(data []byte) error {
+	elements, err := unmarshalObjects[WebPageElement](data)
+	if err != nil {
+		return fmt.Errorf("invalid hasPart: %w", err)
+	}
+	*wpe = elements
+	return nil
+}
+
+// NewPaywalledSection returns a WebPageElement marking the content matched by
+// the class selector (e.g. ".paywall") as not accessible for free.
+func NewPaywalledSection(cssSelector string) *WebPageElement {
+	free := false
+	element := &WebPageElement{
+		IsAccessibleForFree: &free,
+		CSSSelector:         cssSelector,
+	}
+	element.ensureDefaults()
+	return element
+}
+
+// validate checks the WebPageElement fields required for paywalled content.
+func (wpe *WebPageElement) validate(prefix string) []teseo.ValidationIssue {
+	var found issues
+
+	if wpe.IsAccessibleForFree == nil {
+		found.required(prefix + ".isAccessibleForFree")
+	}
+	if wpe.CSSSelector == "" {
+		found.required(prefix + ".cssSelector")
+	} else if !strings.HasPrefix(wpe.CSSSelector, ".") {
+		found.warnf(teseo.RuleInvalidFormat, prefix+".cssSelector", "%s.cssSelector should be a class selector, got %q", prefix, wpe.CSSSelector)
+	}
+
+	return found
+}
+
+// ensureDefaults sets default values for WebPageElement if they are not already set.
+func (wpe *WebPageElement) ensureDefaults() {
+	if wpe.Type == "" {
+		wpe.Type = "WebPageElement"
+	}
+}
+
+// SpeakableSpecification represents a Schema.org SpeakableSpecification object,
+// identifying the sections of an article suited to text-to-speech playback.
+// Sections are selected either with CSS selectors or with XPaths, not both.
+// For more details see: https://schema.org/SpeakableSpecification
+type SpeakableSpecification struct {
+	Type        string     `json:"@type"`
+	CSSSelector StringList `json:"cssSelector,omitempty"`
+	XPath       StringList `json:"xpath,omitempty"`
+}
+
+// validate checks that exactly one of cssSelector and xpath is set.
+func (ss *SpeakableSpecification) validate(prefix string) []teseo.ValidationIssue {
+	var found issues
+	switch {
+	case ss.CSSSelector.IsZero() && ss.XPath.IsZero():
+		found.missing(teseo.SeverityRequired, prefix+".cssSelector", fmt.Sprintf("%s.cssSelector or %s.xpath", prefix, prefix))
+	case !ss.CSSSelector.IsZero() && !ss.XPath.IsZero():
+		found.warnf(teseo.RuleConstraint, prefix, "%s should set either cssSelector or xpath, not both", prefix)
+	}
+	return found
+}
+
+// ensureDefaults sets default values for SpeakableSpecification if they are not already set.
+func (ss *SpeakableSpecification) ensureDefaults() {
+	if ss.Type == "" {
+		ss.Type = "SpeakableSpecification"
+	}
+}
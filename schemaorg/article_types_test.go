@@ -0,0 +1,176 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestArticleType_IsA(t *testing.T) {
+	tests := []struct {
+		t        ArticleType
+		ancestor ArticleType
+		want     bool
+	}{
+		{TypeNewsArticle, TypeArticle, true},
+		{TypeOpinionNewsArticle, TypeNewsArticle, true},
+		{TypeLiveBlogPosting, TypeBlogPosting, true},
+		{TypeBlogPosting, TypeNewsArticle, false},
+		{"Blogpost", TypeArticle, false},
+	}
+	for _, tt := range tests {
+		if got := tt.t.IsA(tt.ancestor); got != tt.want {
+			t.Errorf("%s.IsA(%s) = %v, want %v", tt.t, tt.ancestor, got, tt.want)
+		}
+	}
+}
+
+func TestNewPaywalledSection(t *testing.T) {
+	data, err := json.Marshal(NewPaywalledSection(".paywall"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `{"@type":"WebPageElement","isAccessibleForFree":false,"cssSelector":".paywall"}`
+	if string(data) != expected {
+		t.Errorf("expected %s, got %s", expected, data)
+	}
+}
+
+func TestArticle_Validate_NewsFields(t *testing.T) {
+	free, notFree := true, false
+	newArticle := func() *Article {
+		return &Article{
+			Type:          TypeNewsArticle,
+			Headline:      "Headline",
+			Image:         NewImages("https://www.example.com/image.jpg"),
+			Author:        &Person{Name: "Jane Doe"},
+			DatePublished: "2024-09-15T09:00:00Z",
+		}
+	}
+
+	tests := []struct {
+		name     string
+		modify   func(a *Article)
+		expected []string
+	}{
+		{
+			name: "paywalled",
+			modify: func(a *Article) {
+				a.IsAccessibleForFree = &notFree
+				a.HasPart = []*WebPageElement{NewPaywalledSection(".paywall")}
+				a.Speakable = &SpeakableSpecification{XPath: []string{"/html/head/title"}}
+				a.WordCount = 1200
+			},
+		},
+		{
+			name:     "paywalled without parts",
+			modify:   func(a *Article) { a.IsAccessibleForFree = &notFree },
+			expected: []string{"missing required field: hasPart (paywalled articles must mark their restricted sections)"},
+		},
+		{
+			name: "parts on a free article",
+			modify: func(a *Article) {
+				a.IsAccessibleForFree = &free
+				a.HasPart = []*WebPageElement{NewPaywalledSection("#paywall"), {}}
+			},
+			expected: []string{
+				`hasPart[0].cssSelector should be a class selector, got "#paywall"`,
+				"missing required field: hasPart[1].isAccessibleForFree",
+				"missing required field: hasPart[1].cssSelector",
+				"isAccessibleForFree should be false when hasPart marks paywalled sections",
+			},
+		},
+		{
+			name: "invalid values",
+			modify: func(a *Article) {
+				a.Type = "NewsArticel"
+				a.WordCount = -1
+				a.Speakable = &SpeakableSpecification{CSSSelector: []string{".a"}, XPath: []string{"/b"}}
+			},
+			expected: []string{
+				`unrecognized Article subtype "NewsArticel"`,
+				"wordCount must not be negative, got -1",
+				"speakable should set either cssSelector or xpath, not both",
+			},
+		},
+		{
+			name:     "empty speakable",
+			modify:   func(a *Article) { a.Speakable = &SpeakableSpecification{} },
+			expected: []string{"missing required field: speakable.cssSelector or speakable.xpath"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := newArticle()
+			tt.modify(a)
+			if got := a.Validate(); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestArticle_EnsureDefaults_NewsFields(t *testing.T) {
+	a := &Article{
+		Type:      TypeBlogPosting,
+		Speakable: &SpeakableSpecification{CSSSelector: []string{".summary"}},
+		HasPart:   []*WebPageElement{{CSSSelector: ".paywall"}},
+	}
+	a.ensureDefaults()
+
+	if a.Type != TypeBlogPosting {
+		t.Errorf("expected BlogPosting to be kept, got %s", a.Type)
+	}
+	if a.Speakable.Type != "SpeakableSpecification" {
+		t.Errorf("expected SpeakableSpecification type, got %s", a.Speakable.Type)
+	}
+	if a.HasPart[0].Type != "WebPageElement" {
+		t.Errorf("expected WebPageElement type, got %s", a.HasPart[0].Type)
+	}
+}
+
+func TestArticle_UnmarshalJSON_PartsAndMainEntity(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		wantPage string
+		wantPart int
+	}{
+		{
+			name:     "single part and page object",
+			data:     `{"@type": "NewsArticle", "mainEntityOfPage": {"@type": "WebPage", "@id": "https://www.example.com/news"}, "hasPart": {"@type": "WebPageElement", "isAccessibleForFree": false, "cssSelector": ".paywall"}}`,
+			wantPage: "https://www.example.com/news",
+			wantPart: 1,
+		},
+		{
+			name:     "part array and page URL",
+			data:     `{"@type": "NewsArticle", "mainEntityOfPage": "https://www.example.com/news", "hasPart": [{"cssSelector": ".a"}, {"cssSelector": ".b"}]}`,
+			wantPage: "https://www.example.com/news",
+			wantPart: 2,
+		},
+		{
+			name:     "page object with url",
+			data:     `{"@type": "NewsArticle", "mainEntityOfPage": {"@type": "WebPage", "url": "https://www.example.com/news"}}`,
+			wantPage: "https://www.example.com/news",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var a Article
+			if err := json.Unmarshal([]byte(tt.data), &a); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if a.MainEntityOfPage != tt.wantPage || len(a.HasPart) != tt.wantPart {
+				t.Errorf("expected page %q and %d parts, got %q and %d", tt.wantPage, tt.wantPart, a.MainEntityOfPage, len(a.HasPart))
+			}
+		})
+	}
+
+	things, err := Decode([]byte(`{"@type": "NewsArticle", "headline": "News", "hasPart": {"cssSelector": ".paywall"}}`))
+	if err != nil {
+		t.Fatalf("unexpected decode error: %v", err)
+	}
+	if a, ok := things[0].(*Article); !ok || len(a.HasPart) != 1 || a.HasPart[0].CSSSelector != ".paywall" {
+		t.Errorf("expected a decoded hasPart, got %#v", things[0])
+	}
+}
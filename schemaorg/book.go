@@ -0,0 +1,296 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+
+	"github.com/a-h/templ"
+	"github.com/indaco/teseo"
+)
+
+// Book represents a Schema.org Book object.
+// Each edition (e.g. the paperback and the ebook) is listed in WorkExample and
+// can offer a ReadAction pointing to where the edition can be read or bought.
+// For more details about the meaning of the properties see: https://schema.org/Book
+//
+// Example usage:
+//
+// Pure struct usage:
+//
+//	book := &schemaorg.Book{
+//		ID:     "https://www.example.com/books/the-catcher-in-the-rye",
+//		Name:   "The Catcher in the Rye",
+//		URL:    "https://www.example.com/books/the-catcher-in-the-rye",
+//		Author: &schemaorg.Person{Name: "J.D. Salinger"},
+//		WorkExample: []*schemaorg.BookEdition{
+//			{
+//				ID:         "https://www.example.com/books/the-catcher-in-the-rye#ebook",
+//				BookFormat: schemaorg.EBook,
+//				InLanguage: "en",
+//				ISBN:       "9780316769174",
+//				PotentialAction: schemaorg.NewReadAction("https://www.example.com/read/the-catcher-in-the-rye"),
+//			},
+//		},
+//	}
+//
+// Factory method usage:
+//
+//	book := schemaorg.NewBook(
+//		"The Catcher in the Rye",
+//		"https://www.example.com/books/the-catcher-in-the-rye",
+//		&schemaorg.Person{Name: "J.D. Salinger"},
+//		edition,
+//	)
+//
+// // Rendering JSON-LD using templ:
+//
+//	templ Page() {
+//		@book.ToJsonLd()
+//	}
+//
+// // Rendering JSON-LD as `template.HTML` value:
+//
+//	jsonLdHtml := book.ToGoHTMLJsonLd()
+//
+// Expected output:
+//
+//	{
+//		"@context": "https://schema.org",
+//		"@type": "Book",
+//		"@id": "https://www.example.com/books/the-catcher-in-the-rye",
+//		"name": "The Catcher in the Rye",
+//		"url": "https://www.example.com/books/the-catcher-in-the-rye",
+//		"author": {"@type": "Person", "name": "J.D. Salinger"},
+//		"workExample": [
+//			{
+//				"@type": "Book",
+//				"@id": "https://www.example.com/books/the-catcher-in-the-rye#ebook",
+//				"bookFormat": "https://schema.org/EBook",
+//				"inLanguage": "en",
+//				"isbn": "9780316769174",
+//				"potentialAction": {
+//					"@type": "ReadAction",
+//					"target": {"@type": "EntryPoint", "urlTemplate": "https://www.example.com/read/the-catcher-in-the-rye"}
+//				}
+//			}
+//		]
+//	}
+type Book struct {
+	Context       string         `json:"@context"`
+	Type          string         `json:"@type"`
+	ID            string         `json:"@id,omitempty"`
+	Name          string         `json:"name,omitempty"`
+	URL           string         `json:"url,omitempty"`
+	Description   string         `json:"description,omitempty"`
+	Image         Images         `json:"image,omitempty"`
+	Author        Agent          `json:"author,omitempty"`
+	ISBN          string         `json:"isbn,omitempty"`
+	DatePublished teseo.DateTime `json:"datePublished,omitempty"`
+	Genre         StringList     `json:"genre,omitempty"`
+	Keywords      StringList     `json:"keywords,omitempty"`
+	SameAs        StringList     `json:"sameAs,omitempty"`
+	WorkExample   []*BookEdition `json:"workExample,omitempty"`
+	Extra         Extra          `json:"-"`
+}
+
+// BookEdition represents a single edition of a Book, listed in its `workExample`.
+type BookEdition struct {
+	Type            string         `json:"@type"`
+	ID              string         `json:"@id,omitempty"`
+	Name            string         `json:"name,omitempty"`
+	BookEdition     string         `json:"bookEdition,omitempty"`
+	BookFormat      BookFormatType `json:"bookFormat,omitempty"`
+	InLanguage      string         `json:"inLanguage,omitempty"`
+	ISBN            string         `json:"isbn,omitempty"`
+	NumberOfPages   int            `json:"numberOfPages,omitempty"`
+	DatePublished   teseo.DateTime `json:"datePublished,omitempty"`
+	PotentialAction *ReadAction    `json:"potentialAction,omitempty"`
+}
+
+// ReadAction represents a Schema.org ReadAction object, the action of reading
+// an edition at the target URL. ExpectsAcceptanceOf describes the purchase or
+// subscription required, if any.
+// For more details see: https://schema.org/ReadAction
+type ReadAction struct {
+	Type                string  `json:"@type"`
+	Target              *Target `json:"target,omitempty"`
+	ExpectsAcceptanceOf *Offer  `json:"expectsAcceptanceOf,omitempty"`
+}
+
+// NewBook initializes a Book with default context and type.
+func NewBook(name, url string, author Agent, editions ...*BookEdition) *Book {
+	book := &Book{
+		Name:        name,
+		URL:         url,
+		Author:      author,
+		WorkExample: editions,
+	}
+	book.ensureDefaults()
+	return book
+}
+
+// NewReadAction initializes a ReadAction with the given target URL.
+func NewReadAction(url string) *ReadAction {
+	action := &ReadAction{Target: &Target{URLTemplate: url}}
+	action.ensureDefaults()
+	return action
+}
+
+// Validate returns warnings for missing required or recommended Book fields.
+func (b *Book) Validate() []string {
+	return issueMessages(b.ValidationIssues())
+}
+
+// ValidationIssues returns the issues found by Validate on the Book as structured values.
+func (b *Book) ValidationIssues() []teseo.ValidationIssue {
+	var found issues
+
+	if b.Name == "" {
+		found.required("name")
+	}
+	if isNilAgent(b.Author) {
+		found.required("author.name")
+	} else {
+		found.add(validateAgent("author", teseo.SeverityRequired, b.Author)...)
+	}
+	if b.URL == "" {
+		found.recommended("url")
+	}
+	if len(b.WorkExample) == 0 {
+		found.recommended("workExample")
+	}
+	found.add(validateDateTime("datePublished", b.DatePublished)...)
+
+	for i, edition := range b.WorkExample {
+		if edition != nil {
+			found.add(edition.validate(fmt.Sprintf("workExample[%d]", i))...)
+		}
+	}
+	found.add(b.Image.validate("image")...)
+
+	found.add(validateFormats(b)...)
+	return found
+}
+
+// validate checks the BookEdition fields, prefixing the issue paths with the given path.
+func (be *BookEdition) validate(prefix string) []teseo.ValidationIssue {
+	var found issues
+
+	if be.BookFormat == "" {
+		found.required(prefix + ".bookFormat")
+	}
+	found.add(validateEnum(prefix+".bookFormat", string(be.BookFormat), be.BookFormat.IsValid())...)
+	if be.ISBN == "" {
+		found.required(prefix + ".isbn")
+	}
+	if be.InLanguage == "" {
+		found.recommended(prefix + ".inLanguage")
+	}
+	if be.NumberOfPages < 0 {
+		found.warnf(teseo.RuleNegativeValue, prefix+".numberOfPages", "%s.numberOfPages must not be negative, got %d", prefix, be.NumberOfPages)
+	}
+	found.add(validateDateTime(prefix+".datePublished", be.DatePublished)...)
+
+	if be.PotentialAction != nil {
+		action := be.PotentialAction
+		if action.Target == nil || action.Target.URLTemplate == "" {
+			found.required(prefix + ".potentialAction.target.urlTemplate")
+		}
+		if action.ExpectsAcceptanceOf != nil {
+			found.add(action.ExpectsAcceptanceOf.validateEnums(prefix + ".potentialAction.expectsAcceptanceOf")...)
+		}
+	}
+
+	return found
+}
+
+// ToJsonLd converts the Book struct to a JSON-LD `templ.Component`.
+func (b *Book) ToJsonLd() templ.Component {
+	b.ensureDefaults()
+	id := fmt.Sprintf("%s-%s", "book", teseo.GenerateUniqueKey())
+	return templ.JSONScript(id, b).WithType("application/ld+json")
+}
+
+// ToGoHTMLJsonLd renders the Book struct as `template.HTML` value for Go's `html/template`.
+func (b *Book) ToGoHTMLJsonLd() (template.HTML, error) {
+	return teseo.RenderToHTML(b.ToJsonLd())
+}
+
+// MarshalJSON encodes a Book, merging the Extra properties into the JSON-LD object.
+func (b Book) MarshalJSON() ([]byte, error) {
+	type alias Book
+	return marshalWithExtra(alias(b), b.Extra)
+}
+
+// UnmarshalJSON decodes a Book, resolving `author` to Person, Organization or @id reference nodes based on their `@type`.
+func (b *Book) UnmarshalJSON(data []byte) error {
+	type alias Book
+	aux := struct {
+		*alias
+		Author json.RawMessage `json:"author,omitempty"`
+	}{alias: (*alias)(b)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, aux)
+	if err != nil {
+		return err
+	}
+	b.Extra = extra
+
+	author, err := unmarshalAgent(aux.Author)
+	if err != nil {
+		return fmt.Errorf("Book: invalid author: %w", err)
+	}
+	b.Author = author
+
+	return nil
+}
+
+// ensureDefaults sets default values for Book and its editions if they are not already set.
+func (b *Book) ensureDefaults() {
+	if b.Context == "" {
+		b.Context = "https://schema.org"
+	}
+
+	if b.Type == "" {
+		b.Type = "Book"
+	}
+
+	ensureAgentDefaults(b.Author)
+
+	for _, edition := range b.WorkExample {
+		if edition != nil {
+			edition.ensureDefaults()
+		}
+	}
+
+	b.Image.ensureDefaults()
+}
+
+// ensureDefaults sets default values for BookEdition and its action if they are not already set.
+func (be *BookEdition) ensureDefaults() {
+	if be.Type == "" {
+		be.Type = "Book"
+	}
+
+	if be.PotentialAction != nil {
+		be.PotentialAction.ensureDefaults()
+	}
+}
+
+// ensureDefaults sets default values for ReadAction and its target and offer if they are not already set.
+func (ra *ReadAction) ensureDefaults() {
+	if ra.Type == "" {
+		ra.Type = "ReadAction"
+	}
+
+	if ra.Target != nil {
+		ra.Target.ensureDefaults()
+	}
+
+	if ra.ExpectsAcceptanceOf != nil {
+		ra.ExpectsAcceptanceOf.ensureDefaults()
+	}
+}
@@ -0,0 +1,109 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestNewBook_SetsDefaults(t *testing.T) {
+	edition := &BookEdition{
+		BookFormat:      EBook,
+		ISBN:            "9780316769174",
+		InLanguage:      "en",
+		PotentialAction: &ReadAction{Target: &Target{URLTemplate: "https://www.example.com/read"}, ExpectsAcceptanceOf: &Offer{}},
+	}
+	book := NewBook("The Catcher in the Rye", "https://www.example.com/books/catcher", &Person{Name: "J.D. Salinger"}, edition)
+
+	if book.Context != "https://schema.org" || book.Type != "Book" {
+		t.Errorf("expected default context and type, got %s %s", book.Context, book.Type)
+	}
+	if edition.Type != "Book" {
+		t.Errorf("expected edition type Book, got %s", edition.Type)
+	}
+	if edition.PotentialAction.Type != "ReadAction" || edition.PotentialAction.Target.Type != "EntryPoint" {
+		t.Errorf("expected ReadAction with an EntryPoint target, got %#v", edition.PotentialAction)
+	}
+	if edition.PotentialAction.ExpectsAcceptanceOf.Type != "Offer" {
+		t.Errorf("expected offer type Offer, got %s", edition.PotentialAction.ExpectsAcceptanceOf.Type)
+	}
+}
+
+func TestNewReadAction_MarshalJSON(t *testing.T) {
+	action := NewReadAction("https://www.example.com/read")
+	action.Target.ActionPlatform = StringList{"https://schema.org/DesktopWebPlatform"}
+	data, err := json.Marshal(action)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `{"@type":"ReadAction","target":{"@type":"EntryPoint","urlTemplate":"https://www.example.com/read","actionPlatform":"https://schema.org/DesktopWebPlatform"}}`
+	if string(data) != expected {
+		t.Errorf("expected %s, got %s", expected, data)
+	}
+}
+
+func TestBook_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		book     *Book
+		expected []string
+	}{
+		{
+			name: "valid book",
+			book: &Book{
+				Name:   "The Catcher in the Rye",
+				URL:    "https://www.example.com/books/catcher",
+				Author: &Person{Name: "J.D. Salinger"},
+				WorkExample: []*BookEdition{
+					{BookFormat: "Paperback", ISBN: "9780316769174", InLanguage: "en", PotentialAction: NewReadAction("https://www.example.com/read")},
+				},
+			},
+		},
+		{
+			name: "missing fields",
+			book: &Book{},
+			expected: []string{
+				"missing required field: name",
+				"missing required field: author.name",
+				"missing recommended field: url",
+				"missing recommended field: workExample",
+			},
+		},
+		{
+			name: "invalid edition",
+			book: &Book{
+				Name:   "The Catcher in the Rye",
+				URL:    "https://www.example.com/books/catcher",
+				Author: &Person{Name: "J.D. Salinger"},
+				WorkExample: []*BookEdition{
+					{BookFormat: "Kindle", NumberOfPages: -1, PotentialAction: &ReadAction{ExpectsAcceptanceOf: &Offer{Availability: "Available"}}},
+				},
+			},
+			expected: []string{
+				`unknown workExample[0].bookFormat value "Kindle"`,
+				"missing required field: workExample[0].isbn",
+				"missing recommended field: workExample[0].inLanguage",
+				"workExample[0].numberOfPages must not be negative, got -1",
+				"missing required field: workExample[0].potentialAction.target.urlTemplate",
+				`unknown workExample[0].potentialAction.expectsAcceptanceOf.availability value "Available"`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.book.Validate(); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestBook_ToGoHTMLJsonLd(t *testing.T) {
+	html, err := NewBook("Book", "https://www.example.com/book", &Person{Name: "Author"}).ToGoHTMLJsonLd()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if html == "" {
+		t.Errorf("expected non-empty HTML")
+	}
+}
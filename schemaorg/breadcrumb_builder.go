@@ -0,0 +1,342 @@
+package schemaorg
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// BreadcrumbBuilder builds a BreadcrumbList from the URL of a page, one item per
+// path segment. The zero value is ready to use: segments are humanized
+// ("my-first-post" becomes "My first post"), the root item is labelled "Home"
+// and trailing slashes follow the URL being built.
+//
+// Example usage:
+//
+//	builder := &schemaorg.BreadcrumbBuilder{
+//		Locale: "fr",
+//		Labels: schemaorg.RouteLabels(map[string]string{
+//			"/blog":               "Journal",
+//			"/blog/{year}/{slug}": "Article",
+//		}),
+//		ExcludeSegments: []string{"p"},
+//		ExcludeNumeric:  true,
+//		TrailingSlash:   schemaorg.TrailingSlashAlways,
+//	}
+//	breadcrumbList, err := builder.Build("https://www.example.com/blog/2024/my-first-post")
+//
+// Expected output:
+//
+//	{
+//		"@context": "https://schema.org",
+//		"@type": "BreadcrumbList",
+//		"itemListElement": [
+//			{"@type": "ListItem", "position": 1, "name": "Accueil", "item": "https://www.example.com/"},
+//			{"@type": "ListItem", "position": 2, "name": "Journal", "item": "https://www.example.com/blog/"},
+//			{"@type": "ListItem", "position": 3, "name": "Article", "item": "https://www.example.com/blog/2024/my-first-post/"}
+//		]
+//	}
+type BreadcrumbBuilder struct {
+	// RootLabel is the name of the first item. When empty, it is looked up from Locale in RootLabels.
+	RootLabel string
+	// Locale is the BCP 47 language tag of the page, e.g. "en" or "pt-BR".
+	// It selects the default RootLabel and the casing rules used to humanize segments.
+	Locale string
+	// Labels resolves the name of an item from its path. Items without a
+	// resolved label are named after their humanized segment.
+	Labels BreadcrumbLabelResolver
+	// ExcludeSegments lists the segments, such as "p" in "/p/123/coat", that do not get an item
+	// unless Labels resolves a label for them. They are still part of the URL of the items below them.
+	ExcludeSegments []string
+	// ExcludeNumeric skips segments made only of digits, such as numeric IDs or years.
+	ExcludeNumeric bool
+	// TrailingSlash sets whether the URL of each item ends with a slash.
+	TrailingSlash TrailingSlashPolicy
+	// BaseURL is the absolute URL that relative URLs, such as "/blog/my-first-post",
+	// are resolved against. Without it, Build only accepts absolute URLs.
+	BaseURL string
+}
+
+// BreadcrumbLabelResolver returns the name of the breadcrumb item for a
+// decoded URL path such as "/blog/my-first-post", or false when it has none.
+type BreadcrumbLabelResolver func(path string) (string, bool)
+
+// TrailingSlashPolicy sets whether the URLs of the breadcrumb items end with a slash.
+type TrailingSlashPolicy int
+
+const (
+	// TrailingSlashPreserve adds a trailing slash to every item when the URL being built has one.
+	TrailingSlashPreserve TrailingSlashPolicy = iota
+	// TrailingSlashAlways adds a trailing slash to every item.
+	TrailingSlashAlways
+	// TrailingSlashNever removes the trailing slash from every item.
+	TrailingSlashNever
+)
+
+// RootLabels maps the primary language subtag of a locale to the default name of the root item.
+var RootLabels = map[string]string{
+	"de": "Startseite",
+	"en": "Home",
+	"es": "Inicio",
+	"fr": "Accueil",
+	"it": "Home",
+	"ja": "ホーム",
+	"nl": "Home",
+	"pl": "Strona główna",
+	"pt": "Início",
+	"sv": "Hem",
+	"zh": "首页",
+}
+
+// Build returns the BreadcrumbList of the page at rawURL. A relative rawURL is
+// resolved against BaseURL; an error is returned when it cannot be resolved.
+func (b *BreadcrumbBuilder) Build(rawURL string) (*BreadcrumbList, error) {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("[BreadcrumbBuilder.Build] invalid URL: %w", err)
+	}
+	if parsedURL.Scheme == "" || parsedURL.Host == "" {
+		if b.BaseURL == "" {
+			return nil, fmt.Errorf("[BreadcrumbBuilder.Build] URL %q is not absolute and no BaseURL is set", rawURL)
+		}
+		base, err := url.Parse(b.BaseURL)
+		if err != nil || base.Scheme == "" || base.Host == "" {
+			return nil, fmt.Errorf("[BreadcrumbBuilder.Build] invalid BaseURL %q: expected an absolute URL", b.BaseURL)
+		}
+		parsedURL = base.ResolveReference(parsedURL)
+		if parsedURL.Host == "" {
+			return nil, fmt.Errorf("[BreadcrumbBuilder.Build] URL %q has no host", rawURL)
+		}
+	}
+
+	baseURL := parsedURL.Scheme + "://" + parsedURL.Host
+	slash := b.TrailingSlash == TrailingSlashAlways ||
+		(b.TrailingSlash == TrailingSlashPreserve && strings.HasSuffix(parsedURL.Path, "/") && strings.Trim(parsedURL.Path, "/") != "")
+
+	rootURL := baseURL
+	if slash {
+		rootURL += "/"
+	}
+	rootLabel, ok := b.resolveLabel("/")
+	if !ok {
+		rootLabel = b.rootLabel()
+	}
+	listItems := []ListItem{{Type: "ListItem", Position: 1, Name: rootLabel, Item: rootURL}}
+
+	// Keep the escaped segments to build the URLs and decode them for the labels.
+	var segments []string
+	if trimmed := strings.Trim(parsedURL.EscapedPath(), "/"); trimmed != "" {
+		segments = strings.Split(trimmed, "/")
+	}
+	decoded := make([]string, len(segments))
+	for i, segment := range segments {
+		decoded[i] = unescapeSegment(segment)
+	}
+
+	for i, segment := range decoded {
+		path := "/" + strings.Join(decoded[:i+1], "/")
+		label, ok := b.resolveLabel(path)
+		if !ok {
+			if b.isExcluded(segment) {
+				continue
+			}
+			label = humanizeSegment(segment, b.Locale)
+		}
+
+		href := baseURL + "/" + strings.Join(segments[:i+1], "/")
+		if slash {
+			href += "/"
+		}
+		listItems = append(listItems, ListItem{
+			Type:     "ListItem",
+			Position: len(listItems) + 1,
+			Name:     label,
+			Item:     href,
+		})
+	}
+
+	return NewBreadcrumbList(listItems), nil
+}
+
+// resolveLabel returns the label resolved for path, if any.
+func (b *BreadcrumbBuilder) resolveLabel(path string) (string, bool) {
+	if b.Labels == nil {
+		return "", false
+	}
+	label, ok := b.Labels(path)
+	return label, ok && label != ""
+}
+
+// rootLabel returns the name of the root item.
+func (b *BreadcrumbBuilder) rootLabel() string {
+	if b.RootLabel != "" {
+		return b.RootLabel
+	}
+	if label, ok := RootLabels[primaryLanguage(b.Locale)]; ok {
+		return label
+	}
+	return "Home"
+}
+
+// isExcluded reports whether the segment does not get a breadcrumb item.
+func (b *BreadcrumbBuilder) isExcluded(segment string) bool {
+	for _, excluded := range b.ExcludeSegments {
+		if strings.EqualFold(strings.Trim(excluded, "/"), segment) {
+			return true
+		}
+	}
+	return b.ExcludeNumeric && isNumeric(segment)
+}
+
+// RouteLabels returns a BreadcrumbLabelResolver looking up labels in a map of
+// route patterns. A pattern matches a path segment by segment: "{name}" and "*"
+// match any single segment and a final "{name...}" matches the rest of the path.
+// Exact paths take precedence over patterns, and patterns with fewer wildcards
+// over the others.
+//
+// Example usage:
+//
+//	labels := schemaorg.RouteLabels(map[string]string{
+//		"/":                      "Start",
+//		"/docs":                  "Documentation",
+//		"/docs/{version}":        "Version",
+//		"/docs/{version}/{p...}": "Page",
+//	})
+func RouteLabels(routes map[string]string) BreadcrumbLabelResolver {
+	type route struct {
+		pattern   string
+		segments  []string
+		wildcards int
+		catchAll  bool
+	}
+
+	exact := make(map[string]string, len(routes))
+	var patterns []route
+	for pattern, label := range routes {
+		normalized := "/" + strings.Trim(pattern, "/")
+		segments := splitRoute(normalized)
+		r := route{pattern: pattern, segments: segments}
+		for i, s := range segments {
+			switch {
+			case isCatchAll(s) && i == len(segments)-1:
+				r.catchAll = true
+			case isWildcard(s):
+				r.wildcards++
+			}
+		}
+		if r.wildcards == 0 && !r.catchAll {
+			exact[normalized] = label
+			continue
+		}
+		patterns = append(patterns, r)
+	}
+	sort.Slice(patterns, func(i, j int) bool {
+		a, b := patterns[i], patterns[j]
+		if a.catchAll != b.catchAll {
+			return !a.catchAll
+		}
+		if a.wildcards != b.wildcards {
+			return a.wildcards < b.wildcards
+		}
+		if len(a.segments) != len(b.segments) {
+			return len(a.segments) > len(b.segments)
+		}
+		return a.pattern < b.pattern
+	})
+
+	return func(path string) (string, bool) {
+		normalized := "/" + strings.Trim(path, "/")
+		if label, ok := exact[normalized]; ok {
+			return label, true
+		}
+		segments := splitRoute(normalized)
+		for _, r := range patterns {
+			if matchRoute(r.segments, segments) {
+				return routes[r.pattern], true
+			}
+		}
+		return "", false
+	}
+}
+
+// splitRoute splits a normalized path into its segments.
+func splitRoute(path string) []string {
+	if path == "/" {
+		return nil
+	}
+	return strings.Split(strings.TrimPrefix(path, "/"), "/")
+}
+
+// matchRoute reports whether the path segments match the pattern segments.
+func matchRoute(pattern, segments []string) bool {
+	for i, p := range pattern {
+		if isCatchAll(p) && i == len(pattern)-1 {
+			return len(segments) > i
+		}
+		if i >= len(segments) {
+			return false
+		}
+		if !isWildcard(p) && p != segments[i] {
+			return false
+		}
+	}
+	return len(pattern) == len(segments)
+}
+
+// isWildcard reports whether a pattern segment matches any single segment.
+func isWildcard(s string) bool {
+	return s == "*" || (strings.HasPrefix(s, "{") && strings.HasSuffix(s, "}"))
+}
+
+// isCatchAll reports whether a pattern segment matches the rest of the path.
+func isCatchAll(s string) bool {
+	return strings.HasPrefix(s, "{") && strings.HasSuffix(s, "...}")
+}
+
+// humanizeSegment turns a URL segment into a label: dashes and underscores
+// become spaces and the first letter is title-cased using the rules of locale.
+func humanizeSegment(segment, locale string) string {
+	label := strings.Join(strings.FieldsFunc(segment, func(r rune) bool {
+		return r == '-' || r == '_' || unicode.IsSpace(r)
+	}), " ")
+
+	switch primaryLanguage(locale) {
+	case "tr", "az":
+		runes := []rune(label)
+		if len(runes) > 0 {
+			runes[0] = unicode.TurkishCase.ToTitle(runes[0])
+		}
+		return string(runes)
+	default:
+		return toTitle(label)
+	}
+}
+
+// unescapeSegment percent-decodes a URL segment, keeping it as is when it is not validly encoded.
+func unescapeSegment(segment string) string {
+	if decoded, err := url.PathUnescape(segment); err == nil {
+		return decoded
+	}
+	return segment
+}
+
+// primaryLanguage returns the lowercased primary language subtag of a BCP 47 tag.
+func primaryLanguage(locale string) string {
+	lang, _, _ := strings.Cut(strings.ReplaceAll(locale, "_", "-"), "-")
+	return strings.ToLower(lang)
+}
+
+// isNumeric reports whether s is made only of ASCII digits.
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,234 @@
+package schemaorg
+
+import (
+	"reflect"
+	"testing"
+)
+
+// crumbs returns the name and item URL of each ListItem of the BreadcrumbList.
+func crumbs(bcl *BreadcrumbList) [][2]string {
+	var got [][2]string
+	for _, item := range bcl.ItemListElement {
+		got = append(got, [2]string{item.Name, item.ItemURL()})
+	}
+	return got
+}
+
+func TestBreadcrumbBuilder_Build(t *testing.T) {
+	tests := []struct {
+		name     string
+		builder  *BreadcrumbBuilder
+		url      string
+		expected [][2]string
+	}{
+		{
+			name:    "humanized slugs",
+			builder: &BreadcrumbBuilder{},
+			url:     "https://example.com/blog/my_first-post",
+			expected: [][2]string{
+				{"Home", "https://example.com"},
+				{"Blog", "https://example.com/blog"},
+				{"My first post", "https://example.com/blog/my_first-post"},
+			},
+		},
+		{
+			name:    "percent-decoded labels keep escaped URLs",
+			builder: &BreadcrumbBuilder{},
+			url:     "https://example.com/caf%C3%A9/cr%C3%A8me%20br%C3%BBl%C3%A9e",
+			expected: [][2]string{
+				{"Home", "https://example.com"},
+				{"Café", "https://example.com/caf%C3%A9"},
+				{"Crème brûlée", "https://example.com/caf%C3%A9/cr%C3%A8me%20br%C3%BBl%C3%A9e"},
+			},
+		},
+		{
+			name:    "root only",
+			builder: &BreadcrumbBuilder{},
+			url:     "https://example.com/",
+			expected: [][2]string{
+				{"Home", "https://example.com"},
+			},
+		},
+		{
+			name:    "locale root label and casing",
+			builder: &BreadcrumbBuilder{Locale: "tr-TR"},
+			url:     "https://example.com/istanbul",
+			expected: [][2]string{
+				{"Home", "https://example.com"},
+				{"İstanbul", "https://example.com/istanbul"},
+			},
+		},
+		{
+			name:    "locale root label",
+			builder: &BreadcrumbBuilder{Locale: "fr_CA"},
+			url:     "https://example.com/contact",
+			expected: [][2]string{
+				{"Accueil", "https://example.com"},
+				{"Contact", "https://example.com/contact"},
+			},
+		},
+		{
+			name:    "custom root label",
+			builder: &BreadcrumbBuilder{RootLabel: "Start", Locale: "fr"},
+			url:     "https://example.com/contact",
+			expected: [][2]string{
+				{"Start", "https://example.com"},
+				{"Contact", "https://example.com/contact"},
+			},
+		},
+		{
+			name:    "excluded segments",
+			builder: &BreadcrumbBuilder{ExcludeSegments: []string{"/p/"}, ExcludeNumeric: true},
+			url:     "https://example.com/p/123/winter-coat",
+			expected: [][2]string{
+				{"Home", "https://example.com"},
+				{"Winter coat", "https://example.com/p/123/winter-coat"},
+			},
+		},
+		{
+			name: "labels take precedence over exclusions",
+			builder: &BreadcrumbBuilder{
+				ExcludeNumeric: true,
+				Labels:         RouteLabels(map[string]string{"/orders/{id}": "Order details"}),
+			},
+			url: "https://example.com/orders/42",
+			expected: [][2]string{
+				{"Home", "https://example.com"},
+				{"Orders", "https://example.com/orders"},
+				{"Order details", "https://example.com/orders/42"},
+			},
+		},
+		{
+			name: "callback resolver",
+			builder: &BreadcrumbBuilder{
+				Labels: func(path string) (string, bool) {
+					if path == "/" {
+						return "Dashboard", true
+					}
+					return "", false
+				},
+			},
+			url: "https://example.com/settings",
+			expected: [][2]string{
+				{"Dashboard", "https://example.com"},
+				{"Settings", "https://example.com/settings"},
+			},
+		},
+		{
+			name:    "preserved trailing slash",
+			builder: &BreadcrumbBuilder{},
+			url:     "https://example.com/docs/intro/?page=2#top",
+			expected: [][2]string{
+				{"Home", "https://example.com/"},
+				{"Docs", "https://example.com/docs/"},
+				{"Intro", "https://example.com/docs/intro/"},
+			},
+		},
+		{
+			name:    "always trailing slash",
+			builder: &BreadcrumbBuilder{TrailingSlash: TrailingSlashAlways},
+			url:     "https://example.com/docs",
+			expected: [][2]string{
+				{"Home", "https://example.com/"},
+				{"Docs", "https://example.com/docs/"},
+			},
+		},
+		{
+			name:    "never trailing slash",
+			builder: &BreadcrumbBuilder{TrailingSlash: TrailingSlashNever},
+			url:     "https://example.com/docs/",
+			expected: [][2]string{
+				{"Home", "https://example.com"},
+				{"Docs", "https://example.com/docs"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bcl, err := tt.builder.Build(tt.url)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := crumbs(bcl); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+			for i, item := range bcl.ItemListElement {
+				if item.Position != i+1 {
+					t.Errorf("expected position %d, got %d", i+1, item.Position)
+				}
+			}
+			if w := bcl.Validate(); len(w) != 0 {
+				t.Errorf("expected no warnings, got %v", w)
+			}
+		})
+	}
+}
+
+func TestBreadcrumbBuilder_Build_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		builder BreadcrumbBuilder
+		url     string
+	}{
+		{"invalid URL", BreadcrumbBuilder{}, "http://[::1]:namedport"},
+		{"relative URL", BreadcrumbBuilder{}, "/blog/my-first-post"},
+		{"scheme-less URL", BreadcrumbBuilder{}, "www.example.com/blog"},
+		{"relative base URL", BreadcrumbBuilder{BaseURL: "/blog/"}, "my-first-post"},
+		{"URL without host", BreadcrumbBuilder{BaseURL: "https://www.example.com"}, "mailto:info@example.com"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if bcl, err := tt.builder.Build(tt.url); err == nil {
+				t.Errorf("expected an error for %q, got %v", tt.url, crumbs(bcl))
+			}
+		})
+	}
+}
+
+func TestBreadcrumbBuilder_Build_BaseURL(t *testing.T) {
+	builder := &BreadcrumbBuilder{BaseURL: "https://www.example.com/"}
+	bcl, err := builder.Build("/blog/my-first-post")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := [][2]string{
+		{"Home", "https://www.example.com"},
+		{"Blog", "https://www.example.com/blog"},
+		{"My first post", "https://www.example.com/blog/my-first-post"},
+	}
+	if got := crumbs(bcl); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestRouteLabels(t *testing.T) {
+	labels := RouteLabels(map[string]string{
+		"/":                      "Start",
+		"/docs/":                 "Documentation",
+		"/docs/{version}":        "Version",
+		"/docs/latest":           "Latest",
+		"/docs/*/api":            "API",
+		"/docs/{version}/{p...}": "Page",
+	})
+
+	tests := []struct {
+		path  string
+		label string
+		ok    bool
+	}{
+		{"/", "Start", true},
+		{"/docs", "Documentation", true},
+		{"/docs/latest", "Latest", true},
+		{"/docs/v2", "Version", true},
+		{"/docs/v2/api", "API", true},
+		{"/docs/v2/guides/install", "Page", true},
+		{"/blog", "", false},
+	}
+	for _, tt := range tests {
+		label, ok := labels(tt.path)
+		if label != tt.label || ok != tt.ok {
+			t.Errorf("labels(%q) = %q, %v; want %q, %v", tt.path, label, ok, tt.label, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package schemaorg
+
+import (
+	"context"
+	"html"
+	"html/template"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/a-h/templ"
+	"github.com/indaco/teseo"
+)
+
+// BreadcrumbHTMLOptions sets the class hooks and attributes of the visible
+// breadcrumb navigation rendered by BreadcrumbList.ToHTML. Empty classes are omitted.
+type BreadcrumbHTMLOptions struct {
+	AriaLabel    string // aria-label of the nav element, "breadcrumb" when empty
+	NavClass     string // class of the nav element
+	ListClass    string // class of the ol element
+	ItemClass    string // class of every li element
+	LinkClass    string // class of the links to the ancestor pages
+	CurrentClass string // class added to the li element of the current page
+	Separator    string // text rendered before every item but the first, inside its li and hidden from assistive technologies; leave empty to draw separators with CSS
+	Microdata    bool   // adds the BreadcrumbList microdata attributes
+}
+
+// ToHTML renders the BreadcrumbList as a visible breadcrumb navigation, so that
+// the links shown to users and the structured data come from the same items.
+// The last item is the current page: it is marked with aria-current="page" and
+// is not a link. Items whose URL is neither http(s) nor relative, such as
+// "javascript:" URLs, are rendered as plain text.
+//
+// Example usage:
+//
+//	templ Page(breadcrumb *schemaorg.BreadcrumbList) {
+//		@breadcrumb.ToJsonLd()
+//		@breadcrumb.ToHTML(schemaorg.BreadcrumbHTMLOptions{ItemClass: "breadcrumb-item", CurrentClass: "active"})
+//	}
+//
+// Expected output:
+//
+//	<nav aria-label="breadcrumb">
+//		<ol>
+//			<li class="breadcrumb-item"><a href="https://www.example.com"><span>Home</span></a></li>
+//			<li class="breadcrumb-item active" aria-current="page"><span>About Us</span></li>
+//		</ol>
+//	</nav>
+func (bcl *BreadcrumbList) ToHTML(opts ...BreadcrumbHTMLOptions) templ.Component {
+	var o BreadcrumbHTMLOptions
+	if len(opts) > 0 {
+		o = opts[0]
+	}
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		_, err := io.WriteString(w, bcl.navHTML(o))
+		return err
+	})
+}
+
+// ToGoHTML renders the BreadcrumbList as a visible breadcrumb navigation as
+// `template.HTML` value for Go's `html/template`.
+func (bcl *BreadcrumbList) ToGoHTML(opts ...BreadcrumbHTMLOptions) (template.HTML, error) {
+	return teseo.RenderToHTML(bcl.ToHTML(opts...))
+}
+
+// navHTML returns the markup of the breadcrumb navigation.
+func (bcl *BreadcrumbList) navHTML(o BreadcrumbHTMLOptions) string {
+	ariaLabel := o.AriaLabel
+	if ariaLabel == "" {
+		ariaLabel = "breadcrumb"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(`<nav aria-label="` + html.EscapeString(ariaLabel) + `"`)
+	writeClass(&sb, o.NavClass)
+	sb.WriteString(`><ol`)
+	writeClass(&sb, o.ListClass)
+	if o.Microdata {
+		sb.WriteString(` itemscope itemtype="https://schema.org/BreadcrumbList"`)
+	}
+	sb.WriteString(`>`)
+
+	last := len(bcl.ItemListElement) - 1
+	for i, item := range bcl.ItemListElement {
+		position := item.Position
+		if position == 0 {
+			position = i + 1
+		}
+		href := safeHref(item.ItemURL())
+		name := html.EscapeString(item.Name)
+
+		sb.WriteString(`<li`)
+		if i == last {
+			writeClass(&sb, strings.TrimSpace(o.ItemClass+" "+o.CurrentClass))
+			sb.WriteString(` aria-current="page"`)
+		} else {
+			writeClass(&sb, o.ItemClass)
+		}
+		if o.Microdata {
+			sb.WriteString(` itemprop="itemListElement" itemscope itemtype="https://schema.org/ListItem"`)
+		}
+		sb.WriteString(`>`)
+		if i > 0 && o.Separator != "" {
+			sb.WriteString(`<span aria-hidden="true">` + html.EscapeString(o.Separator) + `</span>`)
+		}
+
+		switch {
+		case i == last || href == "":
+			sb.WriteString(`<span` + microdataProp(o.Microdata, "name") + `>` + name + `</span>`)
+			if o.Microdata && href != "" {
+				sb.WriteString(`<link itemprop="item" href="` + html.EscapeString(href) + `">`)
+			}
+		default:
+			sb.WriteString(`<a href="` + html.EscapeString(href) + `"`)
+			writeClass(&sb, o.LinkClass)
+			sb.WriteString(microdataProp(o.Microdata, "item") + `><span` + microdataProp(o.Microdata, "name") + `>` + name + `</span></a>`)
+		}
+		if o.Microdata {
+			sb.WriteString(`<meta itemprop="position" content="` + strconv.Itoa(position) + `">`)
+		}
+		sb.WriteString(`</li>`)
+	}
+
+	sb.WriteString(`</ol></nav>`)
+	return sb.String()
+}
+
+// safeHref returns href when it is an http(s) or a relative URL, and an empty
+// string otherwise, so that URLs such as "javascript:alert(1)" are never rendered
+// and the item is shown as plain text.
+func safeHref(href string) string {
+	href = strings.TrimSpace(href)
+	u, err := url.Parse(href)
+	if err != nil {
+		return ""
+	}
+	switch strings.ToLower(u.Scheme) {
+	case "", "http", "https":
+		return href
+	}
+	return ""
+}
+
+// writeClass writes the class attribute, if any.
+func writeClass(sb *strings.Builder, class string) {
+	if class != "" {
+		sb.WriteString(` class="` + html.EscapeString(class) + `"`)
+	}
+}
+
+// microdataProp returns the itemprop attribute when microdata is enabled.
+func microdataProp(enabled bool, prop string) string {
+	if !enabled {
+		return ""
+	}
+	return ` itemprop="` + prop + `"`
+}
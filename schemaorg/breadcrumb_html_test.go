@@ -0,0 +1,110 @@
+package schemaorg
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+func TestBreadcrumbList_ToHTML(t *testing.T) {
+	bcl := NewBreadcrumbList([]ListItem{
+		{Name: "Home", Item: "https://example.com", Position: 1},
+		{Name: "Books & Comics", Item: "https://example.com/books?a=1&b=2", Position: 2},
+		{Name: "Dune", Item: "https://example.com/books/dune", Position: 3},
+	})
+
+	tests := []struct {
+		name     string
+		opts     []BreadcrumbHTMLOptions
+		expected string
+	}{
+		{
+			name: "default",
+			expected: `<nav aria-label="breadcrumb"><ol>` +
+				`<li><a href="https://example.com"><span>Home</span></a></li>` +
+				`<li><a href="https://example.com/books?a=1&amp;b=2"><span>Books &amp; Comics</span></a></li>` +
+				`<li aria-current="page"><span>Dune</span></li>` +
+				`</ol></nav>`,
+		},
+		{
+			name: "class hooks and separator",
+			opts: []BreadcrumbHTMLOptions{{
+				AriaLabel:    "Fil d'Ariane",
+				NavClass:     "crumbs",
+				ListClass:    "breadcrumb",
+				ItemClass:    "breadcrumb-item",
+				LinkClass:    "link",
+				CurrentClass: "active",
+				Separator:    "/",
+			}},
+			expected: `<nav aria-label="Fil d&#39;Ariane" class="crumbs"><ol class="breadcrumb">` +
+				`<li class="breadcrumb-item"><a href="https://example.com" class="link"><span>Home</span></a></li>` +
+				`<li class="breadcrumb-item"><span aria-hidden="true">/</span><a href="https://example.com/books?a=1&amp;b=2" class="link"><span>Books &amp; Comics</span></a></li>` +
+				`<li class="breadcrumb-item active" aria-current="page"><span aria-hidden="true">/</span><span>Dune</span></li>` +
+				`</ol></nav>`,
+		},
+		{
+			name: "microdata",
+			opts: []BreadcrumbHTMLOptions{{Microdata: true}},
+			expected: `<nav aria-label="breadcrumb"><ol itemscope itemtype="https://schema.org/BreadcrumbList">` +
+				`<li itemprop="itemListElement" itemscope itemtype="https://schema.org/ListItem"><a href="https://example.com" itemprop="item"><span itemprop="name">Home</span></a><meta itemprop="position" content="1"></li>` +
+				`<li itemprop="itemListElement" itemscope itemtype="https://schema.org/ListItem"><a href="https://example.com/books?a=1&amp;b=2" itemprop="item"><span itemprop="name">Books &amp; Comics</span></a><meta itemprop="position" content="2"></li>` +
+				`<li aria-current="page" itemprop="itemListElement" itemscope itemtype="https://schema.org/ListItem"><span itemprop="name">Dune</span><link itemprop="item" href="https://example.com/books/dune"><meta itemprop="position" content="3"></li>` +
+				`</ol></nav>`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var sb strings.Builder
+			if err := bcl.ToHTML(tt.opts...).Render(context.Background(), &sb); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if sb.String() != tt.expected {
+				t.Errorf("expected\n%s\ngot\n%s", tt.expected, sb.String())
+			}
+		})
+	}
+}
+
+func TestBreadcrumbList_ToGoHTML(t *testing.T) {
+	bcl, err := NewBreadcrumbListFromUrl("https://example.com/about")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	html, err := bcl.ToGoHTML()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `<nav aria-label="breadcrumb"><ol><li><a href="https://example.com"><span>Home</span></a></li><li aria-current="page"><span>About</span></li></ol></nav>`
+	if string(html) != expected {
+		t.Errorf("expected %s, got %s", expected, html)
+	}
+}
+
+func TestBreadcrumbList_ToHTML_UnsafeURLs(t *testing.T) {
+	bcl := NewBreadcrumbList([]ListItem{
+		{Name: "Home", Item: "/", Position: 1},
+		{Name: "Script", Item: "javascript:alert(1)", Position: 2},
+		{Name: "Spaced", Item: " JavaScript:alert(1)", Position: 3},
+		{Name: "Data", Item: "data:text/html,<script>alert(1)</script>", Position: 4},
+		{Name: "Current", Item: "javascript:alert(2)", Position: 5},
+	})
+	expected := `<nav aria-label="breadcrumb"><ol>` +
+		`<li><a href="/"><span>Home</span></a></li>` +
+		`<li><span>Script</span></li>` +
+		`<li><span>Spaced</span></li>` +
+		`<li><span>Data</span></li>` +
+		`<li aria-current="page"><span>Current</span></li>` +
+		`</ol></nav>`
+	html, err := bcl.ToGoHTML(BreadcrumbHTMLOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(html) != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, html)
+	}
+	if strings.Contains(string(html), "javascript") {
+		t.Errorf("expected no javascript URL in %s", html)
+	}
+}
@@ -0,0 +1,138 @@
+package schemaorg
+
+import (
+	"fmt"
+	"html/template"
+
+	"github.com/a-h/templ"
+	"github.com/indaco/teseo"
+)
+
+// BreadcrumbTrails holds the BreadcrumbList trails of a page reachable through
+// several category paths. The trails are rendered as an array in a single JSON-LD script.
+// For more details see: https://developers.google.com/search/docs/appearance/structured-data/breadcrumb
+//
+// Example usage:
+//
+//	trails := schemaorg.NewBreadcrumbTrails(
+//		schemaorg.NewBreadcrumbList([]schemaorg.ListItem{
+//			{Name: "Books", Item: "https://www.example.com/books", Position: 1},
+//			{Name: "Science Fiction", Item: "https://www.example.com/books/sciencefiction", Position: 2},
+//			{Name: "Dune", Item: "https://www.example.com/books/dune", Position: 3},
+//		}),
+//		schemaorg.NewBreadcrumbList([]schemaorg.ListItem{
+//			{Name: "Literature", Item: "https://www.example.com/literature", Position: 1},
+//			{Name: "Dune", Item: "https://www.example.com/books/dune", Position: 2},
+//		}),
+//	)
+//
+//	templ Page() {
+//		@trails.ToJsonLd()
+//	}
+//
+// Expected output:
+//
+//	[
+//		{
+//			"@context": "https://schema.org",
+//			"@type": "BreadcrumbList",
+//			"itemListElement": [
+//				{"@type": "ListItem", "position": 1, "name": "Books", "item": "https://www.example.com/books"},
+//				{"@type": "ListItem", "position": 2, "name": "Science Fiction", "item": "https://www.example.com/books/sciencefiction"},
+//				{"@type": "ListItem", "position": 3, "name": "Dune", "item": "https://www.example.com/books/dune"}
+//			]
+//		},
+//		{
+//			"@context": "https://schema.org",
+//			"@type": "BreadcrumbList",
+//			"itemListElement": [
+//				{"@type": "ListItem", "position": 1, "name": "Literature", "item": "https://www.example.com/literature"},
+//				{"@type": "ListItem", "position": 2, "name": "Dune", "item": "https://www.example.com/books/dune"}
+//			]
+//		}
+//	]
+type BreadcrumbTrails []*BreadcrumbList
+
+// NewBreadcrumbTrails groups the given trails, skipping nil values.
+func NewBreadcrumbTrails(trails ...*BreadcrumbList) BreadcrumbTrails {
+	var list BreadcrumbTrails
+	for _, trail := range trails {
+		if trail != nil {
+			list = append(list, trail)
+		}
+	}
+	list.ensureDefaults()
+	return list
+}
+
+// Validate checks every trail and that all the trails end at the same item
+// with positions that are contiguous and start at 1.
+func (bt BreadcrumbTrails) Validate() []string {
+	return issueMessages(bt.ValidationIssues())
+}
+
+// ValidationIssues returns the issues found by Validate on the BreadcrumbTrails as structured values.
+func (bt BreadcrumbTrails) ValidationIssues() []teseo.ValidationIssue {
+	var found issues
+
+	if len(bt) == 0 {
+		found.addf(teseo.SeverityRequired, teseo.RuleMissingField, "", "BreadcrumbTrails should contain at least one trail")
+		return found
+	}
+
+	var lastItem ListItem
+	first := -1
+	for i, trail := range bt {
+		if trail == nil {
+			found.addf(teseo.SeverityRequired, teseo.RuleMissingField, fmt.Sprintf("trails[%d]", i), "trails[%d]: missing BreadcrumbList", i)
+			continue
+		}
+		found.nested(fmt.Sprintf("trails[%d]", i), trail.ValidationIssues())
+		for j, item := range trail.ItemListElement {
+			if item.Position != 0 && item.Position != j+1 {
+				found.warnf(teseo.RuleConstraint, fmt.Sprintf("trails[%d].itemListElement[%d].position", i, j), "trails[%d]: positions must be contiguous and start at 1, expected %d, got %d", i, j+1, item.Position)
+				break
+			}
+		}
+
+		if len(trail.ItemListElement) == 0 {
+			continue
+		}
+		end := trail.ItemListElement[len(trail.ItemListElement)-1]
+		if first < 0 {
+			first, lastItem = i, end
+			continue
+		}
+		// The current page may be listed without a URL, so its name is compared instead.
+		endID, lastID := end.ItemURL(), lastItem.ItemURL()
+		if endID == "" || lastID == "" {
+			endID, lastID = end.Name, lastItem.Name
+		}
+		if endID != lastID {
+			found.warnf(teseo.RuleConstraint, fmt.Sprintf("trails[%d]", i), "trails[%d]: ends at %q, but trails[%d] ends at %q", i, endID, first, lastID)
+		}
+	}
+
+	return found
+}
+
+// ToJsonLd converts the BreadcrumbTrails to a JSON-LD `templ.Component` holding all the trails.
+func (bt BreadcrumbTrails) ToJsonLd() templ.Component {
+	trails := NewBreadcrumbTrails(bt...)
+	id := fmt.Sprintf("%s-%s", "breadcrumbTrails", teseo.GenerateUniqueKey())
+	return templ.JSONScript(id, []*BreadcrumbList(trails)).WithType("application/ld+json")
+}
+
+// ToGoHTMLJsonLd renders the BreadcrumbTrails as `template.HTML` value for Go's `html/template`.
+func (bt BreadcrumbTrails) ToGoHTMLJsonLd() (template.HTML, error) {
+	return teseo.RenderToHTML(bt.ToJsonLd())
+}
+
+// ensureDefaults sets default values for every trail.
+func (bt BreadcrumbTrails) ensureDefaults() {
+	for _, trail := range bt {
+		if trail != nil {
+			trail.ensureDefaults()
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package schemaorg
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestNewBreadcrumbTrails_SkipsNil(t *testing.T) {
+	trails := NewBreadcrumbTrails(nil, &BreadcrumbList{ItemListElement: []ListItem{{Name: "Home", Item: "https://example.com", Position: 1}}})
+	if len(trails) != 1 {
+		t.Fatalf("expected 1 trail, got %d", len(trails))
+	}
+	if trails[0].Context != "https://schema.org" || trails[0].Type != "BreadcrumbList" || trails[0].ItemListElement[0].Type != "ListItem" {
+		t.Errorf("expected defaults to be set, got %#v", trails[0])
+	}
+}
+
+func TestBreadcrumbTrails_Validate(t *testing.T) {
+	books := NewBreadcrumbList([]ListItem{
+		{Name: "Books", Item: "https://example.com/books", Position: 1},
+		{Name: "Science Fiction", Item: "https://example.com/books/sciencefiction", Position: 2},
+		{Name: "Dune", Item: "https://example.com/books/dune", Position: 3},
+	})
+	literature := NewBreadcrumbList([]ListItem{
+		{Name: "Literature", Item: "https://example.com/literature", Position: 1},
+		{Name: "Dune", Item: "https://example.com/books/dune", Position: 2},
+	})
+
+	tests := []struct {
+		name     string
+		trails   BreadcrumbTrails
+		expected []string
+	}{
+		{
+			name:   "valid",
+			trails: BreadcrumbTrails{books, literature},
+		},
+		{
+			name:     "empty",
+			expected: []string{"BreadcrumbTrails should contain at least one trail"},
+		},
+		{
+			name: "different last item",
+			trails: BreadcrumbTrails{books, NewBreadcrumbList([]ListItem{
+				{Name: "Literature", Item: "https://example.com/literature", Position: 1},
+			})},
+			expected: []string{`trails[1]: ends at "https://example.com/literature", but trails[0] ends at "https://example.com/books/dune"`},
+		},
+		{
+			name: "current page without URL",
+			trails: BreadcrumbTrails{
+				NewBreadcrumbList([]ListItem{{Name: "Books", Item: "https://example.com/books", Position: 1}, {Name: "Dune", Position: 2}}),
+				NewBreadcrumbList([]ListItem{{Name: "Literature", Item: "https://example.com/literature", Position: 1}, {Name: "Dune", Position: 2}}),
+			},
+			expected: []string{
+				"trails[0]: ListItem at position 2 is missing a URL",
+				"trails[1]: ListItem at position 2 is missing a URL",
+			},
+		},
+		{
+			name: "different current page without URL",
+			trails: BreadcrumbTrails{
+				NewBreadcrumbList([]ListItem{{Name: "Books", Item: "https://example.com/books", Position: 1}, {Name: "Dune", Position: 2}}),
+				NewBreadcrumbList([]ListItem{{Name: "Literature", Item: "https://example.com/literature", Position: 1}, {Name: "Dune Messiah", Position: 2}}),
+			},
+			expected: []string{
+				"trails[0]: ListItem at position 2 is missing a URL",
+				"trails[1]: ListItem at position 2 is missing a URL",
+				`trails[1]: ends at "Dune Messiah", but trails[0] ends at "Dune"`,
+			},
+		},
+		{
+			name: "positions not contiguous",
+			trails: BreadcrumbTrails{books, NewBreadcrumbList([]ListItem{
+				{Name: "Literature", Item: "https://example.com/literature", Position: 2},
+				{Name: "Dune", Item: "https://example.com/books/dune", Position: 3},
+			})},
+			expected: []string{"trails[1]: positions must be contiguous and start at 1, expected 1, got 2"},
+		},
+		{
+			name: "invalid trail",
+			trails: BreadcrumbTrails{nil, NewBreadcrumbList([]ListItem{
+				{Item: "https://example.com/books/dune", Position: 1},
+			}), literature},
+			expected: []string{
+				"trails[0]: missing BreadcrumbList",
+				"trails[1]: ListItem at position 1 is missing a name",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if w := tt.trails.Validate(); !reflect.DeepEqual(w, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, w)
+			}
+		})
+	}
+}
+
+func TestBreadcrumbTrails_ToGoHTMLJsonLd(t *testing.T) {
+	trails := BreadcrumbTrails{
+		{ItemListElement: []ListItem{{Name: "Books", Item: "https://example.com/books", Position: 1}}},
+		nil,
+		{ItemListElement: []ListItem{{Name: "Literature", Item: "https://example.com/literature", Position: 1}}},
+	}
+	html, err := trails.ToGoHTMLJsonLd()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	got := string(html)
+	if strings.Count(got, "<script") != 1 {
+		t.Errorf("expected a single script, got %s", got)
+	}
+	if !strings.Contains(got, `>[{"@context":"https://schema.org","@type":"BreadcrumbList"`) || strings.Contains(got, "null") {
+		t.Errorf("expected an array of BreadcrumbList without null trails, got %s", got)
+	}
+	if strings.Count(got, `"@type":"BreadcrumbList"`) != 2 {
+		t.Errorf("expected 2 trails, got %s", got)
+	}
+}
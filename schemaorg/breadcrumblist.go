@@ -1,10 +1,9 @@
 package schemaorg
 
 import (
+	"encoding/json"
 	"fmt"
 	"html/template"
-	"net/url"
-	"strings"
 	"unicode"
 
 	"github.com/a-h/templ"
@@ -75,6 +74,7 @@ type BreadcrumbList struct {
 	Context         string     `json:"@context"`
 	Type            string     `json:"@type"`
 	ItemListElement []ListItem `json:"itemListElement"`
+	Extra           Extra      `json:"-"`
 }
 
 // NewBreadcrumbList initializes a BreadcrumbList with default context and type.
@@ -88,9 +88,10 @@ func NewBreadcrumbList(listItem []ListItem) *BreadcrumbList {
 	return b
 }
 
-// NewBreadcrumbListFromUrl initializes an BreadcrumbList from the URL string.
+// NewBreadcrumbListFromUrl initializes an BreadcrumbList from the URL string,
+// using a BreadcrumbBuilder with its default settings.
 func NewBreadcrumbListFromUrl(url string) (*BreadcrumbList, error) {
-	bcl, err := createBreadcrumbListFromURL(url)
+	bcl, err := (&BreadcrumbBuilder{}).Build(url)
 	if err != nil {
 		return nil, fmt.Errorf("[NewBreadcrumbListFromUrl] invalid URL: %w", err)
 	}
@@ -99,25 +100,31 @@ func NewBreadcrumbListFromUrl(url string) (*BreadcrumbList, error) {
 
 // Validate checks if the BreadcrumbList has the required structure.
 func (bcl *BreadcrumbList) Validate() []string {
-	var warnings []string
+	return issueMessages(bcl.ValidationIssues())
+}
+
+// ValidationIssues returns the issues found by Validate on the BreadcrumbList as structured values.
+func (bcl *BreadcrumbList) ValidationIssues() []teseo.ValidationIssue {
+	var found issues
 
 	if len(bcl.ItemListElement) == 0 {
-		warnings = append(warnings, "BreadcrumbList should contain at least one item")
+		found.addf(teseo.SeverityRequired, teseo.RuleMissingField, "itemListElement", "BreadcrumbList should contain at least one item")
 	}
 
 	for i, item := range bcl.ItemListElement {
 		if item.Name == "" {
-			warnings = append(warnings, fmt.Sprintf("ListItem at position %d is missing a name", i+1))
+			found.addf(teseo.SeverityRequired, teseo.RuleMissingField, fmt.Sprintf("itemListElement[%d].name", i), "ListItem at position %d is missing a name", i+1)
 		}
-		if item.Item == "" {
-			warnings = append(warnings, fmt.Sprintf("ListItem at position %d is missing a URL", i+1))
+		if !item.hasItem() {
+			found.warnf(teseo.RuleMissingField, fmt.Sprintf("itemListElement[%d].item", i), "ListItem at position %d is missing a URL", i+1)
 		}
 		if item.Position == 0 {
-			warnings = append(warnings, fmt.Sprintf("ListItem at position %d is missing a valid position", i+1))
+			found.addf(teseo.SeverityRequired, teseo.RuleMissingField, fmt.Sprintf("itemListElement[%d].position", i), "ListItem at position %d is missing a valid position", i+1)
 		}
 	}
 
-	return warnings
+	found.add(validateFormats(bcl)...)
+	return found
 }
 
 // ToJsonLd converts the BreadcrumbList struct to a JSON-LD `templ.Component`.
@@ -132,6 +139,26 @@ func (bcl *BreadcrumbList) ToGoHTMLJsonLd() (template.HTML, error) {
 	return teseo.RenderToHTML(bcl.ToJsonLd())
 }
 
+// MarshalJSON encodes a BreadcrumbList, merging the Extra properties into the JSON-LD object.
+func (bcl BreadcrumbList) MarshalJSON() ([]byte, error) {
+	type alias BreadcrumbList
+	return marshalWithExtra(alias(bcl), bcl.Extra)
+}
+
+// UnmarshalJSON decodes a BreadcrumbList, capturing properties without a dedicated field into Extra.
+func (bcl *BreadcrumbList) UnmarshalJSON(data []byte) error {
+	type alias BreadcrumbList
+	if err := json.Unmarshal(data, (*alias)(bcl)); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, (*alias)(bcl))
+	if err != nil {
+		return err
+	}
+	bcl.Extra = extra
+	return nil
+}
+
 func (bcl *BreadcrumbList) ensureDefaults() {
 	if bcl.Context == "" {
 		bcl.Context = "https://schema.org"
@@ -141,60 +168,9 @@ func (bcl *BreadcrumbList) ensureDefaults() {
 		bcl.Type = "BreadcrumbList"
 	}
 
-	// Loop over each ListItem in ile and set its Type to "ListItem"
 	for i := range bcl.ItemListElement {
-		if bcl.ItemListElement[i].Type == "" {
-			bcl.ItemListElement[i].Type = "ListItem"
-		}
-	}
-}
-
-// createBreadcrumbListFromURL generates a BreadcrumbList JSON-LD object from a URL string.
-func createBreadcrumbListFromURL(rawURL string) (*BreadcrumbList, error) {
-	parsedURL, err := url.Parse(rawURL)
-	if err != nil {
-		return nil, fmt.Errorf("[createBreadcrumbListFromURL] invalid URL: %w", err)
-	}
-
-	// Extract segments from the URL path.
-	segments := strings.Split(strings.Trim(parsedURL.Path, "/"), "/")
-
-	// Initialize the base URL correctly.
-	baseURL := parsedURL.Scheme + "://" + parsedURL.Host
-
-	var listItems []ListItem
-
-	// Always include the base URL as the first breadcrumb item.
-	listItems = append(listItems, ListItem{
-		Type:     "ListItem",
-		Position: 1,
-		Name:     "Home",
-		Item:     baseURL,
-	})
-
-	// Check if there are additional segments beyond the base URL.
-	if len(segments) > 0 && segments[0] != "" {
-		// Build the ListItem slice for JSON-LD
-		for i, segment := range segments {
-			// Correctly concatenate the base URL with the segments.
-			href := baseURL + "/" + strings.Join(segments[:i+1], "/")
-			listItems = append(listItems, ListItem{
-				Type:     "ListItem",
-				Position: i + 2, // Start from 2 because the base URL is already position 1
-				Name:     toTitle(segment),
-				Item:     href,
-			})
-		}
+		bcl.ItemListElement[i].ensureDefaults()
 	}
-
-	// Create and return the BreadcrumbList object
-	breadcrumbList := &BreadcrumbList{
-		Context:         "https://schema.org",
-		Type:            "BreadcrumbList",
-		ItemListElement: listItems,
-	}
-
-	return breadcrumbList, nil
 }
 
 // ToTitle converts the first letter of a string to its title case equivalent.
@@ -0,0 +1,288 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+
+	"github.com/a-h/templ"
+	"github.com/indaco/teseo"
+)
+
+// CourseMode represents the medium or means of delivery of a CourseInstance.
+// For more details see: https://schema.org/courseMode
+type CourseMode string
+
+const (
+	CourseModeOnline  CourseMode = "Online"
+	CourseModeOnsite  CourseMode = "Onsite"
+	CourseModeBlended CourseMode = "Blended"
+)
+
+// Course represents a Schema.org Course object.
+// For more details about the meaning of the properties see: https://schema.org/Course
+//
+// Example usage:
+//
+// Pure struct usage:
+//
+//	course := &schemaorg.Course{
+//		Name:        "Introduction to Go",
+//		Description: "Learn the fundamentals of the Go programming language.",
+//		CourseCode:  "GO101",
+//		Provider:    &schemaorg.EducationalOrganization{Name: "Example University", URL: "https://www.example.edu"},
+//		HasCourseInstance: []*schemaorg.CourseInstance{
+//			{
+//				CourseMode:     schemaorg.CourseModeOnline,
+//				CourseWorkload: "PT22H",
+//				Instructor:     []*schemaorg.Person{{Name: "Jane Doe"}},
+//			},
+//		},
+//		Offers: []*schemaorg.Offer{{Category: "Paid", Price: "49.00", PriceCurrency: "USD"}},
+//	}
+//
+// Factory method usage:
+//
+//	course := schemaorg.NewCourse(
+//		"Introduction to Go",
+//		"Learn the fundamentals of the Go programming language.",
+//		"GO101",
+//		&schemaorg.EducationalOrganization{Name: "Example University", URL: "https://www.example.edu"},
+//		[]*schemaorg.CourseInstance{
+//			schemaorg.NewCourseInstance(schemaorg.CourseModeOnline, "PT22H", nil, []*schemaorg.Person{{Name: "Jane Doe"}}),
+//		},
+//		[]*schemaorg.Offer{{Category: "Paid", Price: "49.00", PriceCurrency: "USD"}},
+//	)
+//
+// // Rendering JSON-LD using templ:
+//
+//	templ Page() {
+//		@course.ToJsonLd()
+//	}
+//
+// // Rendering JSON-LD as `template.HTML` value:
+//
+//	jsonLdHtml := course.ToGoHTMLJsonLd()
+//
+// Expected output:
+//
+//	{
+//		"@context": "https://schema.org",
+//		"@type": "Course",
+//		"name": "Introduction to Go",
+//		"description": "Learn the fundamentals of the Go programming language.",
+//		"courseCode": "GO101",
+//		"provider": {
+//			"@context": "https://schema.org",
+//			"@type": "EducationalOrganization",
+//			"name": "Example University",
+//			"url": "https://www.example.edu"
+//		},
+//		"hasCourseInstance": [
+//			{
+//				"@type": "CourseInstance",
+//				"courseMode": "Online",
+//				"courseWorkload": "PT22H",
+//				"instructor": [{"@context": "https://schema.org", "@type": "Person", "name": "Jane Doe"}]
+//			}
+//		],
+//		"offers": [{"@type": "Offer", "price": "49.00", "priceCurrency": "USD", "category": "Paid"}]
+//	}
+type Course struct {
+	Context             string                   `json:"@context"`
+	Type                string                   `json:"@type"`
+	Name                string                   `json:"name,omitempty"`
+	Description         string                   `json:"description,omitempty"`
+	URL                 string                   `json:"url,omitempty"`
+	CourseCode          string                   `json:"courseCode,omitempty"`
+	Image               Images                   `json:"image,omitempty"`
+	InLanguage          string                   `json:"inLanguage,omitempty"`
+	EducationalLevel    string                   `json:"educationalLevel,omitempty"`
+	CoursePrerequisites StringList               `json:"coursePrerequisites,omitempty"`
+	Provider            *EducationalOrganization `json:"provider,omitempty"`
+	HasCourseInstance   []*CourseInstance        `json:"hasCourseInstance,omitempty"`
+	Offers              []*Offer                 `json:"offers,omitempty"`
+	AggregateRating     *AggregateRating         `json:"aggregateRating,omitempty"`
+	Extra               Extra                    `json:"-"`
+}
+
+// CourseInstance represents a Schema.org CourseInstance object
+// For more details about the meaning of the properties see: https://schema.org/CourseInstance
+type CourseInstance struct {
+	Type           string         `json:"@type"`
+	Name           string         `json:"name,omitempty"`
+	CourseMode     CourseMode     `json:"courseMode,omitempty"`
+	CourseWorkload teseo.Duration `json:"courseWorkload,omitempty"`
+	CourseSchedule *Schedule      `json:"courseSchedule,omitempty"`
+	StartDate      teseo.DateTime `json:"startDate,omitempty"`
+	EndDate        teseo.DateTime `json:"endDate,omitempty"`
+	Instructor     []*Person      `json:"instructor,omitempty"`
+	Location       *Place         `json:"location,omitempty"`
+}
+
+// NewCourse initializes a Course with default context and type.
+func NewCourse(name, description, courseCode string, provider *EducationalOrganization, instances []*CourseInstance, offers []*Offer) *Course {
+	course := &Course{
+		Name:              name,
+		Description:       description,
+		CourseCode:        courseCode,
+		Provider:          provider,
+		HasCourseInstance: instances,
+		Offers:            offers,
+	}
+	course.ensureDefaults()
+	return course
+}
+
+// NewCourseInstance initializes a CourseInstance with default type.
+func NewCourseInstance(courseMode CourseMode, courseWorkload string, courseSchedule *Schedule, instructors []*Person) *CourseInstance {
+	instance := &CourseInstance{
+		CourseMode:     courseMode,
+		CourseWorkload: teseo.Duration(courseWorkload),
+		CourseSchedule: courseSchedule,
+		Instructor:     instructors,
+	}
+	instance.ensureDefaults()
+	return instance
+}
+
+// Validate checks if the Course has the required and recommended fields
+// for course rich results and the course list carousel.
+func (c *Course) Validate() []string {
+	return issueMessages(c.ValidationIssues())
+}
+
+// ValidationIssues returns the issues found by Validate on the Course as structured values.
+func (c *Course) ValidationIssues() []teseo.ValidationIssue {
+	var found issues
+
+	if c.Name == "" {
+		found.required("name")
+	}
+	if c.Description == "" {
+		found.required("description")
+	}
+	if c.Provider == nil || c.Provider.Name == "" {
+		found.recommended("provider.name")
+	}
+
+	for i, instance := range c.HasCourseInstance {
+		switch instance.CourseMode {
+		case "":
+			found.warnf(teseo.RuleMissingField, fmt.Sprintf("hasCourseInstance[%d].courseMode", i), "CourseInstance %d is missing courseMode", i+1)
+		case CourseModeOnline, CourseModeOnsite, CourseModeBlended:
+		default:
+			found.warnf(teseo.RuleUnknownValue, fmt.Sprintf("hasCourseInstance[%d].courseMode", i), "CourseInstance %d has unknown courseMode %q", i+1, instance.CourseMode)
+		}
+		if instance.CourseWorkload == "" && instance.CourseSchedule == nil {
+			found.warnf(teseo.RuleMissingField, fmt.Sprintf("hasCourseInstance[%d].courseWorkload", i), "CourseInstance %d is missing courseWorkload or courseSchedule", i+1)
+		}
+		if instance.CourseSchedule != nil && instance.CourseSchedule.RepeatFrequency == "" && instance.CourseSchedule.Duration == "" {
+			found.warnf(teseo.RuleMissingField, fmt.Sprintf("hasCourseInstance[%d].courseSchedule.repeatFrequency", i), "CourseInstance %d courseSchedule is missing repeatFrequency or duration", i+1)
+		}
+		if instance.CourseMode == CourseModeOnsite && instance.Location == nil {
+			found.warnf(teseo.RuleMissingField, fmt.Sprintf("hasCourseInstance[%d].location", i), "CourseInstance %d is onsite but missing location", i+1)
+		}
+		prefix := fmt.Sprintf("hasCourseInstance[%d]", i)
+		found.add(validateDuration(prefix+".courseWorkload", instance.CourseWorkload)...)
+		found.add(validateDateTime(prefix+".startDate", instance.StartDate)...)
+		found.add(validateDateTime(prefix+".endDate", instance.EndDate)...)
+		found.add(validateTimeOrder(prefix+".startDate", instance.StartDate, prefix+".endDate", instance.EndDate)...)
+		if s := instance.CourseSchedule; s != nil {
+			found.add(validateDuration(prefix+".courseSchedule.duration", s.Duration)...)
+			found.add(validateDateTime(prefix+".courseSchedule.startDate", s.StartDate)...)
+			found.add(validateDateTime(prefix+".courseSchedule.endDate", s.EndDate)...)
+			found.add(validateTimeOrder(prefix+".courseSchedule.startDate", s.StartDate, prefix+".courseSchedule.endDate", s.EndDate)...)
+		}
+	}
+
+	for i, offer := range c.Offers {
+		if offer.Category == "" {
+			found.warnf(teseo.RuleMissingField, fmt.Sprintf("offers[%d].category", i), "Offer %d is missing recommended field: category", i+1)
+		}
+	}
+	found.add(c.Image.validate("image")...)
+
+	found.add(validateFormats(c)...)
+	return found
+}
+
+// ToJsonLd converts the Course struct to a JSON-LD `templ.Component`.
+func (c *Course) ToJsonLd() templ.Component {
+	c.ensureDefaults()
+	id := fmt.Sprintf("%s-%s", "course", teseo.GenerateUniqueKey())
+	return templ.JSONScript(id, c).WithType("application/ld+json")
+}
+
+// ToGoHTMLJsonLd renders the Course struct as `template.HTML` value for Go's `html/template`.
+func (c *Course) ToGoHTMLJsonLd() (template.HTML, error) {
+	return teseo.RenderToHTML(c.ToJsonLd())
+}
+
+// MarshalJSON encodes a Course, merging the Extra properties into the JSON-LD object.
+func (c Course) MarshalJSON() ([]byte, error) {
+	type alias Course
+	return marshalWithExtra(alias(c), c.Extra)
+}
+
+// UnmarshalJSON decodes a Course, capturing properties without a dedicated field into Extra.
+func (c *Course) UnmarshalJSON(data []byte) error {
+	type alias Course
+	if err := json.Unmarshal(data, (*alias)(c)); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, (*alias)(c))
+	if err != nil {
+		return err
+	}
+	c.Extra = extra
+	return nil
+}
+
+// ensureDefaults sets default values for Course and its nested objects if they are not already set.
+func (c *Course) ensureDefaults() {
+	if c.Context == "" {
+		c.Context = "https://schema.org"
+	}
+
+	if c.Type == "" {
+		c.Type = "Course"
+	}
+
+	if c.Provider != nil {
+		c.Provider.ensureDefaults()
+	}
+
+	for _, instance := range c.HasCourseInstance {
+		instance.ensureDefaults()
+	}
+
+	for _, offer := range c.Offers {
+		offer.ensureDefaults()
+	}
+
+	if c.AggregateRating != nil {
+		c.AggregateRating.ensureDefaults()
+	}
+
+	c.Image.ensureDefaults()
+}
+
+// ensureDefaults sets default values for CourseInstance and its nested objects if they are not already set.
+func (ci *CourseInstance) ensureDefaults() {
+	if ci.Type == "" {
+		ci.Type = "CourseInstance"
+	}
+
+	if ci.CourseSchedule != nil {
+		ci.CourseSchedule.ensureDefaults()
+	}
+
+	for _, instructor := range ci.Instructor {
+		instructor.ensureDefaults()
+	}
+
+	if ci.Location != nil {
+		ci.Location.ensureDefaults()
+	}
+}
@@ -0,0 +1,175 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestNewCourse_SetsFieldsAndDefaults(t *testing.T) {
+	instance := NewCourseInstance(CourseModeOnline, "PT22H", nil, []*Person{{Name: "Jane Doe"}})
+	course := NewCourse(
+		"Introduction to Go",
+		"Learn Go",
+		"GO101",
+		&EducationalOrganization{Name: "Example University"},
+		[]*CourseInstance{instance},
+		[]*Offer{{Category: "Free"}},
+	)
+
+	if course.Context != "https://schema.org" {
+		t.Errorf("expected context schema.org, got %s", course.Context)
+	}
+	if course.Type != "Course" {
+		t.Errorf("expected type Course, got %s", course.Type)
+	}
+	if course.Provider.Type != "EducationalOrganization" {
+		t.Errorf("expected provider type EducationalOrganization, got %s", course.Provider.Type)
+	}
+	if instance.Type != "CourseInstance" {
+		t.Errorf("expected instance type CourseInstance, got %s", instance.Type)
+	}
+	if instance.Instructor[0].Type != "Person" {
+		t.Errorf("expected instructor type Person, got %s", instance.Instructor[0].Type)
+	}
+	if course.Offers[0].Type != "Offer" {
+		t.Errorf("expected offer type Offer, got %s", course.Offers[0].Type)
+	}
+}
+
+func TestCourseInstance_EnsureDefaults_WithNested(t *testing.T) {
+	ci := &CourseInstance{
+		CourseSchedule: &Schedule{},
+		Location:       &Place{},
+	}
+	ci.ensureDefaults()
+
+	if ci.CourseSchedule.Type != "Schedule" {
+		t.Errorf("expected schedule type Schedule, got %s", ci.CourseSchedule.Type)
+	}
+	if ci.Location.Type != "Place" {
+		t.Errorf("expected location type Place, got %s", ci.Location.Type)
+	}
+}
+
+func TestCourse_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		course   *Course
+		expected []string
+	}{
+		{
+			name: "all good",
+			course: &Course{
+				Name:        "Go",
+				Description: "Learn Go",
+				Provider:    &EducationalOrganization{Name: "Uni"},
+				HasCourseInstance: []*CourseInstance{
+					{CourseMode: CourseModeBlended, CourseSchedule: &Schedule{RepeatFrequency: "Weekly", RepeatCount: 10}},
+				},
+				Offers: []*Offer{{Category: "Paid"}},
+			},
+			expected: nil,
+		},
+		{
+			name:   "missing required fields",
+			course: &Course{},
+			expected: []string{
+				"missing required field: name",
+				"missing required field: description",
+				"missing recommended field: provider.name",
+			},
+		},
+		{
+			name: "invalid instances and offers",
+			course: &Course{
+				Name:        "Go",
+				Description: "Learn Go",
+				Provider:    &EducationalOrganization{Name: "Uni"},
+				HasCourseInstance: []*CourseInstance{
+					{},
+					{CourseMode: "Remote", CourseWorkload: "PT1H"},
+					{CourseMode: CourseModeOnsite, CourseSchedule: &Schedule{}},
+				},
+				Offers: []*Offer{{}},
+			},
+			expected: []string{
+				"CourseInstance 1 is missing courseMode",
+				"CourseInstance 1 is missing courseWorkload or courseSchedule",
+				`CourseInstance 2 has unknown courseMode "Remote"`,
+				"CourseInstance 3 courseSchedule is missing repeatFrequency or duration",
+				"CourseInstance 3 is onsite but missing location",
+				"Offer 1 is missing recommended field: category",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.course.Validate()
+			if len(got) != len(tt.expected) {
+				t.Fatalf("expected %d warnings, got %d: %v", len(tt.expected), len(got), got)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] {
+					t.Errorf("warning %d: expected %q, got %q", i, tt.expected[i], got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestCourse_MarshalJSON(t *testing.T) {
+	course := NewCourse("Go", "Learn Go", "", nil, []*CourseInstance{
+		NewCourseInstance(CourseModeOnline, "PT2H", nil, nil),
+	}, nil)
+
+	data, err := json.Marshal(course)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(string(data), `"courseMode":"Online"`) {
+		t.Errorf("expected courseMode in output, got %s", data)
+	}
+	if strings.Contains(string(data), "provider") {
+		t.Errorf("expected provider to be omitted, got %s", data)
+	}
+}
+
+func TestCourse_ToGoHTMLJsonLd(t *testing.T) {
+	course := NewCourse("Go", "Learn Go", "GO101", nil, nil, nil)
+	html, err := course.ToGoHTMLJsonLd()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if html == "" {
+		t.Errorf("expected non-empty HTML output")
+	}
+}
+
+func TestCourse_Decode_DateTimes(t *testing.T) {
+	things, err := Decode([]byte(`{
+		"@context": "https://schema.org",
+		"@type": "Course",
+		"name": "Go 101",
+		"hasCourseInstance": [{
+			"@type": "CourseInstance",
+			"courseMode": "Online",
+			"startDate": "2024-09-01T09:00:00Z",
+			"endDate": "2024-12-15",
+			"courseSchedule": {"@type": "Schedule", "repeatFrequency": "P1W", "startDate": "2024-09-01T09:00:00+02:00"}
+		}]
+	}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	instance := things[0].(*Course).HasCourseInstance[0]
+	if instance.StartDate != "2024-09-01T09:00:00Z" || instance.EndDate != "2024-12-15" {
+		t.Errorf("expected the dates to be decoded, got %q and %q", instance.StartDate, instance.EndDate)
+	}
+	for _, w := range things[0].(*Course).Validate() {
+		if strings.Contains(w, "ISO 8601") {
+			t.Errorf("expected dates and date-times to be valid, got %q", w)
+		}
+	}
+}
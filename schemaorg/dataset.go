@@ -0,0 +1,400 @@
+package schemaorg
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/a-h/templ"
+	"github.com/indaco/teseo"
+)
+
+// Dataset represents a Schema.org Dataset object.
+// For more details about the meaning of the properties see: https://schema.org/Dataset
+//
+// Example usage:
+//
+// Pure struct usage:
+//
+//	dataset := &schemaorg.Dataset{
+//		Name:             "NCDC Storm Events Database",
+//		Description:      "Storm Data is provided by the National Weather Service (NWS) and contain statistics on...",
+//		URL:              "https://catalog.example.com/dataset/ncdc-storms",
+//		License:          schemaorg.LicenseURL("https://creativecommons.org/publicdomain/zero/1.0/"),
+//		Creator:          &schemaorg.Organization{Name: "NOAA", URL: "https://www.ncdc.noaa.gov/"},
+//		TemporalCoverage: "1950-01-01/2013-12-18",
+//		SpatialCoverage: &schemaorg.Place{
+//			Geo: &schemaorg.GeoShape{Box: "18.0 -122.0 49.0 -66.0"},
+//		},
+//		Distribution: []*schemaorg.DataDownload{
+//			{EncodingFormat: "CSV", ContentURL: "https://www.ncdc.noaa.gov/stormevents/ftp.jsp"},
+//		},
+//		IncludedInDataCatalog: &schemaorg.DataCatalog{Name: "data.gov"},
+//	}
+//
+// Factory method usage:
+//
+//	dataset := schemaorg.NewDataset(
+//		"NCDC Storm Events Database",
+//		"Storm Data is provided by the National Weather Service (NWS) and contain statistics on...",
+//		"https://catalog.example.com/dataset/ncdc-storms",
+//		"https://creativecommons.org/publicdomain/zero/1.0/",
+//		&schemaorg.Organization{Name: "NOAA", URL: "https://www.ncdc.noaa.gov/"},
+//		[]*schemaorg.DataDownload{
+//			{EncodingFormat: "CSV", ContentURL: "https://www.ncdc.noaa.gov/stormevents/ftp.jsp"},
+//		},
+//	)
+//
+// // Rendering JSON-LD using templ:
+//
+//	templ Page() {
+//		@dataset.ToJsonLd()
+//	}
+//
+// // Rendering JSON-LD as `template.HTML` value:
+//
+//	jsonLdHtml := dataset.ToGoHTMLJsonLd()
+//
+// Expected output:
+//
+//	{
+//		"@context": "https://schema.org",
+//		"@type": "Dataset",
+//		"name": "NCDC Storm Events Database",
+//		"description": "Storm Data is provided by the National Weather Service (NWS) and contain statistics on...",
+//		"url": "https://catalog.example.com/dataset/ncdc-storms",
+//		"license": "https://creativecommons.org/publicdomain/zero/1.0/",
+//		"creator": {"@context": "https://schema.org", "@type": "Organization", "name": "NOAA", "url": "https://www.ncdc.noaa.gov/"},
+//		"distribution": [
+//			{"@type": "DataDownload", "encodingFormat": "CSV", "contentUrl": "https://www.ncdc.noaa.gov/stormevents/ftp.jsp"}
+//		]
+//	}
+type Dataset struct {
+	Context               string           `json:"@context"`
+	Type                  string           `json:"@type"`
+	Name                  string           `json:"name,omitempty"`
+	AlternateName         StringList       `json:"alternateName,omitempty"`
+	Description           string           `json:"description,omitempty"`
+	URL                   string           `json:"url,omitempty"`
+	SameAs                StringList       `json:"sameAs,omitempty"`
+	Identifier            StringList       `json:"identifier,omitempty"`
+	Keywords              StringList       `json:"keywords,omitempty"`
+	License               License          `json:"license,omitempty"`
+	IsAccessibleForFree   *bool            `json:"isAccessibleForFree,omitempty"`
+	Version               string           `json:"version,omitempty"`
+	Citation              StringList       `json:"citation,omitempty"`
+	Creator               Agent            `json:"creator,omitempty"`
+	Funder                Agent            `json:"funder,omitempty"`
+	TemporalCoverage      string           `json:"temporalCoverage,omitempty"`
+	SpatialCoverage       *Place           `json:"spatialCoverage,omitempty"`
+	VariableMeasured      []*PropertyValue `json:"variableMeasured,omitempty"`
+	MeasurementTechnique  StringList       `json:"measurementTechnique,omitempty"`
+	Distribution          []*DataDownload  `json:"distribution,omitempty"`
+	IncludedInDataCatalog *DataCatalog     `json:"includedInDataCatalog,omitempty"`
+	Extra                 Extra            `json:"-"`
+}
+
+// DataDownload represents a Schema.org DataDownload object
+// For more details about the meaning of the properties see: https://schema.org/DataDownload
+type DataDownload struct {
+	Type           string `json:"@type"`
+	EncodingFormat string `json:"encodingFormat,omitempty"`
+	ContentURL     string `json:"contentUrl,omitempty"`
+}
+
+// License is implemented by the values accepted as the license of a Dataset:
+// a LicenseURL or a *CreativeWork describing the license.
+//
+// Example usage:
+//
+//	dataset.License = schemaorg.LicenseURL("https://creativecommons.org/licenses/by/4.0/")
+//
+//	dataset.License = &schemaorg.CreativeWork{Name: "CC BY 4.0", URL: "https://creativecommons.org/licenses/by/4.0/"}
+type License interface {
+	ensureDefaults()
+	isLicense()
+}
+
+func (LicenseURL) isLicense()    {}
+func (*CreativeWork) isLicense() {}
+
+// LicenseURL is the URL of a license, rendered as a plain JSON string.
+type LicenseURL string
+
+// ensureDefaults is a no-op, a LicenseURL has no default values.
+func (LicenseURL) ensureDefaults() {}
+
+// CreativeWork represents a Schema.org CreativeWork object, as used to describe a license.
+// For more details about the meaning of the properties see: https://schema.org/CreativeWork
+type CreativeWork struct {
+	Type  string `json:"@type"`
+	Name  string `json:"name,omitempty"`
+	URL   string `json:"url,omitempty"`
+	Extra Extra  `json:"-"`
+}
+
+// MarshalJSON encodes a CreativeWork, merging the Extra properties into the JSON-LD object.
+func (cw CreativeWork) MarshalJSON() ([]byte, error) {
+	type alias CreativeWork
+	return marshalWithExtra(alias(cw), cw.Extra)
+}
+
+// UnmarshalJSON decodes a CreativeWork, capturing properties without a dedicated field into Extra.
+func (cw *CreativeWork) UnmarshalJSON(data []byte) error {
+	type alias CreativeWork
+	if err := json.Unmarshal(data, (*alias)(cw)); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, (*alias)(cw))
+	if err != nil {
+		return err
+	}
+	cw.Extra = extra
+	return nil
+}
+
+// ensureDefaults sets default values for CreativeWork if they are not already set.
+func (cw *CreativeWork) ensureDefaults() {
+	if cw.Type == "" {
+		cw.Type = "CreativeWork"
+	}
+}
+
+// unmarshalLicense decodes a license given as a URL string or a CreativeWork object.
+func unmarshalLicense(data json.RawMessage) (License, error) {
+	data = bytes.TrimSpace(data)
+	switch {
+	case len(data) == 0 || string(data) == "null":
+		return nil, nil
+	case data[0] == '"':
+		var url string
+		if err := json.Unmarshal(data, &url); err != nil {
+			return nil, err
+		}
+		if url = strings.TrimSpace(url); url == "" {
+			return nil, nil
+		}
+		return LicenseURL(url), nil
+	case data[0] == '{':
+		cw := &CreativeWork{}
+		if err := json.Unmarshal(data, cw); err != nil {
+			return nil, err
+		}
+		return cw, nil
+	}
+	return nil, fmt.Errorf("expected a URL or a CreativeWork, got %s", string(data))
+}
+
+// validateLicense checks that the license is set and, when described by a
+// CreativeWork, that it links to or names the license.
+func validateLicense(field string, license License) []teseo.ValidationIssue {
+	var found issues
+	switch l := license.(type) {
+	case LicenseURL:
+		if l == "" {
+			found.recommended(field)
+		}
+	case *CreativeWork:
+		if l == nil {
+			found.recommended(field)
+		} else if l.URL == "" && l.Name == "" {
+			found.missing(teseo.SeverityRecommended, field+".url", field+".url or "+field+".name")
+		}
+	default:
+		found.recommended(field)
+	}
+	return found
+}
+
+// DataCatalog represents a Schema.org DataCatalog object
+// For more details about the meaning of the properties see: https://schema.org/DataCatalog
+type DataCatalog struct {
+	Type string `json:"@type"`
+	Name string `json:"name,omitempty"`
+	URL  string `json:"url,omitempty"`
+}
+
+// PropertyValue represents a Schema.org PropertyValue object
+// For more details about the meaning of the properties see: https://schema.org/PropertyValue
+type PropertyValue struct {
+	Type        string `json:"@type"`
+	Name        string `json:"name,omitempty"`
+	PropertyID  string `json:"propertyID,omitempty"`
+	Value       any    `json:"value,omitempty"`
+	UnitText    string `json:"unitText,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// NewDataset initializes a Dataset with default context and type.
+func NewDataset(name, description, url, license string, creator Agent, distribution []*DataDownload) *Dataset {
+	dataset := &Dataset{
+		Name:         name,
+		Description:  description,
+		URL:          url,
+		Creator:      creator,
+		Distribution: distribution,
+	}
+	if license != "" {
+		dataset.License = LicenseURL(license)
+	}
+	dataset.ensureDefaults()
+	return dataset
+}
+
+// Validate checks if the Dataset has the required and recommended fields for Google Dataset Search.
+func (ds *Dataset) Validate() []string {
+	return issueMessages(ds.ValidationIssues())
+}
+
+// ValidationIssues returns the issues found by Validate on the Dataset as structured values.
+func (ds *Dataset) ValidationIssues() []teseo.ValidationIssue {
+	var found issues
+
+	if ds.Name == "" {
+		found.required("name")
+	}
+	if ds.Description == "" {
+		found.required("description")
+	} else if n := utf8.RuneCountInString(ds.Description); n < 50 || n > 5000 {
+		found.warnf(teseo.RuleOutOfRange, "description", "description should be between 50 and 5000 characters, got %d", n)
+	}
+	found.add(validateLicense("license", ds.License)...)
+	if isNilAgent(ds.Creator) {
+		found.recommended("creator")
+	} else {
+		found.add(validateAgent("creator", teseo.SeverityRequired, ds.Creator)...)
+	}
+	found.add(validateAgent("funder", teseo.SeverityRequired, ds.Funder)...)
+
+	for i, d := range ds.Distribution {
+		if d.ContentURL == "" {
+			found.warnf(teseo.RuleMissingField, fmt.Sprintf("distribution[%d].contentUrl", i), "DataDownload %d is missing contentUrl", i+1)
+		}
+		if d.EncodingFormat == "" {
+			found.warnf(teseo.RuleMissingField, fmt.Sprintf("distribution[%d].encodingFormat", i), "DataDownload %d is missing recommended field: encodingFormat", i+1)
+		}
+	}
+
+	if ds.IncludedInDataCatalog != nil && ds.IncludedInDataCatalog.Name == "" {
+		found.warnf(teseo.RuleMissingField, "includedInDataCatalog.name", "includedInDataCatalog is missing a name")
+	}
+
+	found.add(validateFormats(ds)...)
+	return found
+}
+
+// ToJsonLd converts the Dataset struct to a JSON-LD `templ.Component`.
+func (ds *Dataset) ToJsonLd() templ.Component {
+	ds.ensureDefaults()
+	id := fmt.Sprintf("%s-%s", "dataset", teseo.GenerateUniqueKey())
+	return templ.JSONScript(id, ds).WithType("application/ld+json")
+}
+
+// ToGoHTMLJsonLd renders the Dataset struct as `template.HTML` value for Go's `html/template`.
+func (ds *Dataset) ToGoHTMLJsonLd() (template.HTML, error) {
+	return teseo.RenderToHTML(ds.ToJsonLd())
+}
+
+// UnmarshalJSON decodes a Dataset, resolving `creator` and `funder` to Person, Organization or @id reference nodes based on their `@type`
+// and `license` to a LicenseURL or a CreativeWork.
+func (ds *Dataset) UnmarshalJSON(data []byte) error {
+	type alias Dataset
+	aux := struct {
+		*alias
+		Creator json.RawMessage `json:"creator,omitempty"`
+		Funder  json.RawMessage `json:"funder,omitempty"`
+		License json.RawMessage `json:"license,omitempty"`
+	}{alias: (*alias)(ds)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, aux)
+	if err != nil {
+		return err
+	}
+	ds.Extra = extra
+
+	creator, err := unmarshalAgent(aux.Creator)
+	if err != nil {
+		return fmt.Errorf("Dataset: invalid creator: %w", err)
+	}
+	ds.Creator = creator
+
+	funder, err := unmarshalAgent(aux.Funder)
+	if err != nil {
+		return fmt.Errorf("Dataset: invalid funder: %w", err)
+	}
+	ds.Funder = funder
+
+	license, err := unmarshalLicense(aux.License)
+	if err != nil {
+		return fmt.Errorf("Dataset: invalid license: %w", err)
+	}
+	ds.License = license
+
+	return nil
+}
+
+// MarshalJSON encodes a Dataset, merging the Extra properties into the JSON-LD object.
+func (ds Dataset) MarshalJSON() ([]byte, error) {
+	type alias Dataset
+	return marshalWithExtra(alias(ds), ds.Extra)
+}
+
+// ensureDefaults sets default values for Dataset and its nested objects if they are not already set.
+func (ds *Dataset) ensureDefaults() {
+	if ds.Context == "" {
+		ds.Context = "https://schema.org"
+	}
+
+	if ds.Type == "" {
+		ds.Type = "Dataset"
+	}
+
+	ensureAgentDefaults(ds.Creator)
+	ensureAgentDefaults(ds.Funder)
+
+	if !isNilValue(ds.License) {
+		ds.License.ensureDefaults()
+	}
+
+	if ds.SpatialCoverage != nil {
+		ds.SpatialCoverage.ensureDefaults()
+	}
+
+	for _, v := range ds.VariableMeasured {
+		v.ensureDefaults()
+	}
+
+	for _, d := range ds.Distribution {
+		d.ensureDefaults()
+	}
+
+	if ds.IncludedInDataCatalog != nil {
+		ds.IncludedInDataCatalog.ensureDefaults()
+	}
+}
+
+// ensureDefaults sets default values for DataDownload if they are not already set.
+func (dd *DataDownload) ensureDefaults() {
+	if dd.Type == "" {
+		dd.Type = "DataDownload"
+	}
+}
+
+// ensureDefaults sets default values for DataCatalog if they are not already set.
+func (dc *DataCatalog) ensureDefaults() {
+	if dc.Type == "" {
+		dc.Type = "DataCatalog"
+	}
+}
+
+// ensureDefaults sets default values for PropertyValue if they are not already set.
+func (pv *PropertyValue) ensureDefaults() {
+	if pv.Type == "" {
+		pv.Type = "PropertyValue"
+	}
+}
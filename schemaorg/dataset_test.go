@@ -0,0 +1,234 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const datasetDescription = "Storm Data is provided by the National Weather Service and contains statistics on storms."
+
+func TestNewDataset_SetsFieldsAndDefaults(t *testing.T) {
+	creator := &Organization{Name: "NOAA"}
+	dataset := NewDataset(
+		"Storm Events",
+		datasetDescription,
+		"https://example.com/dataset",
+		"https://creativecommons.org/publicdomain/zero/1.0/",
+		creator,
+		[]*DataDownload{{EncodingFormat: "CSV", ContentURL: "https://example.com/data.csv"}},
+	)
+
+	if dataset.Context != "https://schema.org" {
+		t.Errorf("expected context schema.org, got %s", dataset.Context)
+	}
+	if dataset.Type != "Dataset" {
+		t.Errorf("expected type Dataset, got %s", dataset.Type)
+	}
+	if creator.Type != "Organization" {
+		t.Errorf("expected creator type Organization, got %s", creator.Type)
+	}
+	if dataset.Distribution[0].Type != "DataDownload" {
+		t.Errorf("expected distribution type DataDownload, got %s", dataset.Distribution[0].Type)
+	}
+}
+
+func TestDataset_EnsureDefaults_WithNested(t *testing.T) {
+	shape := &GeoShape{Box: "18.0 -122.0 49.0 -66.0"}
+	ds := &Dataset{
+		Creator:               &Person{Name: "Jane"},
+		SpatialCoverage:       &Place{Geo: shape},
+		VariableMeasured:      []*PropertyValue{{Name: "wind speed"}},
+		IncludedInDataCatalog: &DataCatalog{Name: "data.gov"},
+	}
+	ds.ensureDefaults()
+
+	if ds.Creator.(*Person).Type != "Person" {
+		t.Errorf("expected creator type Person")
+	}
+	if shape.Type != "GeoShape" {
+		t.Errorf("expected geo type GeoShape, got %s", shape.Type)
+	}
+	if ds.VariableMeasured[0].Type != "PropertyValue" {
+		t.Errorf("expected PropertyValue type, got %s", ds.VariableMeasured[0].Type)
+	}
+	if ds.IncludedInDataCatalog.Type != "DataCatalog" {
+		t.Errorf("expected DataCatalog type, got %s", ds.IncludedInDataCatalog.Type)
+	}
+}
+
+func TestDataset_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		dataset  *Dataset
+		expected []string
+	}{
+		{
+			name: "all good",
+			dataset: &Dataset{
+				Name:         "Storm Events",
+				Description:  datasetDescription,
+				License:      LicenseURL("https://creativecommons.org/publicdomain/zero/1.0/"),
+				Creator:      &Organization{Name: "NOAA"},
+				Distribution: []*DataDownload{{EncodingFormat: "CSV", ContentURL: "https://example.com/data.csv"}},
+			},
+			expected: nil,
+		},
+		{
+			name:    "missing fields",
+			dataset: &Dataset{},
+			expected: []string{
+				"missing required field: name",
+				"missing required field: description",
+				"missing recommended field: license",
+				"missing recommended field: creator",
+			},
+		},
+		{
+			name: "invalid values",
+			dataset: &Dataset{
+				Name:                  "Storm Events",
+				Description:           "too short",
+				License:               LicenseURL("CC0"),
+				Creator:               &Organization{},
+				Distribution:          []*DataDownload{{}},
+				IncludedInDataCatalog: &DataCatalog{},
+			},
+			expected: []string{
+				"description should be between 50 and 5000 characters, got 9",
+				"missing required field: creator.name",
+				"DataDownload 1 is missing contentUrl",
+				"DataDownload 1 is missing recommended field: encodingFormat",
+				"includedInDataCatalog is missing a name",
+				`invalid URL for license: "CC0"`,
+			},
+		},
+		{
+			name: "creative work license",
+			dataset: &Dataset{
+				Name:        "Storm Events",
+				Description: datasetDescription,
+				License:     &CreativeWork{Name: "CC0", URL: "https://creativecommons.org/publicdomain/zero/1.0/"},
+				Creator:     &Organization{Name: "NOAA"},
+			},
+			expected: nil,
+		},
+		{
+			name: "empty creative work license",
+			dataset: &Dataset{
+				Name:        "Storm Events",
+				Description: datasetDescription,
+				License:     &CreativeWork{},
+				Creator:     &Organization{Name: "NOAA"},
+			},
+			expected: []string{"missing recommended field: license.url or license.name"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.dataset.Validate()
+			if len(got) != len(tt.expected) {
+				t.Fatalf("expected %d warnings, got %d: %v", len(tt.expected), len(got), got)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] {
+					t.Errorf("warning %d: expected %q, got %q", i, tt.expected[i], got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestDataset_UnmarshalJSON_License(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected License
+	}{
+		{"url", `{"@type":"Dataset","license":"https://creativecommons.org/licenses/by/4.0/"}`, LicenseURL("https://creativecommons.org/licenses/by/4.0/")},
+		{"creative work", `{"@type":"Dataset","license":{"@type":"CreativeWork","name":"CC BY 4.0","url":"https://creativecommons.org/licenses/by/4.0/"}}`, &CreativeWork{Type: "CreativeWork", Name: "CC BY 4.0", URL: "https://creativecommons.org/licenses/by/4.0/"}},
+		{"no license", `{"@type":"Dataset"}`, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var ds Dataset
+			if err := json.Unmarshal([]byte(tt.input), &ds); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(ds.License, tt.expected) {
+				t.Fatalf("expected %#v, got %#v", tt.expected, ds.License)
+			}
+			data, err := json.Marshal(ds)
+			if err != nil || string(data) != `{"@context":"",`+tt.input[1:] {
+				t.Errorf("expected %s after a round trip, got %s (err: %v)", tt.input, data, err)
+			}
+		})
+	}
+
+	var ds Dataset
+	if err := json.Unmarshal([]byte(`{"@type":"Dataset","license":42}`), &ds); err == nil {
+		t.Error("expected an error for a numeric license")
+	}
+}
+
+func TestPlace_UnmarshalJSON_Geo(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		check func(t *testing.T, p Place)
+	}{
+		{
+			name:  "coordinates",
+			input: `{"@type":"Place","geo":{"@type":"GeoCoordinates","latitude":45.5,"longitude":12.1}}`,
+			check: func(t *testing.T, p Place) {
+				geo, ok := p.Geo.(*GeoCoordinates)
+				if !ok || geo.Latitude != 45.5 {
+					t.Errorf("expected GeoCoordinates, got %#v", p.Geo)
+				}
+			},
+		},
+		{
+			name:  "shape",
+			input: `{"@type":"Place","name":"US","geo":{"@type":"GeoShape","box":"18.0 -122.0 49.0 -66.0"}}`,
+			check: func(t *testing.T, p Place) {
+				geo, ok := p.Geo.(*GeoShape)
+				if !ok || geo.Box != "18.0 -122.0 49.0 -66.0" || p.Name != "US" {
+					t.Errorf("expected GeoShape, got %#v", p.Geo)
+				}
+			},
+		},
+		{
+			name:  "no geo",
+			input: `{"@type":"Place","name":"Venue"}`,
+			check: func(t *testing.T, p Place) {
+				if p.Geo != nil {
+					t.Errorf("expected nil geo, got %#v", p.Geo)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var p Place
+			if err := json.Unmarshal([]byte(tt.input), &p); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			tt.check(t, p)
+		})
+	}
+}
+
+func TestDataset_ToGoHTMLJsonLd(t *testing.T) {
+	dataset := NewDataset("Storm Events", datasetDescription, "", "", nil, nil)
+	html, err := dataset.ToGoHTMLJsonLd()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !strings.Contains(string(html), `"@type":"Dataset"`) {
+		t.Errorf("expected Dataset JSON-LD, got %s", html)
+	}
+}
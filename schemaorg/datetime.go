@@ -0,0 +1,35 @@
+package schemaorg
+
+import (
+	"github.com/indaco/teseo"
+)
+
+// validateDate returns an issue if the value is set but not a valid ISO 8601 date.
+func validateDate(field string, value teseo.Date) []teseo.ValidationIssue {
+	return issueList(teseo.CheckDate(field, value))
+}
+
+// validateDateTime returns an issue if the value is set but not a valid ISO 8601 date-time.
+func validateDateTime(field string, value teseo.DateTime) []teseo.ValidationIssue {
+	return issueList(teseo.CheckDateTime(field, value))
+}
+
+// validateDuration returns an issue if the value is set but not a valid duration.
+func validateDuration(field string, value teseo.Duration) []teseo.ValidationIssue {
+	return issueList(teseo.CheckDuration(field, value))
+}
+
+// validateTimeOrder returns an issue if both values are valid and end is before start.
+// Empty or malformed values are left to the format checks.
+func validateTimeOrder(startField string, start teseo.DateTime, endField string, end teseo.DateTime) []teseo.ValidationIssue {
+	return issueList(teseo.CheckTimeOrder(startField, start, endField, end))
+}
+
+// issueList returns the issue found by one of the teseo checks as a list, empty
+// when the check passed.
+func issueList(issue *teseo.ValidationIssue) []teseo.ValidationIssue {
+	if issue == nil {
+		return nil
+	}
+	return []teseo.ValidationIssue{*issue}
+}
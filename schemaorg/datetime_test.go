@@ -0,0 +1,148 @@
+package schemaorg
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/indaco/teseo"
+)
+
+func TestValidateTimeOrder(t *testing.T) {
+	tests := []struct {
+		name     string
+		start    teseo.DateTime
+		end      teseo.DateTime
+		expected []string
+	}{
+		{"ordered", teseo.DateTime("2024-09-15T09:00:00Z"), teseo.DateTime("2024-09-15T18:00:00Z"), nil},
+		{"same instant across zones", teseo.DateTime("2024-09-15T10:00:00+02:00"), teseo.DateTime("2024-09-15T08:00:00Z"), nil},
+		{"missing end", teseo.DateTime("2024-09-15T09:00:00Z"), teseo.DateTime(""), nil},
+		{"malformed start", teseo.DateTime("soon"), teseo.DateTime("2024-09-15T09:00:00Z"), nil},
+		{
+			"end before start",
+			teseo.DateTime("2024-09-15T18:00:00Z"),
+			teseo.DateTime("2024-09-15T09:00:00Z"),
+			[]string{`endDate "2024-09-15T09:00:00Z" is before startDate "2024-09-15T18:00:00Z"`},
+		},
+		{
+			"dates",
+			teseo.DateTime("2024-10-01"),
+			teseo.DateTime("2024-09-30"),
+			[]string{`endDate "2024-09-30" is before startDate "2024-10-01"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := issueMessages(validateTimeOrder("startDate", tt.start, "endDate", tt.end))
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestEvent_Validate_Dates(t *testing.T) {
+	tests := []struct {
+		name     string
+		start    teseo.DateTime
+		end      teseo.DateTime
+		expected []string
+	}{
+		{"valid", "2024-09-15T09:00:00-07:00", "2024-09-15T17:00:00-07:00", nil},
+		{"malformed start", "15 Sep 2024", "", []string{`invalid ISO 8601 date-time for startDate: "15 Sep 2024"`}},
+		{"malformed end", "2024-09-15T09:00", "later", []string{`invalid ISO 8601 date-time for endDate: "later"`}},
+		{
+			"end before start",
+			"2024-09-15T17:00:00Z",
+			"2024-09-14T17:00:00Z",
+			[]string{`endDate "2024-09-14T17:00:00Z" is before startDate "2024-09-15T17:00:00Z"`},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &Event{Name: "Concert", StartDate: tt.start, EndDate: tt.end, Location: &Place{Name: "Venue"}}
+			if got := e.Validate(); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestArticle_Validate_Dates(t *testing.T) {
+	art := &Article{
+		Headline:      "Headline",
+		Image:         NewImages("https://www.example.com/image.jpg"),
+		Author:        &Person{Name: "Jane Doe"},
+		DatePublished: "2024-09-15T09:00:00Z",
+		DateModified:  "2024-09-01",
+	}
+	expected := []string{`dateModified "2024-09-01" is before datePublished "2024-09-15T09:00:00Z"`}
+	if got := art.Validate(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	art.DateModified = "modified"
+	expected = []string{`invalid ISO 8601 date-time for dateModified: "modified"`}
+	if got := art.Validate(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestCourse_Validate_Durations(t *testing.T) {
+	c := &Course{
+		Name:        "Intro to Go",
+		Description: "Learn Go",
+		Provider:    &EducationalOrganization{Name: "Go University"},
+		HasCourseInstance: []*CourseInstance{
+			{
+				CourseMode:     CourseModeOnline,
+				CourseWorkload: "2 hours",
+				StartDate:      "2024-10-01",
+				EndDate:        "2024-09-01",
+			},
+		},
+	}
+	expected := []string{
+		`invalid duration for hasCourseInstance[0].courseWorkload: "2 hours"`,
+		`hasCourseInstance[0].endDate "2024-09-01" is before hasCourseInstance[0].startDate "2024-10-01"`,
+	}
+	if got := c.Validate(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestPerson_Validate_BirthDate(t *testing.T) {
+	p := &Person{Name: "Jane Doe", Email: "jane@example.com", JobTitle: "Engineer", BirthDate: "1990-02-30"}
+	expected := []string{`invalid ISO 8601 date for birthDate: "1990-02-30"`}
+	if got := p.Validate(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestDecode_MalformedDatesAreReported(t *testing.T) {
+	data := []byte(`[
+		{"@context": "https://schema.org", "@type": "Event", "name": "Launch", "startDate": "next friday", "location": {"@type": "Place", "name": "Hall"}},
+		{"@context": "https://schema.org", "@type": "Article", "headline": "News", "datePublished": "2024-09-15T10:00:00+0200"}
+	]`)
+	things, err := Decode(data)
+	if err != nil {
+		t.Fatalf("expected the document to decode, got %v", err)
+	}
+	if len(things) != 2 {
+		t.Fatalf("expected 2 things, got %d", len(things))
+	}
+
+	event := things[0].(*Event)
+	expected := `invalid ISO 8601 date-time for startDate: "next friday"`
+	if !slices.Contains(event.Validate(), expected) {
+		t.Errorf("expected %q, got %v", expected, event.Validate())
+	}
+	article := things[1].(*Article)
+	for _, w := range article.Validate() {
+		if strings.Contains(w, "datePublished:") {
+			t.Errorf("expected the basic offset to be valid, got %q", w)
+		}
+	}
+}
@@ -0,0 +1,393 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+
+	"github.com/a-h/templ"
+	"github.com/indaco/teseo"
+)
+
+// DiscussionForumPosting represents a Schema.org DiscussionForumPosting object,
+// a thread or post on a forum or social media site together with its comments.
+// For more details about the meaning of the properties see: https://schema.org/DiscussionForumPosting
+//
+// Example usage:
+//
+// Pure struct usage:
+//
+//	posting := &schemaorg.DiscussionForumPosting{
+//		Headline:      "I went to the concert!",
+//		Text:          "Look at how cool this concert was!",
+//		URL:           "https://www.example.com/post/1",
+//		Author:        &schemaorg.Person{Name: "Katie Pope", URL: "https://www.example.com/user/katie-pope"},
+//		DatePublished: "2024-03-01T08:34:34+02:00",
+//		InteractionStatistic: []*schemaorg.InteractionCounter{
+//			schemaorg.NewInteractionCounter(schemaorg.LikeAction, 27),
+//		},
+//		Comment: []*schemaorg.Comment{
+//			{
+//				Text:          "Who's the person you're with?",
+//				Author:        &schemaorg.Person{Name: "Saul Douglas"},
+//				DatePublished: "2024-03-01T09:46:02+02:00",
+//			},
+//		},
+//	}
+//
+// Factory method usage:
+//
+//	posting := schemaorg.NewDiscussionForumPosting(
+//		"I went to the concert!",
+//		"Look at how cool this concert was!",
+//		"https://www.example.com/post/1",
+//		&schemaorg.Person{Name: "Katie Pope"},
+//		"2024-03-01T08:34:34+02:00",
+//	)
+//
+// // Rendering JSON-LD using templ:
+//
+//	templ Page() {
+//		@posting.ToJsonLd()
+//	}
+//
+// // Rendering JSON-LD as `template.HTML` value:
+//
+//	jsonLdHtml := posting.ToGoHTMLJsonLd()
+//
+// Expected output:
+//
+//	{
+//		"@context": "https://schema.org",
+//		"@type": "DiscussionForumPosting",
+//		"headline": "I went to the concert!",
+//		"text": "Look at how cool this concert was!",
+//		"url": "https://www.example.com/post/1",
+//		"author": {"@type": "Person", "name": "Katie Pope"},
+//		"datePublished": "2024-03-01T08:34:34+02:00",
+//		"interactionStatistic": [
+//			{"@type": "InteractionCounter", "interactionType": "https://schema.org/LikeAction", "userInteractionCount": 27}
+//		],
+//		"comment": [
+//			{"@type": "Comment", "text": "Who's the person you're with?", "author": {"@type": "Person", "name": "Saul Douglas"}, "datePublished": "2024-03-01T09:46:02+02:00"}
+//		]
+//	}
+type DiscussionForumPosting struct {
+	Context              string              `json:"@context"`
+	Type                 string              `json:"@type"`
+	Headline             string              `json:"headline,omitempty"`
+	Text                 string              `json:"text,omitempty"`
+	URL                  string              `json:"url,omitempty"`
+	Image                Images              `json:"image,omitempty"`
+	Author               Agent               `json:"author,omitempty"`
+	DatePublished        teseo.DateTime      `json:"datePublished,omitempty"`
+	DateModified         teseo.DateTime      `json:"dateModified,omitempty"`
+	IsPartOf             string              `json:"isPartOf,omitempty"`
+	InteractionStatistic InteractionCounters `json:"interactionStatistic,omitempty"`
+	Comment              []*Comment          `json:"comment,omitempty"`
+	Extra                Extra               `json:"-"`
+}
+
+// Comment represents a Schema.org Comment object, a reply to a posting or,
+// through its own Comment field, to another comment.
+type Comment struct {
+	Type                 string              `json:"@type"`
+	Text                 string              `json:"text,omitempty"`
+	URL                  string              `json:"url,omitempty"`
+	Image                Images              `json:"image,omitempty"`
+	Author               Agent               `json:"author,omitempty"`
+	DatePublished        teseo.DateTime      `json:"datePublished,omitempty"`
+	DateModified         teseo.DateTime      `json:"dateModified,omitempty"`
+	InteractionStatistic InteractionCounters `json:"interactionStatistic,omitempty"`
+	Comment              []*Comment          `json:"comment,omitempty"`
+}
+
+// InteractionCounter represents a Schema.org InteractionCounter object,
+// counting the user interactions of a given type (likes, comments, shares...).
+// For more details see: https://schema.org/InteractionCounter
+type InteractionCounter struct {
+	Type                 string          `json:"@type"`
+	InteractionType      InteractionType `json:"interactionType,omitempty"`
+	UserInteractionCount int             `json:"userInteractionCount"`
+}
+
+// InteractionCounters holds the interaction statistics of an entity.
+// It is always rendered as an array and decodes from a single object or an array.
+type InteractionCounters []*InteractionCounter
+
+// UnmarshalJSON decodes a single InteractionCounter or an array of them.
+func (ic *InteractionCounters) UnmarshalJSON(data []byte) error {
+	counters, err := unmarshalObjects[InteractionCounter](data)
+	if err != nil {
+		return fmt.Errorf("invalid interactionStatistic: %w", err)
+	}
+	*ic = counters
+	return nil
+}
+
+// NewDiscussionForumPosting initializes a DiscussionForumPosting with default context and type.
+func NewDiscussionForumPosting(headline, text, url string, author Agent, datePublished string) *DiscussionForumPosting {
+	posting := &DiscussionForumPosting{
+		Headline:      headline,
+		Text:          text,
+		URL:           url,
+		Author:        author,
+		DatePublished: teseo.DateTime(datePublished),
+	}
+	posting.ensureDefaults()
+	return posting
+}
+
+// NewInteractionCounter initializes an InteractionCounter with default type.
+func NewInteractionCounter(interactionType InteractionType, count int) *InteractionCounter {
+	counter := &InteractionCounter{
+		InteractionType:      interactionType,
+		UserInteractionCount: count,
+	}
+	counter.ensureDefaults()
+	return counter
+}
+
+// Validate checks the DiscussionForumPosting against the requirements for
+// discussion forum rich results, including its comments.
+func (dfp *DiscussionForumPosting) Validate() []string {
+	return issueMessages(dfp.ValidationIssues())
+}
+
+// ValidationIssues returns the issues found by Validate on the DiscussionForumPosting as structured values.
+func (dfp *DiscussionForumPosting) ValidationIssues() []teseo.ValidationIssue {
+	var found issues
+
+	if isNilAgent(dfp.Author) {
+		found.required("author.name")
+	} else {
+		found.add(validateAgent("author", teseo.SeverityRequired, dfp.Author)...)
+	}
+	if dfp.DatePublished == "" {
+		found.required("datePublished")
+	}
+	if dfp.Text == "" && len(dfp.Image) == 0 {
+		found.missing(teseo.SeverityRequired, "text", "text or image")
+	}
+	if dfp.Headline == "" {
+		found.recommended("headline")
+	}
+	if dfp.URL == "" {
+		found.recommended("url")
+	}
+	found.add(validateDateTime("datePublished", dfp.DatePublished)...)
+	found.add(validateDateTime("dateModified", dfp.DateModified)...)
+	found.add(validateTimeOrder("datePublished", dfp.DatePublished, "dateModified", dfp.DateModified)...)
+
+	for i, counter := range dfp.InteractionStatistic {
+		if counter != nil {
+			found.add(counter.validate(fmt.Sprintf("interactionStatistic[%d]", i))...)
+		}
+	}
+	for i, c := range dfp.Comment {
+		if c != nil {
+			found.add(c.validate(fmt.Sprintf("comment[%d]", i))...)
+		}
+	}
+	found.add(dfp.Image.validate("image")...)
+
+	found.add(validateFormats(dfp)...)
+	return found
+}
+
+// validate checks a Comment and its replies, prefixing the issue paths with the given path.
+func (c *Comment) validate(prefix string) []teseo.ValidationIssue {
+	var found issues
+
+	if isNilAgent(c.Author) {
+		found.required(prefix + ".author.name")
+	} else {
+		found.add(validateAgent(prefix+".author", teseo.SeverityRequired, c.Author)...)
+	}
+	if c.DatePublished == "" {
+		found.required(prefix + ".datePublished")
+	}
+	if c.Text == "" && len(c.Image) == 0 {
+		found.missing(teseo.SeverityRequired, prefix+".text", fmt.Sprintf("%s.text or %s.image", prefix, prefix))
+	}
+	found.add(validateDateTime(prefix+".datePublished", c.DatePublished)...)
+	found.add(validateDateTime(prefix+".dateModified", c.DateModified)...)
+
+	for i, counter := range c.InteractionStatistic {
+		if counter != nil {
+			found.add(counter.validate(fmt.Sprintf("%s.interactionStatistic[%d]", prefix, i))...)
+		}
+	}
+	for i, reply := range c.Comment {
+		if reply != nil {
+			found.add(reply.validate(fmt.Sprintf("%s.comment[%d]", prefix, i))...)
+		}
+	}
+	found.add(c.Image.validate(fieldPath(prefix, "image"))...)
+
+	return found
+}
+
+// validate checks the InteractionCounter fields, prefixing the issue paths with the given path.
+func (ic *InteractionCounter) validate(prefix string) []teseo.ValidationIssue {
+	var found issues
+
+	if ic.InteractionType == "" {
+		found.required(prefix + ".interactionType")
+	}
+	found.add(validateEnum(prefix+".interactionType", string(ic.InteractionType), ic.InteractionType.IsValid())...)
+	if ic.UserInteractionCount < 0 {
+		found.warnf(teseo.RuleNegativeValue, prefix+".userInteractionCount", "%s.userInteractionCount must not be negative, got %d", prefix, ic.UserInteractionCount)
+	}
+
+	return found
+}
+
+// validateInteractionStatistics checks the interactionStatistic and
+// agentInteractionStatistic counters of a Person or an Organization, prefixing
+// the issue paths with the given path.
+func validateInteractionStatistics(prefix string, statistics, agentStatistics []*InteractionCounter) []teseo.ValidationIssue {
+	var found issues
+	for i, counter := range statistics {
+		if counter != nil {
+			found.add(counter.validate(fieldPath(prefix, fmt.Sprintf("interactionStatistic[%d]", i)))...)
+		}
+	}
+	for i, counter := range agentStatistics {
+		if counter != nil {
+			found.add(counter.validate(fieldPath(prefix, fmt.Sprintf("agentInteractionStatistic[%d]", i)))...)
+		}
+	}
+	return found
+}
+
+// ToJsonLd converts the DiscussionForumPosting struct to a JSON-LD `templ.Component`.
+func (dfp *DiscussionForumPosting) ToJsonLd() templ.Component {
+	dfp.ensureDefaults()
+	id := fmt.Sprintf("%s-%s", "discussionforumposting", teseo.GenerateUniqueKey())
+	return templ.JSONScript(id, dfp).WithType("application/ld+json")
+}
+
+// ToGoHTMLJsonLd renders the DiscussionForumPosting struct as `template.HTML` value for Go's `html/template`.
+func (dfp *DiscussionForumPosting) ToGoHTMLJsonLd() (template.HTML, error) {
+	return teseo.RenderToHTML(dfp.ToJsonLd())
+}
+
+// MarshalJSON encodes a DiscussionForumPosting, merging the Extra properties into the JSON-LD object.
+func (dfp DiscussionForumPosting) MarshalJSON() ([]byte, error) {
+	type alias DiscussionForumPosting
+	return marshalWithExtra(alias(dfp), dfp.Extra)
+}
+
+// UnmarshalJSON decodes a DiscussionForumPosting, resolving `author` to Person, Organization or @id reference nodes based on their `@type`.
+func (dfp *DiscussionForumPosting) UnmarshalJSON(data []byte) error {
+	type alias DiscussionForumPosting
+	aux := struct {
+		*alias
+		Author json.RawMessage `json:"author,omitempty"`
+	}{alias: (*alias)(dfp)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, aux)
+	if err != nil {
+		return err
+	}
+	dfp.Extra = extra
+
+	author, err := unmarshalAgent(aux.Author)
+	if err != nil {
+		return fmt.Errorf("DiscussionForumPosting: invalid author: %w", err)
+	}
+	dfp.Author = author
+
+	return nil
+}
+
+// UnmarshalJSON decodes a Comment, resolving `author` to Person, Organization or @id reference nodes based on their `@type`.
+func (c *Comment) UnmarshalJSON(data []byte) error {
+	type alias Comment
+	aux := struct {
+		*alias
+		Author json.RawMessage `json:"author,omitempty"`
+	}{alias: (*alias)(c)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	author, err := unmarshalAgent(aux.Author)
+	if err != nil {
+		return fmt.Errorf("Comment: invalid author: %w", err)
+	}
+	c.Author = author
+
+	return nil
+}
+
+// ensureDefaults sets default values for DiscussionForumPosting and its nested objects if they are not already set.
+func (dfp *DiscussionForumPosting) ensureDefaults() {
+	if dfp.Context == "" {
+		dfp.Context = "https://schema.org"
+	}
+
+	if dfp.Type == "" {
+		dfp.Type = "DiscussionForumPosting"
+	}
+
+	ensureAgentDefaults(dfp.Author)
+
+	for _, counter := range dfp.InteractionStatistic {
+		if counter != nil {
+			counter.ensureDefaults()
+		}
+	}
+
+	for _, c := range dfp.Comment {
+		if c != nil {
+			c.ensureDefaults()
+		}
+	}
+
+	dfp.Image.ensureDefaults()
+}
+
+// ensureDefaults sets default values for Comment and its replies if they are not already set.
+func (c *Comment) ensureDefaults() {
+	if c.Type == "" {
+		c.Type = "Comment"
+	}
+
+	ensureAgentDefaults(c.Author)
+
+	for _, counter := range c.InteractionStatistic {
+		if counter != nil {
+			counter.ensureDefaults()
+		}
+	}
+
+	for _, reply := range c.Comment {
+		if reply != nil {
+			reply.ensureDefaults()
+		}
+	}
+
+	c.Image.ensureDefaults()
+}
+
+// ensureDefaults sets default values for InteractionCounter if they are not already set.
+func (ic *InteractionCounter) ensureDefaults() {
+	if ic.Type == "" {
+		ic.Type = "InteractionCounter"
+	}
+}
+
+// ensureInteractionStatisticsDefaults sets default values for every counter of the lists.
+func ensureInteractionStatisticsDefaults(lists ...[]*InteractionCounter) {
+	for _, counters := range lists {
+		for _, counter := range counters {
+			if counter != nil {
+				counter.ensureDefaults()
+			}
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestNewDiscussionForumPosting_SetsDefaults(t *testing.T) {
+	posting := NewDiscussionForumPosting("Headline", "Text", "https://www.example.com/post/1", &Person{Name: "Katie Pope"}, "2024-03-01T08:34:34+02:00")
+	posting.InteractionStatistic = []*InteractionCounter{{InteractionType: LikeAction}}
+	posting.Comment = []*Comment{{Comment: []*Comment{{}}}}
+	posting.ensureDefaults()
+
+	if posting.Context != "https://schema.org" || posting.Type != "DiscussionForumPosting" {
+		t.Errorf("expected default context and type, got %s %s", posting.Context, posting.Type)
+	}
+	if posting.InteractionStatistic[0].Type != "InteractionCounter" {
+		t.Errorf("expected InteractionCounter type, got %s", posting.InteractionStatistic[0].Type)
+	}
+	if posting.Comment[0].Comment[0].Type != "Comment" {
+		t.Errorf("expected nested reply type Comment, got %s", posting.Comment[0].Comment[0].Type)
+	}
+}
+
+func TestInteractionCounter_MarshalJSON(t *testing.T) {
+	data, err := json.Marshal(NewInteractionCounter("LikeAction", 0))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `{"@type":"InteractionCounter","interactionType":"https://schema.org/LikeAction","userInteractionCount":0}`
+	if string(data) != expected {
+		t.Errorf("expected %s, got %s", expected, data)
+	}
+}
+
+func TestDiscussionForumPosting_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		posting  *DiscussionForumPosting
+		expected []string
+	}{
+		{
+			name: "valid posting",
+			posting: &DiscussionForumPosting{
+				Headline:             "I went to the concert!",
+				Image:                NewImages("https://www.example.com/concert.jpg"),
+				URL:                  "https://www.example.com/post/1",
+				Author:               &Person{Name: "Katie Pope"},
+				DatePublished:        "2024-03-01T08:34:34+02:00",
+				InteractionStatistic: []*InteractionCounter{NewInteractionCounter(LikeAction, 27)},
+				Comment: []*Comment{
+					{Text: "Who's with you?", Author: &Person{Name: "Saul Douglas"}, DatePublished: "2024-03-01T09:46:02+02:00"},
+				},
+			},
+		},
+		{
+			name:    "missing required fields",
+			posting: &DiscussionForumPosting{},
+			expected: []string{
+				"missing required field: author.name",
+				"missing required field: datePublished",
+				"missing required field: text or image",
+				"missing recommended field: headline",
+				"missing recommended field: url",
+			},
+		},
+		{
+			name: "invalid comments and counters",
+			posting: &DiscussionForumPosting{
+				Headline:             "Headline",
+				Text:                 "Text",
+				URL:                  "https://www.example.com/post/1",
+				Author:               &Person{Name: "Katie Pope"},
+				DatePublished:        "2024-03-01T08:34:34+02:00",
+				InteractionStatistic: []*InteractionCounter{{UserInteractionCount: -1}, {InteractionType: "ClapAction"}},
+				Comment: []*Comment{
+					{Text: "Reply", Author: &Person{}, DatePublished: "2024-03-01T09:46:02+02:00", Comment: []*Comment{{}}},
+				},
+			},
+			expected: []string{
+				"missing required field: interactionStatistic[0].interactionType",
+				"interactionStatistic[0].userInteractionCount must not be negative, got -1",
+				`unknown interactionStatistic[1].interactionType value "ClapAction"`,
+				"missing required field: comment[0].author.name",
+				"missing required field: comment[0].comment[0].author.name",
+				"missing required field: comment[0].comment[0].datePublished",
+				"missing required field: comment[0].comment[0].text or comment[0].comment[0].image",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.posting.Validate(); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestDecode_DiscussionForumPosting(t *testing.T) {
+	data := `{
+		"@context": "https://schema.org",
+		"@type": "DiscussionForumPosting",
+		"headline": "I went to the concert!",
+		"author": {"@type": "Person", "name": "Katie Pope"},
+		"interactionStatistic": {"@type": "InteractionCounter", "interactionType": "https://schema.org/LikeAction", "userInteractionCount": 27},
+		"comment": [{
+			"@type": "Comment",
+			"text": "Nice!",
+			"author": {"@id": "https://www.example.com/#saul"},
+			"interactionStatistic": [{"@type": "InteractionCounter", "interactionType": "https://schema.org/LikeAction", "userInteractionCount": 3}]
+		}]
+	}`
+	things, err := Decode([]byte(data))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	posting, ok := things[0].(*DiscussionForumPosting)
+	if !ok {
+		t.Fatalf("expected *DiscussionForumPosting, got %T", things[0])
+	}
+	if _, ok := posting.Author.(*Person); !ok {
+		t.Errorf("expected author to be *Person, got %T", posting.Author)
+	}
+	if ref, ok := posting.Comment[0].Author.(*NodeReference); !ok || ref.ID != "https://www.example.com/#saul" {
+		t.Errorf("expected comment author reference, got %#v", posting.Comment[0].Author)
+	}
+	if len(posting.InteractionStatistic) != 1 || posting.InteractionStatistic[0].UserInteractionCount != 27 {
+		t.Errorf("expected a single interaction counter, got %#v", posting.InteractionStatistic)
+	}
+	if len(posting.Comment[0].InteractionStatistic) != 1 || posting.Comment[0].InteractionStatistic[0].UserInteractionCount != 3 {
+		t.Errorf("expected the comment interaction counters, got %#v", posting.Comment[0].InteractionStatistic)
+	}
+}
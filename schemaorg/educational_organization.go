@@ -0,0 +1,158 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+
+	"github.com/a-h/templ"
+	"github.com/indaco/teseo"
+)
+
+// EducationalOrganization represents a Schema.org EducationalOrganization object.
+// For more details about the meaning of the properties see: https://schema.org/EducationalOrganization
+//
+// Example usage:
+//
+// Pure struct usage:
+//
+//	school := &schemaorg.EducationalOrganization{
+//		Name:   "Example University",
+//		URL:    "https://www.example.edu",
+//		Logo:   &schemaorg.ImageObject{URL: "https://www.example.edu/logo.png"},
+//		SameAs: []string{"https://en.wikipedia.org/wiki/Example_University"},
+//	}
+//
+// Factory method usage:
+//
+//	school := schemaorg.NewEducationalOrganization(
+//		"Example University",
+//		"https://www.example.edu",
+//		"https://www.example.edu/logo.png",
+//		"A public research university.",
+//		nil,
+//		[]string{"https://en.wikipedia.org/wiki/Example_University"},
+//	)
+//
+// // Rendering JSON-LD using templ:
+//
+//	templ Page() {
+//		@school.ToJsonLd()
+//	}
+//
+// // Rendering JSON-LD as `template.HTML` value:
+//
+//	jsonLdHtml := school.ToGoHTMLJsonLd()
+//
+// Expected output:
+//
+//	{
+//		"@context": "https://schema.org",
+//		"@type": "EducationalOrganization",
+//		"name": "Example University",
+//		"url": "https://www.example.edu",
+//		"logo": {"@type": "ImageObject", "url": "https://www.example.edu/logo.png"},
+//		"description": "A public research university.",
+//		"sameAs": ["https://en.wikipedia.org/wiki/Example_University"]
+//	}
+type EducationalOrganization struct {
+	Context       string         `json:"@context"`
+	Type          string         `json:"@type"`
+	ID            string         `json:"@id,omitempty"`
+	Name          string         `json:"name,omitempty"`
+	URL           string         `json:"url,omitempty"`
+	Logo          *ImageObject   `json:"logo,omitempty"`
+	Description   string         `json:"description,omitempty"`
+	Address       *PostalAddress `json:"address,omitempty"`
+	ContactPoints []ContactPoint `json:"contactPoint,omitempty"`
+	SameAs        StringList     `json:"sameAs,omitempty"`
+	Extra         Extra          `json:"-"`
+}
+
+// NewEducationalOrganization initializes an EducationalOrganization with default context and type.
+func NewEducationalOrganization(name, url, logoURL, description string, address *PostalAddress, sameAs []string) *EducationalOrganization {
+	org := &EducationalOrganization{
+		Name:        name,
+		URL:         url,
+		Description: description,
+		Address:     address,
+		SameAs:      sameAs,
+	}
+	if logoURL != "" {
+		org.Logo = &ImageObject{URL: logoURL}
+	}
+	org.ensureDefaults()
+	return org
+}
+
+// Validate checks for recommended fields in EducationalOrganization.
+func (org *EducationalOrganization) Validate() []string {
+	return issueMessages(org.ValidationIssues())
+}
+
+// ValidationIssues returns the issues found by Validate on the EducationalOrganization as structured values.
+func (org *EducationalOrganization) ValidationIssues() []teseo.ValidationIssue {
+	var found issues
+
+	if org.Name == "" {
+		found.recommended("name")
+	}
+	if org.URL == "" {
+		found.recommended("url")
+	}
+	found.add(validateLogoSize("logo", org.Logo)...)
+
+	found.add(validateFormats(org)...)
+	return found
+}
+
+// ToJsonLd converts the EducationalOrganization struct to a JSON-LD `templ.Component`.
+func (org *EducationalOrganization) ToJsonLd() templ.Component {
+	org.ensureDefaults()
+	id := fmt.Sprintf("%s-%s", "educationalOrg", teseo.GenerateUniqueKey())
+	return templ.JSONScript(id, org).WithType("application/ld+json")
+}
+
+// ToGoHTMLJsonLd renders the EducationalOrganization struct as `template.HTML` value for Go's `html/template`.
+func (org *EducationalOrganization) ToGoHTMLJsonLd() (template.HTML, error) {
+	return teseo.RenderToHTML(org.ToJsonLd())
+}
+
+// MarshalJSON encodes an EducationalOrganization, merging the Extra properties into the JSON-LD object.
+func (org EducationalOrganization) MarshalJSON() ([]byte, error) {
+	type alias EducationalOrganization
+	return marshalWithExtra(alias(org), org.Extra)
+}
+
+// UnmarshalJSON decodes an EducationalOrganization, capturing properties without a dedicated field into Extra.
+func (org *EducationalOrganization) UnmarshalJSON(data []byte) error {
+	type alias EducationalOrganization
+	if err := json.Unmarshal(data, (*alias)(org)); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, (*alias)(org))
+	if err != nil {
+		return err
+	}
+	org.Extra = extra
+	return nil
+}
+
+// ensureDefaults sets default values for EducationalOrganization and its nested objects if they are not already set.
+func (org *EducationalOrganization) ensureDefaults() {
+	if org.Context == "" {
+		org.Context = "https://schema.org"
+	}
+
+	if org.Type == "" {
+		org.Type = "EducationalOrganization"
+	}
+
+	if org.Logo != nil {
+		org.Logo.ensureDefaults()
+	}
+
+	if org.Address != nil {
+		org.Address.ensureDefaults()
+	}
+}
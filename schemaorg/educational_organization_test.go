@@ -0,0 +1,64 @@
+package schemaorg
+
+import (
+	"testing"
+)
+
+func TestNewEducationalOrganization_SetsFieldsAndDefaults(t *testing.T) {
+	org := NewEducationalOrganization(
+		"Example University",
+		"https://www.example.edu",
+		"https://www.example.edu/logo.png",
+		"A university",
+		&PostalAddress{AddressLocality: "Springfield"},
+		[]string{"https://en.wikipedia.org/wiki/Example"},
+	)
+
+	if org.Context != "https://schema.org" {
+		t.Errorf("expected context schema.org, got %s", org.Context)
+	}
+	if org.Type != "EducationalOrganization" {
+		t.Errorf("expected type EducationalOrganization, got %s", org.Type)
+	}
+	if org.Logo == nil || org.Logo.Type != "ImageObject" || org.Logo.URL != "https://www.example.edu/logo.png" {
+		t.Errorf("logo not set correctly: %v", org.Logo)
+	}
+	if org.Address.Type != "PostalAddress" {
+		t.Errorf("expected address type PostalAddress, got %s", org.Address.Type)
+	}
+}
+
+func TestNewEducationalOrganization_NoLogo(t *testing.T) {
+	org := NewEducationalOrganization("Uni", "https://uni.example", "", "", nil, nil)
+	if org.Logo != nil {
+		t.Errorf("expected nil logo, got %v", org.Logo)
+	}
+}
+
+func TestEducationalOrganization_Validate(t *testing.T) {
+	org := &EducationalOrganization{}
+	warnings := org.Validate()
+	expected := map[string]bool{
+		"missing recommended field: name": true,
+		"missing recommended field: url":  true,
+	}
+	if len(warnings) != len(expected) {
+		t.Errorf("expected %d warnings, got %v", len(expected), warnings)
+	}
+	for _, w := range warnings {
+		if !expected[w] {
+			t.Errorf("unexpected warning: %s", w)
+		}
+	}
+}
+
+func TestEducationalOrganization_ToGoHTMLJsonLd(t *testing.T) {
+	org := NewEducationalOrganization("Uni", "https://uni.example", "", "", nil, nil)
+	html, err := org.ToGoHTMLJsonLd()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if html == "" {
+		t.Errorf("expected non-empty HTML output")
+	}
+}
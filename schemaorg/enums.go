@@ -0,0 +1,415 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/indaco/teseo"
+)
+
+// Schema.org enumeration members are rendered as their canonical URL
+// (e.g. "https://schema.org/InStock"). When decoding JSON-LD both the URL and
+// the short form ("InStock") are accepted; the short form can also be used
+// when assigning string literals to the typed fields.
+
+// schemaOrgBaseURL is the prefix of every canonical Schema.org enumeration member.
+const schemaOrgBaseURL = "https://schema.org/"
+
+// ItemAvailability represents a Schema.org ItemAvailability enumeration member.
+// For more details see: https://schema.org/ItemAvailability
+type ItemAvailability string
+
+const (
+	BackOrder           ItemAvailability = "https://schema.org/BackOrder"
+	Discontinued        ItemAvailability = "https://schema.org/Discontinued"
+	InStock             ItemAvailability = "https://schema.org/InStock"
+	InStoreOnly         ItemAvailability = "https://schema.org/InStoreOnly"
+	LimitedAvailability ItemAvailability = "https://schema.org/LimitedAvailability"
+	MadeToOrder         ItemAvailability = "https://schema.org/MadeToOrder"
+	OnlineOnly          ItemAvailability = "https://schema.org/OnlineOnly"
+	OutOfStock          ItemAvailability = "https://schema.org/OutOfStock"
+	PreOrder            ItemAvailability = "https://schema.org/PreOrder"
+	PreSale             ItemAvailability = "https://schema.org/PreSale"
+	Reserved            ItemAvailability = "https://schema.org/Reserved"
+	SoldOut             ItemAvailability = "https://schema.org/SoldOut"
+)
+
+// OfferItemCondition represents a Schema.org OfferItemCondition enumeration member.
+// For more details see: https://schema.org/OfferItemCondition
+type OfferItemCondition string
+
+const (
+	NewCondition         OfferItemCondition = "https://schema.org/NewCondition"
+	UsedCondition        OfferItemCondition = "https://schema.org/UsedCondition"
+	RefurbishedCondition OfferItemCondition = "https://schema.org/RefurbishedCondition"
+	DamagedCondition     OfferItemCondition = "https://schema.org/DamagedCondition"
+)
+
+// MerchantReturnPolicyCategory represents a Schema.org MerchantReturnEnumeration member.
+// For more details see: https://schema.org/MerchantReturnEnumeration
+type MerchantReturnPolicyCategory string
+
+const (
+	MerchantReturnFiniteReturnWindow MerchantReturnPolicyCategory = "https://schema.org/MerchantReturnFiniteReturnWindow"
+	MerchantReturnNotPermitted       MerchantReturnPolicyCategory = "https://schema.org/MerchantReturnNotPermitted"
+	MerchantReturnUnlimitedWindow    MerchantReturnPolicyCategory = "https://schema.org/MerchantReturnUnlimitedWindow"
+)
+
+// ReturnMethod represents a Schema.org ReturnMethodEnumeration member.
+// For more details see: https://schema.org/ReturnMethodEnumeration
+type ReturnMethod string
+
+const (
+	ReturnAtKiosk ReturnMethod = "https://schema.org/ReturnAtKiosk"
+	ReturnByMail  ReturnMethod = "https://schema.org/ReturnByMail"
+	ReturnInStore ReturnMethod = "https://schema.org/ReturnInStore"
+)
+
+// ReturnFees represents a Schema.org ReturnFeesEnumeration member.
+// For more details see: https://schema.org/ReturnFeesEnumeration
+type ReturnFees string
+
+const (
+	FreeReturn                       ReturnFees = "https://schema.org/FreeReturn"
+	ReturnFeesCustomerResponsibility ReturnFees = "https://schema.org/ReturnFeesCustomerResponsibility"
+	ReturnShippingFees               ReturnFees = "https://schema.org/ReturnShippingFees"
+)
+
+// ItemListOrder represents the type of ordering of an ItemList.
+// For more details see: https://schema.org/ItemListOrderType
+type ItemListOrder string
+
+const (
+	ItemListOrderAscending  ItemListOrder = "https://schema.org/ItemListOrderAscending"
+	ItemListOrderDescending ItemListOrder = "https://schema.org/ItemListOrderDescending"
+	ItemListOrderUnordered  ItemListOrder = "https://schema.org/ItemListUnordered"
+)
+
+// EventStatusType represents a Schema.org EventStatusType enumeration member.
+// For more details see: https://schema.org/EventStatusType
+type EventStatusType string
+
+const (
+	EventScheduled   EventStatusType = "https://schema.org/EventScheduled"
+	EventCancelled   EventStatusType = "https://schema.org/EventCancelled"
+	EventMovedOnline EventStatusType = "https://schema.org/EventMovedOnline"
+	EventPostponed   EventStatusType = "https://schema.org/EventPostponed"
+	EventRescheduled EventStatusType = "https://schema.org/EventRescheduled"
+)
+
+// EventAttendanceMode represents a Schema.org EventAttendanceModeEnumeration member.
+// For more details see: https://schema.org/EventAttendanceModeEnumeration
+type EventAttendanceMode string
+
+const (
+	OfflineEventAttendanceMode EventAttendanceMode = "https://schema.org/OfflineEventAttendanceMode"
+	OnlineEventAttendanceMode  EventAttendanceMode = "https://schema.org/OnlineEventAttendanceMode"
+	MixedEventAttendanceMode   EventAttendanceMode = "https://schema.org/MixedEventAttendanceMode"
+)
+
+// GenderType represents a Schema.org GenderType enumeration member.
+// schema.org also accepts free text for gender, so values other than Male and
+// Female are kept as they are instead of being turned into enumeration URLs.
+// For more details see: https://schema.org/GenderType
+type GenderType string
+
+const (
+	GenderMale   GenderType = "https://schema.org/Male"
+	GenderFemale GenderType = "https://schema.org/Female"
+)
+
+// DayOfWeek represents a Schema.org DayOfWeek enumeration member.
+// For more details see: https://schema.org/DayOfWeek
+type DayOfWeek string
+
+const (
+	Monday         DayOfWeek = "https://schema.org/Monday"
+	Tuesday        DayOfWeek = "https://schema.org/Tuesday"
+	Wednesday      DayOfWeek = "https://schema.org/Wednesday"
+	Thursday       DayOfWeek = "https://schema.org/Thursday"
+	Friday         DayOfWeek = "https://schema.org/Friday"
+	Saturday       DayOfWeek = "https://schema.org/Saturday"
+	Sunday         DayOfWeek = "https://schema.org/Sunday"
+	PublicHolidays DayOfWeek = "https://schema.org/PublicHolidays"
+)
+
+// BookFormatType represents a Schema.org BookFormatType enumeration member.
+// For more details see: https://schema.org/BookFormatType
+type BookFormatType string
+
+const (
+	AudiobookFormat BookFormatType = "https://schema.org/AudiobookFormat"
+	EBook           BookFormatType = "https://schema.org/EBook"
+	GraphicNovel    BookFormatType = "https://schema.org/GraphicNovel"
+	Hardcover       BookFormatType = "https://schema.org/Hardcover"
+	Paperback       BookFormatType = "https://schema.org/Paperback"
+)
+
+// InteractionType represents the Schema.org Action counted by an InteractionCounter.
+// For more details see: https://schema.org/InteractionCounter
+type InteractionType string
+
+const (
+	LikeAction     InteractionType = "https://schema.org/LikeAction"
+	DislikeAction  InteractionType = "https://schema.org/DislikeAction"
+	CommentAction  InteractionType = "https://schema.org/CommentAction"
+	ShareAction    InteractionType = "https://schema.org/ShareAction"
+	FollowAction   InteractionType = "https://schema.org/FollowAction"
+	WatchAction    InteractionType = "https://schema.org/WatchAction"
+	WriteAction    InteractionType = "https://schema.org/WriteAction"
+	BefriendAction InteractionType = "https://schema.org/BefriendAction"
+)
+
+// MarshalJSON encodes the value as its canonical Schema.org URL.
+func (v ItemAvailability) MarshalJSON() ([]byte, error) {
+	return marshalEnum(v)
+}
+
+// UnmarshalJSON accepts both the short and the URL form.
+func (v *ItemAvailability) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, v)
+}
+
+// IsValid reports whether the value is a known ItemAvailability member.
+func (v ItemAvailability) IsValid() bool {
+	return isKnownEnum(v, BackOrder, Discontinued, InStock, InStoreOnly, LimitedAvailability, MadeToOrder,
+		OnlineOnly, OutOfStock, PreOrder, PreSale, Reserved, SoldOut)
+}
+
+// MarshalJSON encodes the value as its canonical Schema.org URL.
+func (v OfferItemCondition) MarshalJSON() ([]byte, error) {
+	return marshalEnum(v)
+}
+
+// UnmarshalJSON accepts both the short and the URL form.
+func (v *OfferItemCondition) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, v)
+}
+
+// IsValid reports whether the value is a known OfferItemCondition member.
+func (v OfferItemCondition) IsValid() bool {
+	return isKnownEnum(v, NewCondition, UsedCondition, RefurbishedCondition, DamagedCondition)
+}
+
+// MarshalJSON encodes the value as its canonical Schema.org URL.
+func (v MerchantReturnPolicyCategory) MarshalJSON() ([]byte, error) {
+	return marshalEnum(v)
+}
+
+// UnmarshalJSON accepts both the short and the URL form.
+func (v *MerchantReturnPolicyCategory) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, v)
+}
+
+// IsValid reports whether the value is a known MerchantReturnEnumeration member.
+func (v MerchantReturnPolicyCategory) IsValid() bool {
+	return isKnownEnum(v, MerchantReturnFiniteReturnWindow, MerchantReturnNotPermitted, MerchantReturnUnlimitedWindow)
+}
+
+// MarshalJSON encodes the value as its canonical Schema.org URL.
+func (v ReturnMethod) MarshalJSON() ([]byte, error) {
+	return marshalEnum(v)
+}
+
+// UnmarshalJSON accepts both the short and the URL form.
+func (v *ReturnMethod) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, v)
+}
+
+// IsValid reports whether the value is a known ReturnMethodEnumeration member.
+func (v ReturnMethod) IsValid() bool {
+	return isKnownEnum(v, ReturnAtKiosk, ReturnByMail, ReturnInStore)
+}
+
+// MarshalJSON encodes the value as its canonical Schema.org URL.
+func (v ReturnFees) MarshalJSON() ([]byte, error) {
+	return marshalEnum(v)
+}
+
+// UnmarshalJSON accepts both the short and the URL form.
+func (v *ReturnFees) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, v)
+}
+
+// IsValid reports whether the value is a known ReturnFeesEnumeration member.
+func (v ReturnFees) IsValid() bool {
+	return isKnownEnum(v, FreeReturn, ReturnFeesCustomerResponsibility, ReturnShippingFees)
+}
+
+// MarshalJSON encodes the value as its canonical Schema.org URL.
+func (v ItemListOrder) MarshalJSON() ([]byte, error) {
+	return marshalEnum(v)
+}
+
+// UnmarshalJSON accepts both the short and the URL form.
+func (v *ItemListOrder) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, v)
+}
+
+// IsValid reports whether the value is a known ItemListOrderType member.
+func (v ItemListOrder) IsValid() bool {
+	return isKnownEnum(v, ItemListOrderAscending, ItemListOrderDescending, ItemListOrderUnordered)
+}
+
+// MarshalJSON encodes the value as its canonical Schema.org URL.
+func (v EventStatusType) MarshalJSON() ([]byte, error) {
+	return marshalEnum(v)
+}
+
+// UnmarshalJSON accepts both the short and the URL form.
+func (v *EventStatusType) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, v)
+}
+
+// IsValid reports whether the value is a known EventStatusType member.
+func (v EventStatusType) IsValid() bool {
+	return isKnownEnum(v, EventScheduled, EventCancelled, EventMovedOnline, EventPostponed, EventRescheduled)
+}
+
+// MarshalJSON encodes the value as its canonical Schema.org URL.
+func (v EventAttendanceMode) MarshalJSON() ([]byte, error) {
+	return marshalEnum(v)
+}
+
+// UnmarshalJSON accepts both the short and the URL form.
+func (v *EventAttendanceMode) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, v)
+}
+
+// IsValid reports whether the value is a known EventAttendanceModeEnumeration member.
+func (v EventAttendanceMode) IsValid() bool {
+	return isKnownEnum(v, OfflineEventAttendanceMode, OnlineEventAttendanceMode, MixedEventAttendanceMode)
+}
+
+// MarshalJSON encodes Male and Female as their canonical Schema.org URL and
+// any other value as free text.
+func (v GenderType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(string(v.canonical()))
+}
+
+// UnmarshalJSON accepts both the short and the URL form of Male and Female, and free text.
+func (v *GenderType) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("%T: invalid JSON input: %s", *v, string(data))
+	}
+	*v = GenderType(s).canonical()
+	return nil
+}
+
+// canonical returns the canonical URL of the Male and Female members and the
+// trimmed value otherwise.
+func (v GenderType) canonical() GenderType {
+	if c := canonical(v); c == GenderMale || c == GenderFemale {
+		return c
+	}
+	return GenderType(strings.TrimSpace(string(v)))
+}
+
+// IsValid reports whether the value is a known GenderType member.
+func (v GenderType) IsValid() bool {
+	return isKnownEnum(v, GenderMale, GenderFemale)
+}
+
+// MarshalJSON encodes the value as its canonical Schema.org URL.
+func (v DayOfWeek) MarshalJSON() ([]byte, error) {
+	return marshalEnum(v)
+}
+
+// UnmarshalJSON accepts both the short and the URL form.
+func (v *DayOfWeek) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, v)
+}
+
+// IsValid reports whether the value is a known DayOfWeek member.
+func (v DayOfWeek) IsValid() bool {
+	return isKnownEnum(v, Monday, Tuesday, Wednesday, Thursday, Friday, Saturday, Sunday, PublicHolidays)
+}
+
+// MarshalJSON encodes the value as its canonical Schema.org URL.
+func (v BookFormatType) MarshalJSON() ([]byte, error) {
+	return marshalEnum(v)
+}
+
+// UnmarshalJSON accepts both the short and the URL form.
+func (v *BookFormatType) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, v)
+}
+
+// IsValid reports whether the value is a known BookFormatType member.
+func (v BookFormatType) IsValid() bool {
+	return isKnownEnum(v, AudiobookFormat, EBook, GraphicNovel, Hardcover, Paperback)
+}
+
+// MarshalJSON encodes the value as its canonical Schema.org URL.
+func (v InteractionType) MarshalJSON() ([]byte, error) {
+	return marshalEnum(v)
+}
+
+// UnmarshalJSON accepts both the short and the URL form.
+func (v *InteractionType) UnmarshalJSON(data []byte) error {
+	return unmarshalEnum(data, v)
+}
+
+// IsValid reports whether the value is a known InteractionType member.
+func (v InteractionType) IsValid() bool {
+	return isKnownEnum(v, LikeAction, DislikeAction, CommentAction, ShareAction, FollowAction, WatchAction, WriteAction, BefriendAction)
+}
+
+// canonicalEnum returns the canonical Schema.org URL of an enumeration member,
+// expanding the short form and upgrading `http://` to `https://`.
+// Values that are neither are returned trimmed but otherwise unchanged.
+func canonicalEnum(s string) string {
+	s = strings.TrimSpace(s)
+	switch {
+	case s == "":
+		return ""
+	case strings.HasPrefix(s, schemaOrgBaseURL):
+		return s
+	case strings.HasPrefix(s, "http://schema.org/"):
+		return schemaOrgBaseURL + strings.TrimPrefix(s, "http://schema.org/")
+	case strings.HasPrefix(s, "schema:"):
+		return schemaOrgBaseURL + strings.TrimPrefix(s, "schema:")
+	case !strings.ContainsAny(s, ":/ "):
+		return schemaOrgBaseURL + s
+	default:
+		return s
+	}
+}
+
+// canonical returns the enumeration member in its canonical URL form.
+func canonical[T ~string](v T) T {
+	return T(canonicalEnum(string(v)))
+}
+
+// marshalEnum encodes an enumeration member as its canonical URL.
+func marshalEnum[T ~string](v T) ([]byte, error) {
+	return json.Marshal(canonicalEnum(string(v)))
+}
+
+// unmarshalEnum decodes an enumeration member from either its short or URL form.
+func unmarshalEnum[T ~string](data []byte, v *T) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("%T: invalid JSON input: %s", *v, string(data))
+	}
+	*v = canonical(T(s))
+	return nil
+}
+
+// isKnownEnum reports whether v, in either form, is one of the known members.
+func isKnownEnum[T ~string](v T, known ...T) bool {
+	return slices.Contains(known, canonical(v))
+}
+
+// validateEnum returns an issue when a non-empty value is not a known member.
+func validateEnum(field string, value string, valid bool) []teseo.ValidationIssue {
+	if value == "" || valid {
+		return nil
+	}
+	var found issues
+	found.warnf(teseo.RuleUnknownValue, field, "unknown %s value %q", field, value)
+	return found
+}
@@ -0,0 +1,139 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+)
+
+func TestCanonicalEnum(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"", ""},
+		{"InStock", "https://schema.org/InStock"},
+		{"  InStock ", "https://schema.org/InStock"},
+		{"https://schema.org/InStock", "https://schema.org/InStock"},
+		{"http://schema.org/InStock", "https://schema.org/InStock"},
+		{"schema:InStock", "https://schema.org/InStock"},
+		{"https://example.com/Custom", "https://example.com/Custom"},
+		{"in stock", "in stock"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			if got := canonicalEnum(tt.input); got != tt.want {
+				t.Errorf("canonicalEnum(%q) = %q, want %q", tt.input, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnum_MarshalJSON_CanonicalURL(t *testing.T) {
+	offer := &Offer{Availability: "InStock", ItemCondition: UsedCondition}
+	data, err := json.Marshal(offer)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `{"@type":"","availability":"https://schema.org/InStock","itemCondition":"https://schema.org/UsedCondition"}`
+	if string(data) != want {
+		t.Errorf("expected %s, got %s", want, data)
+	}
+}
+
+func TestEnum_UnmarshalJSON_AcceptsBothForms(t *testing.T) {
+	var e Event
+	input := `{"eventStatus":"EventCancelled","eventAttendanceMode":"https://schema.org/OnlineEventAttendanceMode"}`
+	if err := json.Unmarshal([]byte(input), &e); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if e.EventStatus != EventCancelled {
+		t.Errorf("expected EventCancelled, got %q", e.EventStatus)
+	}
+	if e.EventAttendanceMode != OnlineEventAttendanceMode {
+		t.Errorf("expected OnlineEventAttendanceMode, got %q", e.EventAttendanceMode)
+	}
+
+	var g GenderType
+	if err := json.Unmarshal([]byte(`42`), &g); err == nil {
+		t.Errorf("expected error for non-string input")
+	}
+}
+
+func TestGenderType_FreeText(t *testing.T) {
+	tests := map[GenderType]string{
+		"Female":                    `"https://schema.org/Female"`,
+		"http://schema.org/Male":    `"https://schema.org/Male"`,
+		"Nonbinary":                 `"Nonbinary"`,
+		"https://example.com/other": `"https://example.com/other"`,
+	}
+	for g, expected := range tests {
+		data, err := json.Marshal(g)
+		if err != nil || string(data) != expected {
+			t.Errorf("Marshal(%q): expected %s, got %s (%v)", g, expected, data, err)
+		}
+		var decoded GenderType
+		if err := json.Unmarshal(data, &decoded); err != nil || `"`+string(decoded)+`"` != expected {
+			t.Errorf("Unmarshal(%s): got %q (%v)", data, decoded, err)
+		}
+	}
+}
+
+func TestEnum_IsValid(t *testing.T) {
+	tests := []struct {
+		name  string
+		valid bool
+		want  bool
+	}{
+		{"availability url", InStock.IsValid(), true},
+		{"availability short", ItemAvailability("OutOfStock").IsValid(), true},
+		{"availability typo", ItemAvailability("In Stock").IsValid(), false},
+		{"condition", OfferItemCondition("Refurbished").IsValid(), false},
+		{"event status", EventStatusType("EventPostponed").IsValid(), true},
+		{"attendance mode", EventAttendanceMode("Hybrid").IsValid(), false},
+		{"gender", GenderType("Female").IsValid(), true},
+		{"return fees", ReturnFees("FreeReturn").IsValid(), true},
+		{"return method", ReturnMethod("ReturnByFax").IsValid(), false},
+		{"return category", MerchantReturnPolicyCategory("MerchantReturnNotPermitted").IsValid(), true},
+		{"item list order", ItemListOrder("ItemListOrderAscending").IsValid(), true},
+		{"interaction type", InteractionType("http://schema.org/ShareAction").IsValid(), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.valid != tt.want {
+				t.Errorf("IsValid() = %v, want %v", tt.valid, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate_FlagsUnknownEnumValues(t *testing.T) {
+	e := &Event{
+		Name:                "Concert",
+		StartDate:           "2024-01-01T10:00:00",
+		Location:            &Place{Name: "Venue"},
+		EventStatus:         "Scheduled",
+		EventAttendanceMode: OfflineEventAttendanceMode,
+		Offers:              []*Offer{{Availability: "Available"}},
+	}
+	want := []string{
+		`unknown eventStatus value "Scheduled"`,
+		`unknown offers.availability value "Available"`,
+	}
+	if got := e.Validate(); !slices.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+
+	p := &Person{Name: "Jane", Email: "jane@example.com", JobTitle: "Engineer", Gender: "Nonbinary"}
+	if got := p.Validate(); got != nil {
+		t.Errorf("expected free-text gender to be accepted, got %v", got)
+	}
+
+	policy := &MerchantReturnPolicy{ApplicableCountry: StringList{"US"}, ReturnPolicyCategory: "MerchantReturnNotPermitted", ReturnFees: "ReturnShippingFees"}
+	want = []string{"missing required field: policy.returnShippingFeesAmount"}
+	if got := issueMessages(policy.validate("policy")); !slices.Equal(got, want) {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
@@ -1,6 +1,8 @@
 package schemaorg
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"html/template"
 
@@ -11,6 +13,14 @@ import (
 // Event represents a Schema.org Event object.
 // For more details about the meaning of the properties see:https://schema.org/Event
 //
+// Organizer and Performer accept a Person, an Organization (e.g. with Type
+// "MusicGroup" for a band), an @id reference or several of them combined with NewAgents.
+//
+// Location accepts a *Place, a *VirtualLocation for online events or both,
+// combined with NewEventLocations, for events with MixedEventAttendanceMode.
+// Recurring events describe their recurrence with EventSchedule, and
+// rescheduled events keep their former start dates in PreviousStartDate.
+//
 // Example usage:
 //
 // Pure struct usage:
@@ -33,6 +43,16 @@ import (
 //		"This is an example event",
 //	)
 //
+// Online event usage:
+//
+//	event := &schemaorg.Event{
+//		Type:                schemaorg.TypeBusinessEvent,
+//		Name:                "Example Webinar",
+//		StartDate:           "2024-09-20T19:00:00Z",
+//		EventAttendanceMode: schemaorg.OnlineEventAttendanceMode,
+//		Location:            schemaorg.NewVirtualLocation("https://www.example.com/live"),
+//	}
+//
 // // Rendering JSON-LD using templ:
 //
 //	templ Page() {
@@ -55,63 +75,165 @@ import (
 //		"description": "This is an example event"
 //	}
 type Event struct {
-	Context             string        `json:"@context"`
-	Type                string        `json:"@type"`
-	Name                string        `json:"name,omitempty"`
-	Description         string        `json:"description,omitempty"`
-	StartDate           string        `json:"startDate,omitempty"`
-	EndDate             string        `json:"endDate,omitempty"`
-	Location            *Place        `json:"location,omitempty"`
-	Organizer           *Organization `json:"organizer,omitempty"`
-	Performer           *Person       `json:"performer,omitempty"`
-	Image               []string      `json:"image,omitempty"`
-	EventStatus         string        `json:"eventStatus,omitempty"`
-	EventAttendanceMode string        `json:"eventAttendanceMode,omitempty"`
-	Offers              *Offer        `json:"offers,omitempty"`
+	Context             string              `json:"@context"`
+	Type                EventType           `json:"@type"`
+	Name                string              `json:"name,omitempty"`
+	Description         string              `json:"description,omitempty"`
+	StartDate           teseo.DateTime      `json:"startDate,omitempty"`
+	EndDate             teseo.DateTime      `json:"endDate,omitempty"`
+	Location            EventLocation       `json:"location,omitempty"`
+	Organizer           Agent               `json:"organizer,omitempty"`
+	Performer           Agent               `json:"performer,omitempty"`
+	Image               Images              `json:"image,omitempty"`
+	EventStatus         EventStatusType     `json:"eventStatus,omitempty"`
+	EventAttendanceMode EventAttendanceMode `json:"eventAttendanceMode,omitempty"`
+	Offers              []*Offer            `json:"offers,omitempty"`
+	EventSchedule       []*Schedule         `json:"eventSchedule,omitempty"`
+	PreviousStartDate   []teseo.DateTime    `json:"previousStartDate,omitempty"`
+	Extra               Extra               `json:"-"`
 }
 
 // Place represents a Schema.org Place object
+// Geo holds either a *GeoCoordinates point or a *GeoShape area.
 type Place struct {
-	Context string          `json:"@context"`
-	Type    string          `json:"@type"`
-	Name    string          `json:"name,omitempty"`
-	Address *PostalAddress  `json:"address,omitempty"`
-	Geo     *GeoCoordinates `json:"geo,omitempty"`
+	Context string         `json:"@context"`
+	Type    string         `json:"@type"`
+	Name    string         `json:"name,omitempty"`
+	Address *PostalAddress `json:"address,omitempty"`
+	Geo     GeoLocation    `json:"geo,omitempty"`
+	Extra   Extra          `json:"-"`
 }
 
 // NewEvent initializes an Event with default context and type.
-func NewEvent(name, description, startDate, endDate string, location *Place, organizer *Organization, performer *Person, images []string, eventStatus, eventAttendanceMode string, offers *Offer) *Event {
+func NewEvent(name, description, startDate, endDate string, location EventLocation, organizer, performer Agent, images []string, eventStatus, eventAttendanceMode string, offers ...*Offer) *Event {
+	var eventOffers []*Offer
+	for _, offer := range offers {
+		if offer != nil {
+			eventOffers = append(eventOffers, offer)
+		}
+	}
+
 	event := &Event{
 		Name:                name,
 		Description:         description,
-		StartDate:           startDate,
-		EndDate:             endDate,
+		StartDate:           teseo.DateTime(startDate),
+		EndDate:             teseo.DateTime(endDate),
 		Location:            location,
 		Organizer:           organizer,
 		Performer:           performer,
-		Image:               images,
-		EventStatus:         eventStatus,
-		EventAttendanceMode: eventAttendanceMode,
-		Offers:              offers,
+		Image:               NewImages(images...),
+		EventStatus:         EventStatusType(eventStatus),
+		EventAttendanceMode: EventAttendanceMode(eventAttendanceMode),
+		Offers:              eventOffers,
 	}
 	event.ensureDefaults()
 	return event
 }
 
-// Validate returns warnings for missing recommended Event fields.
+// Validate returns warnings for missing required or recommended Event fields, unknown
+// subtypes and inconsistencies between the event status, the attendance mode
+// and the locations.
 func (e *Event) Validate() []string {
-	var warnings []string
+	return issueMessages(e.ValidationIssues())
+}
+
+// ValidationIssues returns the issues found by Validate on the Event as structured values.
+func (e *Event) ValidationIssues() []teseo.ValidationIssue {
+	var found issues
 
 	if e.Name == "" {
-		warnings = append(warnings, "missing recommended field: name")
+		found.required("name")
 	}
 	if e.StartDate == "" {
-		warnings = append(warnings, "missing recommended field: startDate")
+		found.required("startDate")
+	}
+	if isNilValue(e.Location) {
+		found.required("location")
+	}
+	if isNearMiss(e.Type, eventParents) {
+		found.warnf(teseo.RuleUnknownType, "@type", "unrecognized Event subtype %q", e.Type)
+	}
+	found.add(validateAgent("organizer", teseo.SeverityRecommended, e.Organizer)...)
+	found.add(validateAgent("performer", teseo.SeverityRecommended, e.Performer)...)
+	found.add(validateDateTime("startDate", e.StartDate)...)
+	found.add(validateDateTime("endDate", e.EndDate)...)
+	found.add(validateTimeOrder("startDate", e.StartDate, "endDate", e.EndDate)...)
+	found.add(validateEnum("eventStatus", string(e.EventStatus), e.EventStatus.IsValid())...)
+	found.add(validateEnum("eventAttendanceMode", string(e.EventAttendanceMode), e.EventAttendanceMode.IsValid())...)
+	found.add(e.validateStatus()...)
+	for i, d := range e.PreviousStartDate {
+		found.add(validateDateTime(fmt.Sprintf("previousStartDate[%d]", i), d)...)
+	}
+	for i, s := range e.EventSchedule {
+		if s != nil {
+			found.add(s.validate(fmt.Sprintf("eventSchedule[%d]", i))...)
+		}
 	}
-	if e.Location == nil {
-		warnings = append(warnings, "missing recommended field: location")
+	for i, offer := range e.Offers {
+		prefix := "offers"
+		if len(e.Offers) > 1 {
+			prefix = fmt.Sprintf("offers[%d]", i)
+		}
+		if offer != nil {
+			found.add(offer.validateEnums(prefix)...)
+		}
 	}
-	return warnings
+	found.add(e.Image.validate("image")...)
+	found.add(validateImageDimensions("image", e.Image)...)
+	found.add(validateFormats(e)...)
+	return found
+}
+
+// validateStatus checks that the attendance mode matches the kinds of location
+// and that moved online and rescheduled events carry the matching details.
+func (e *Event) validateStatus() []teseo.ValidationIssue {
+	var found issues
+
+	var hasPlace, hasVirtual bool
+	for _, l := range eventLocationList(e.Location) {
+		switch l.(type) {
+		case *Place:
+			hasPlace = true
+		case *VirtualLocation:
+			hasVirtual = true
+		}
+	}
+
+	mode := canonical(e.EventAttendanceMode)
+	switch mode {
+	case OnlineEventAttendanceMode:
+		if !hasVirtual {
+			found.warnf(teseo.RuleConstraint, "location", "online events require a VirtualLocation in location")
+		}
+	case MixedEventAttendanceMode:
+		if !hasPlace || !hasVirtual {
+			found.warnf(teseo.RuleConstraint, "location", "mixed attendance events require both a Place and a VirtualLocation in location")
+		}
+	case OfflineEventAttendanceMode, "":
+		if hasVirtual && !hasPlace {
+			found.warnf(teseo.RuleConstraint, "eventAttendanceMode", "events with only a VirtualLocation should use OnlineEventAttendanceMode")
+		}
+	}
+
+	status := canonical(e.EventStatus)
+	switch status {
+	case EventMovedOnline:
+		if mode != OnlineEventAttendanceMode {
+			found.warnf(teseo.RuleConstraint, "eventAttendanceMode", "events moved online should use OnlineEventAttendanceMode")
+		}
+		if !hasVirtual {
+			found.warnf(teseo.RuleConstraint, "location", "events moved online require a VirtualLocation in location")
+		}
+	case EventRescheduled:
+		if len(e.PreviousStartDate) == 0 {
+			found.recommended("previousStartDate")
+		}
+	}
+	if len(e.PreviousStartDate) > 0 && status != EventRescheduled {
+		found.warnf(teseo.RuleConstraint, "previousStartDate", "previousStartDate only applies to events with EventRescheduled status")
+	}
+
+	return found
 }
 
 // ToJsonLd converts the Event struct to a JSON-LD `templ.Component`.
@@ -126,6 +248,63 @@ func (e *Event) ToGoHTMLJsonLd() (template.HTML, error) {
 	return teseo.RenderToHTML(e.ToJsonLd())
 }
 
+// UnmarshalJSON decodes an Event, resolving `organizer` and `performer` to Person, Organization or @id reference nodes
+// and `location` to Place or VirtualLocation nodes based on their `@type`. A single `offers` object is decoded as a one-item list.
+func (e *Event) UnmarshalJSON(data []byte) error {
+	type alias Event
+	aux := struct {
+		*alias
+		Organizer json.RawMessage `json:"organizer,omitempty"`
+		Performer json.RawMessage `json:"performer,omitempty"`
+		Location  json.RawMessage `json:"location,omitempty"`
+		Offers    json.RawMessage `json:"offers,omitempty"`
+	}{alias: (*alias)(e)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, aux)
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	organizer, err := unmarshalAgent(aux.Organizer)
+	if err != nil {
+		return fmt.Errorf("Event: invalid organizer: %w", err)
+	}
+	e.Organizer = organizer
+
+	performer, err := unmarshalAgent(aux.Performer)
+	if err != nil {
+		return fmt.Errorf("Event: invalid performer: %w", err)
+	}
+	e.Performer = performer
+
+	location, err := unmarshalEventLocation(aux.Location)
+	if err != nil {
+		return fmt.Errorf("Event: invalid location: %w", err)
+	}
+	e.Location = location
+
+	e.Offers = nil
+	if offers := bytes.TrimSpace(aux.Offers); len(offers) > 0 && string(offers) != "null" {
+		if offers[0] != '[' {
+			offers = append(append([]byte{'['}, offers...), ']')
+		}
+		if err := json.Unmarshal(offers, &e.Offers); err != nil {
+			return fmt.Errorf("Event: invalid offers: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes an Event, merging the Extra properties into the JSON-LD object.
+func (e Event) MarshalJSON() ([]byte, error) {
+	type alias Event
+	return marshalWithExtra(alias(e), e.Extra)
+}
+
 // ensureDefaults sets default values for Event and its nested objects if they are not already set.
 func (e *Event) ensureDefaults() {
 	if e.Context == "" {
@@ -136,21 +315,33 @@ func (e *Event) ensureDefaults() {
 		e.Type = "Event"
 	}
 
-	if e.Location != nil {
-		e.Location.ensureDefaults()
+	for _, l := range eventLocationList(e.Location) {
+		l.ensureDefaults()
 	}
 
-	if e.Organizer != nil {
-		e.Organizer.ensureDefaults()
-	}
+	ensureAgentDefaults(e.Organizer)
+
+	ensureAgentDefaults(e.Performer)
 
-	if e.Performer != nil {
-		e.Performer.ensureDefaults()
+	for _, offer := range e.Offers {
+		if offer != nil {
+			offer.ensureDefaults()
+		}
 	}
 
-	if e.Offers != nil {
-		e.Offers.ensureDefaults()
+	for _, s := range e.EventSchedule {
+		if s != nil {
+			s.ensureDefaults()
+		}
 	}
+
+	e.Image.ensureDefaults()
+}
+
+// MarshalJSON encodes a Place, merging the Extra properties into the JSON-LD object.
+func (p Place) MarshalJSON() ([]byte, error) {
+	type alias Place
+	return marshalWithExtra(alias(p), p.Extra)
 }
 
 // ensureDefaults sets default values for Place if they are not already set.
@@ -170,3 +361,40 @@ func (p *Place) ensureDefaults() {
 		p.Geo.ensureDefaults()
 	}
 }
+
+// UnmarshalJSON decodes a Place, resolving `geo` to *GeoCoordinates or *GeoShape based on its `@type`.
+func (p *Place) UnmarshalJSON(data []byte) error {
+	type alias Place
+	aux := struct {
+		*alias
+		Geo json.RawMessage `json:"geo,omitempty"`
+	}{alias: (*alias)(p)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, aux)
+	if err != nil {
+		return err
+	}
+	p.Extra = extra
+	p.Geo = nil
+	if len(aux.Geo) == 0 || string(aux.Geo) == "null" {
+		return nil
+	}
+
+	var probe struct {
+		Type string `json:"@type"`
+	}
+	if err := json.Unmarshal(aux.Geo, &probe); err != nil {
+		return fmt.Errorf("Place: invalid geo: %w", err)
+	}
+	var geo GeoLocation = &GeoCoordinates{}
+	if probe.Type == "GeoShape" {
+		geo = &GeoShape{}
+	}
+	if err := json.Unmarshal(aux.Geo, geo); err != nil {
+		return fmt.Errorf("Place: invalid geo: %w", err)
+	}
+	p.Geo = geo
+	return nil
+}
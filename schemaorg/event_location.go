@@ -0,0 +1,129 @@
+package schemaorg
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// EventLocation is implemented by the values accepted as the `location` of an
+// Event: a physical *Place, a *VirtualLocation for online events, or
+// EventLocations holding both for hybrid events.
+type EventLocation interface {
+	ensureDefaults()
+	isEventLocation()
+}
+
+func (*Place) isEventLocation()           {}
+func (*VirtualLocation) isEventLocation() {}
+func (EventLocations) isEventLocation()   {}
+
+// VirtualLocation represents a Schema.org VirtualLocation object, the online
+// location (e.g. a livestream URL) of an online or hybrid event.
+// For more details see: https://schema.org/VirtualLocation
+type VirtualLocation struct {
+	Type string `json:"@type"`
+	Name string `json:"name,omitempty"`
+	URL  string `json:"url,omitempty"`
+}
+
+// NewVirtualLocation initializes a VirtualLocation with default type.
+func NewVirtualLocation(url string) *VirtualLocation {
+	location := &VirtualLocation{URL: url}
+	location.ensureDefaults()
+	return location
+}
+
+// ensureDefaults sets default values for VirtualLocation if they are not already set.
+func (vl *VirtualLocation) ensureDefaults() {
+	if vl.Type == "" {
+		vl.Type = "VirtualLocation"
+	}
+}
+
+// EventLocations holds several locations, typically a Place and a
+// VirtualLocation for events with MixedEventAttendanceMode.
+// It is rendered as a JSON object when it holds a single location and as an array otherwise.
+type EventLocations []EventLocation
+
+// NewEventLocations groups the given locations, skipping nil values.
+func NewEventLocations(locations ...EventLocation) EventLocations {
+	var list EventLocations
+	for _, l := range locations {
+		list = append(list, eventLocationList(l)...)
+	}
+	return list
+}
+
+// MarshalJSON renders a single location as an object and multiple locations as an array.
+func (el EventLocations) MarshalJSON() ([]byte, error) {
+	if len(el) == 1 {
+		return json.Marshal(el[0])
+	}
+	return json.Marshal([]EventLocation(el))
+}
+
+// ensureDefaults sets default values for each location in the list.
+func (el EventLocations) ensureDefaults() {
+	for _, l := range eventLocationList(el) {
+		l.ensureDefaults()
+	}
+}
+
+// eventLocationList flattens an EventLocation into its locations, dropping nil values.
+func eventLocationList(l EventLocation) []EventLocation {
+	if isNilValue(l) {
+		return nil
+	}
+	list, ok := l.(EventLocations)
+	if !ok {
+		return []EventLocation{l}
+	}
+	var locations []EventLocation
+	for _, item := range list {
+		locations = append(locations, eventLocationList(item)...)
+	}
+	return locations
+}
+
+// unmarshalEventLocation decodes a JSON object or array into an EventLocation,
+// resolving VirtualLocation nodes by their `@type` and any other node to a Place.
+func unmarshalEventLocation(data json.RawMessage) (EventLocation, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil
+	}
+
+	if data[0] == '[' {
+		var items []json.RawMessage
+		if err := json.Unmarshal(data, &items); err != nil {
+			return nil, err
+		}
+		var locations EventLocations
+		for _, item := range items {
+			location, err := unmarshalEventLocation(item)
+			if err != nil {
+				return nil, err
+			}
+			if location != nil {
+				locations = append(locations, location)
+			}
+		}
+		return locations, nil
+	}
+
+	var probe struct {
+		Type string `json:"@type"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return nil, fmt.Errorf("invalid location: %s", string(data))
+	}
+	var location EventLocation = &Place{}
+	if probe.Type == "VirtualLocation" {
+		location = &VirtualLocation{}
+	}
+	if err := json.Unmarshal(data, location); err != nil {
+		return nil, err
+	}
+	return location, nil
+}
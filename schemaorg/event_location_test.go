@@ -0,0 +1,147 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/indaco/teseo"
+)
+
+func TestEventLocations_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		location EventLocation
+		expected string
+	}{
+		{
+			name:     "single location",
+			location: NewEventLocations(NewVirtualLocation("https://www.example.com/live")),
+			expected: `{"@type":"VirtualLocation","url":"https://www.example.com/live"}`,
+		},
+		{
+			name:     "hybrid event",
+			location: NewEventLocations(&Place{Type: "Place", Name: "Venue"}, nil, (*VirtualLocation)(nil), NewVirtualLocation("https://www.example.com/live")),
+			expected: `[{"@context":"","@type":"Place","name":"Venue"},{"@type":"VirtualLocation","url":"https://www.example.com/live"}]`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.location)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if string(data) != tt.expected {
+				t.Errorf("expected %s, got %s", tt.expected, data)
+			}
+		})
+	}
+}
+
+func TestEvent_UnmarshalJSON_LocationsAndOffers(t *testing.T) {
+	data := `{
+		"@context": "https://schema.org",
+		"@type": "MusicEvent",
+		"name": "Concert",
+		"location": [
+			{"@type": "Place", "name": "Venue"},
+			{"@type": "VirtualLocation", "url": "https://www.example.com/live"}
+		],
+		"offers": {"@type": "Offer", "price": "10.00"}
+	}`
+
+	var e Event
+	if err := json.Unmarshal([]byte(data), &e); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	locations, ok := e.Location.(EventLocations)
+	if !ok || len(locations) != 2 {
+		t.Fatalf("expected two locations, got %#v", e.Location)
+	}
+	if _, ok := locations[0].(*Place); !ok {
+		t.Errorf("expected first location to be *Place, got %T", locations[0])
+	}
+	if vl, ok := locations[1].(*VirtualLocation); !ok || vl.URL != "https://www.example.com/live" {
+		t.Errorf("expected second location to be the VirtualLocation, got %#v", locations[1])
+	}
+	if len(e.Offers) != 1 || e.Offers[0].Price != "10.00" {
+		t.Errorf("expected a single offer, got %#v", e.Offers)
+	}
+
+	if err := json.Unmarshal([]byte(`{"location": "Venue"}`), &e); err == nil {
+		t.Errorf("expected an error for a string location")
+	}
+}
+
+func TestEvent_Validate_StatusAndAttendanceMode(t *testing.T) {
+	place := &Place{Name: "Venue"}
+	virtual := NewVirtualLocation("https://www.example.com/live")
+
+	tests := []struct {
+		name     string
+		event    *Event
+		expected []string
+	}{
+		{
+			name:  "online event",
+			event: &Event{EventAttendanceMode: OnlineEventAttendanceMode, Location: virtual},
+		},
+		{
+			name:     "online event at a place",
+			event:    &Event{EventAttendanceMode: OnlineEventAttendanceMode, Location: place},
+			expected: []string{"online events require a VirtualLocation in location"},
+		},
+		{
+			name:  "hybrid event",
+			event: &Event{EventAttendanceMode: MixedEventAttendanceMode, Location: NewEventLocations(place, virtual)},
+		},
+		{
+			name:     "hybrid event without a virtual location",
+			event:    &Event{EventAttendanceMode: MixedEventAttendanceMode, Location: place},
+			expected: []string{"mixed attendance events require both a Place and a VirtualLocation in location"},
+		},
+		{
+			name:     "offline event with only a virtual location",
+			event:    &Event{Location: virtual},
+			expected: []string{"events with only a VirtualLocation should use OnlineEventAttendanceMode"},
+		},
+		{
+			name:  "moved online",
+			event: &Event{EventStatus: "EventMovedOnline", EventAttendanceMode: OnlineEventAttendanceMode, Location: virtual},
+		},
+		{
+			name:  "moved online without updating the event",
+			event: &Event{EventStatus: EventMovedOnline, EventAttendanceMode: OfflineEventAttendanceMode, Location: place},
+			expected: []string{
+				"events moved online should use OnlineEventAttendanceMode",
+				"events moved online require a VirtualLocation in location",
+			},
+		},
+		{
+			name:  "rescheduled",
+			event: &Event{EventStatus: EventRescheduled, Location: place, PreviousStartDate: []teseo.DateTime{"2024-01-01T10:00:00Z"}},
+		},
+		{
+			name:     "rescheduled without previous start date",
+			event:    &Event{EventStatus: EventRescheduled, Location: place},
+			expected: []string{"missing recommended field: previousStartDate"},
+		},
+		{
+			name:  "previous start date on a scheduled event",
+			event: &Event{EventStatus: EventScheduled, Location: place, PreviousStartDate: []teseo.DateTime{"01/01/2024"}},
+			expected: []string{
+				"previousStartDate only applies to events with EventRescheduled status",
+				`invalid ISO 8601 date-time for previousStartDate[0]: "01/01/2024"`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.event.Name = "Event"
+			tt.event.StartDate = "2024-02-01T10:00:00Z"
+			if got := tt.event.Validate(); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package schemaorg
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -16,7 +17,7 @@ func TestNewEvent_SetsDefaults(t *testing.T) {
 	if event.Context != "https://schema.org" {
 		t.Errorf("expected context to be schema.org, got %s", event.Context)
 	}
-	if event.Location.Type != "Place" {
+	if event.Location.(*Place).Type != "Place" {
 		t.Errorf("expected nested Place type to be set")
 	}
 }
@@ -40,7 +41,7 @@ func TestEvent_EnsureDefaults_WithNestedValues(t *testing.T) {
 	event := &Event{
 		Organizer: org,
 		Performer: per,
-		Offers:    offer,
+		Offers:    []*Offer{offer},
 	}
 
 	event.ensureDefaults()
@@ -82,7 +83,7 @@ func TestEvent_Validate_MissingName(t *testing.T) {
 		Location:  &Place{Name: "Venue"},
 	}
 	w := e.Validate()
-	if len(w) != 1 || w[0] != "missing recommended field: name" {
+	if len(w) != 1 || w[0] != "missing required field: name" {
 		t.Errorf("expected name warning, got %v", w)
 	}
 }
@@ -93,7 +94,7 @@ func TestEvent_Validate_MissingStartDate(t *testing.T) {
 		Location: &Place{Name: "Venue"},
 	}
 	w := e.Validate()
-	if len(w) != 1 || w[0] != "missing recommended field: startDate" {
+	if len(w) != 1 || w[0] != "missing required field: startDate" {
 		t.Errorf("expected startDate warning, got %v", w)
 	}
 }
@@ -104,7 +105,7 @@ func TestEvent_Validate_MissingLocation(t *testing.T) {
 		StartDate: "2024-01-01T10:00:00",
 	}
 	w := e.Validate()
-	if len(w) != 1 || w[0] != "missing recommended field: location" {
+	if len(w) != 1 || w[0] != "missing required field: location" {
 		t.Errorf("expected location warning, got %v", w)
 	}
 }
@@ -112,9 +113,9 @@ func TestEvent_Validate_MissingLocation(t *testing.T) {
 func TestEvent_Validate_AllMissing(t *testing.T) {
 	e := &Event{}
 	expected := map[string]bool{
-		"missing recommended field: name":      true,
-		"missing recommended field: startDate": true,
-		"missing recommended field: location":  true,
+		"missing required field: name":      true,
+		"missing required field: startDate": true,
+		"missing required field: location":  true,
 	}
 	warnings := e.Validate()
 	if len(warnings) != len(expected) {
@@ -170,3 +171,55 @@ func TestPlace_EnsureDefaults_NilAddressAndGeo(t *testing.T) {
 	}
 	// This test ensures no panic and default values are set even if Address and Geo are nil.
 }
+
+func TestEvent_Validate_EventSchedule(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule *Schedule
+		expected []string
+	}{
+		{
+			name:     "weekly schedule",
+			schedule: &Schedule{StartDate: "2024-01-01", RepeatFrequency: "P1W", ByDay: StringList{"https://schema.org/Monday", "TU"}, StartTime: "19:00", Duration: "PT2H"},
+		},
+		{
+			name:     "missing recurrence",
+			schedule: &Schedule{},
+			expected: []string{
+				"missing recommended field: eventSchedule[0].startDate",
+				"missing recommended field: eventSchedule[0].repeatFrequency",
+			},
+		},
+		{
+			name:     "malformed values",
+			schedule: &Schedule{StartDate: "2024-03-01", EndDate: "2024-01-01", RepeatFrequency: "every week", RepeatCount: -1, ByDay: StringList{"Mon"}, StartTime: "7pm", Duration: "2h"},
+			expected: []string{
+				`invalid repeatFrequency for eventSchedule[0]: "every week"`,
+				"eventSchedule[0].repeatCount must not be negative, got -1",
+				`unknown eventSchedule[0].byDay[0] value "Mon"`,
+				`invalid time for eventSchedule[0].startTime: "7pm"`,
+				`invalid duration for eventSchedule[0].duration: "2h"`,
+				`eventSchedule[0].endDate "2024-01-01" is before eventSchedule[0].startDate "2024-03-01"`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			e := &Event{Name: "Meetup", StartDate: "2024-01-01T19:00:00Z", Location: &Place{Name: "Venue"}, EventSchedule: []*Schedule{tt.schedule}}
+			if got := e.Validate(); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestNewEvent_MultipleOffers(t *testing.T) {
+	e := NewEvent("Concert", "", "2024-01-01T19:00:00Z", "", &Place{Name: "Venue"}, nil, nil, nil, "", "", &Offer{Price: "10.00"}, nil, &Offer{Availability: "Available"})
+	if len(e.Offers) != 2 || e.Offers[1].Type != "Offer" {
+		t.Fatalf("expected two offers with defaults, got %#v", e.Offers)
+	}
+	expected := []string{`unknown offers[1].availability value "Available"`}
+	if got := e.Validate(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
@@ -0,0 +1,73 @@
+package schemaorg
+
+// EventType is the `@type` of an Event: Event itself or one of its subtypes,
+// such as MusicEvent for concerts or SportsEvent for matches.
+// For the full hierarchy see: https://schema.org/Event
+type EventType string
+
+const (
+	TypeEvent           EventType = "Event"
+	TypeBusinessEvent   EventType = "BusinessEvent"
+	TypeChildrensEvent  EventType = "ChildrensEvent"
+	TypeComedyEvent     EventType = "ComedyEvent"
+	TypeDanceEvent      EventType = "DanceEvent"
+	TypeDeliveryEvent   EventType = "DeliveryEvent"
+	TypeEducationEvent  EventType = "EducationEvent"
+	TypeExhibitionEvent EventType = "ExhibitionEvent"
+	TypeFestival        EventType = "Festival"
+	TypeFoodEvent       EventType = "FoodEvent"
+	TypeHackathon       EventType = "Hackathon"
+	TypeLiteraryEvent   EventType = "LiteraryEvent"
+	TypeMusicEvent      EventType = "MusicEvent"
+	TypeSaleEvent       EventType = "SaleEvent"
+	TypeScreeningEvent  EventType = "ScreeningEvent"
+	TypeSocialEvent     EventType = "SocialEvent"
+	TypeSportsEvent     EventType = "SportsEvent"
+	TypeTheaterEvent    EventType = "TheaterEvent"
+	TypeVisualArtsEvent EventType = "VisualArtsEvent"
+)
+
+// eventParents maps every catalogued Event type to its parent type.
+var eventParents = map[EventType]EventType{
+	TypeEvent:           "",
+	TypeBusinessEvent:   TypeEvent,
+	TypeChildrensEvent:  TypeEvent,
+	TypeComedyEvent:     TypeEvent,
+	TypeDanceEvent:      TypeEvent,
+	TypeDeliveryEvent:   TypeEvent,
+	TypeEducationEvent:  TypeEvent,
+	TypeExhibitionEvent: TypeEvent,
+	TypeFestival:        TypeEvent,
+	TypeFoodEvent:       TypeEvent,
+	TypeHackathon:       TypeEvent,
+	TypeLiteraryEvent:   TypeEvent,
+	TypeMusicEvent:      TypeEvent,
+	TypeSaleEvent:       TypeEvent,
+	TypeScreeningEvent:  TypeEvent,
+	TypeSocialEvent:     TypeEvent,
+	TypeSportsEvent:     TypeEvent,
+	TypeTheaterEvent:    TypeEvent,
+	TypeVisualArtsEvent: TypeEvent,
+}
+
+// IsValid reports whether the type is Event or one of the catalogued subtypes.
+func (t EventType) IsValid() bool {
+	_, ok := eventParents[t]
+	return ok
+}
+
+// Parent returns the parent type of a catalogued type, or an empty value for
+// Event and for types outside the catalogue.
+func (t EventType) Parent() EventType {
+	return eventParents[t]
+}
+
+// IsA reports whether the type is ancestor or one of its catalogued subtypes.
+func (t EventType) IsA(ancestor EventType) bool {
+	for current := t; current != ""; current = current.Parent() {
+		if current == ancestor {
+			return true
+		}
+	}
+	return false
+}
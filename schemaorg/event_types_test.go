@@ -0,0 +1,53 @@
+package schemaorg
+
+import "testing"
+
+func TestEventType_IsA(t *testing.T) {
+	tests := []struct {
+		t        EventType
+		ancestor EventType
+		want     bool
+	}{
+		{TypeMusicEvent, TypeEvent, true},
+		{TypeSportsEvent, TypeSportsEvent, true},
+		{TypeBusinessEvent, TypeMusicEvent, false},
+		{"Concert", TypeEvent, false},
+	}
+	for _, tt := range tests {
+		if got := tt.t.IsA(tt.ancestor); got != tt.want {
+			t.Errorf("%s.IsA(%s) = %v, want %v", tt.t, tt.ancestor, got, tt.want)
+		}
+	}
+}
+
+func TestEvent_Validate_Subtype(t *testing.T) {
+	e := &Event{Type: "MusicEvnt", Name: "Concert", StartDate: "2024-01-01T10:00:00", Location: &Place{Name: "Venue"}}
+	w := e.Validate()
+	if len(w) != 1 || w[0] != `unrecognized Event subtype "MusicEvnt"` {
+		t.Errorf("expected subtype warning, got %v", w)
+	}
+
+	e.Type = "Hackathon"
+	if w := e.Validate(); len(w) != 0 {
+		t.Errorf("expected no warnings for a type outside the catalogue, got %v", w)
+	}
+
+	e.Type = TypeMusicEvent
+	if w := e.Validate(); len(w) != 0 {
+		t.Errorf("expected no warnings, got %v", w)
+	}
+}
+
+func TestDecode_EventSubtype(t *testing.T) {
+	things, err := Decode([]byte(`{"@context":"https://schema.org","@type":"Hackathon","name":"Hack Day"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	e, ok := things[0].(*Event)
+	if !ok {
+		t.Fatalf("expected *Event, got %T", things[0])
+	}
+	if e.Type != TypeHackathon {
+		t.Errorf("expected type Hackathon, got %s", e.Type)
+	}
+}
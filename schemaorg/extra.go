@@ -0,0 +1,137 @@
+package schemaorg
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Extra holds additional JSON-LD properties that have no dedicated field on a
+// struct, such as `foundingDate` on an Organization or a property of a subtype
+// selected by overriding `@type` (e.g. `acceptedPaymentMethod` on a `Dentist`).
+//
+// Extra properties are merged into the marshalled JSON-LD, sorted by key, and
+// properties without a matching field are captured into Extra on unmarshal.
+// Keys that match a field of the struct are ignored when marshalling, so Extra
+// can never override a typed field.
+//
+// Example usage:
+//
+//	org := &schemaorg.Organization{
+//		Name: "Example Corp",
+//		Extra: schemaorg.Extra{
+//			"foundingDate":      "1999-04-01",
+//			"numberOfEmployees": 120,
+//		},
+//	}
+type Extra map[string]any
+
+// marshalWithExtra marshals v, which must not implement json.Marshaler itself
+// (callers pass an alias type), and appends the extra properties to the object.
+func marshalWithExtra(v any, extra Extra) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil || len(extra) == 0 {
+		return data, err
+	}
+
+	known := jsonFieldNames(reflect.TypeOf(v))
+	keys := make([]string, 0, len(extra))
+	for k := range extra {
+		if !known[k] {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) == 0 {
+		return data, nil
+	}
+	sort.Strings(keys)
+
+	var buf bytes.Buffer
+	buf.Write(data[:len(data)-1])
+	for i, k := range keys {
+		value, err := json.Marshal(extra[k])
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 || len(data) > 2 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(k)
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// unmarshalExtra returns the properties of the JSON object in data that have no
+// matching field in the struct type of v, or nil if there are none.
+func unmarshalExtra(data []byte, v any) (Extra, error) {
+	var props map[string]json.RawMessage
+	if err := json.Unmarshal(data, &props); err != nil {
+		return nil, err
+	}
+
+	known := jsonFieldNames(reflect.TypeOf(v))
+	var extra Extra
+	for k, raw := range props {
+		if known[k] {
+			continue
+		}
+		var value any
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return nil, err
+		}
+		if extra == nil {
+			extra = Extra{}
+		}
+		extra[k] = value
+	}
+	return extra, nil
+}
+
+// fieldNamesCache caches the JSON property names of struct types.
+var fieldNamesCache sync.Map
+
+// jsonFieldNames returns the set of JSON property names of a struct type,
+// including those of embedded structs.
+func jsonFieldNames(t reflect.Type) map[string]bool {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if cached, ok := fieldNamesCache.Load(t); ok {
+		return cached.(map[string]bool)
+	}
+
+	names := map[string]bool{}
+	if t.Kind() == reflect.Struct {
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			tag := f.Tag.Get("json")
+			if tag == "-" {
+				continue
+			}
+			name, _, _ := strings.Cut(tag, ",")
+			if f.Anonymous && name == "" {
+				for k := range jsonFieldNames(f.Type) {
+					names[k] = true
+				}
+				continue
+			}
+			if !f.IsExported() {
+				continue
+			}
+			if name == "" {
+				name = f.Name
+			}
+			names[name] = true
+		}
+	}
+
+	fieldNamesCache.Store(t, names)
+	return names
+}
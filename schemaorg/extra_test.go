@@ -0,0 +1,125 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestExtra_Marshal(t *testing.T) {
+	org := &Organization{
+		Type: "Organization",
+		Name: "Example Corp",
+		Extra: Extra{
+			"slogan":     "Examples for everyone",
+			"knowsAbout": "Structured data",
+			"name":       "ignored",
+		},
+	}
+
+	data, err := json.Marshal(org)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `{"@context":"","@type":"Organization","name":"Example Corp","knowsAbout":"Structured data","slogan":"Examples for everyone"}`
+	if string(data) != expected {
+		t.Errorf("expected %s, got %s", expected, data)
+	}
+}
+
+func TestExtra_Unmarshal(t *testing.T) {
+	data := `{
+		"@context": "https://schema.org",
+		"@type": "Organization",
+		"name": "Example Corp",
+		"slogan": "Examples for everyone",
+		"brand": {"@type": "Brand", "name": "Example"}
+	}`
+
+	var org Organization
+	if err := json.Unmarshal([]byte(data), &org); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if org.Name != "Example Corp" {
+		t.Errorf("expected name Example Corp, got %s", org.Name)
+	}
+	expected := Extra{
+		"slogan": "Examples for everyone",
+		"brand":  map[string]any{"@type": "Brand", "name": "Example"},
+	}
+	if !reflect.DeepEqual(org.Extra, expected) {
+		t.Errorf("expected extra %v, got %v", expected, org.Extra)
+	}
+}
+
+func TestExtra_UnmarshalNoExtra(t *testing.T) {
+	var p Person
+	if err := json.Unmarshal([]byte(`{"@type": "Person", "name": "Jane"}`), &p); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.Extra != nil {
+		t.Errorf("expected nil extra, got %v", p.Extra)
+	}
+}
+
+func TestExtra_CustomUnmarshalers(t *testing.T) {
+	data := `{
+		"@type": "Product",
+		"name": "Coat",
+		"offers": {"@type": "AggregateOffer", "lowPrice": "10", "priceCurrency": "USD"},
+		"countryOfOrigin": "IT"
+	}`
+
+	var p Product
+	if err := json.Unmarshal([]byte(data), &p); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if p.AggregateOffer == nil {
+		t.Fatal("expected aggregate offer to be decoded")
+	}
+	if p.Extra["countryOfOrigin"] != "IT" {
+		t.Errorf("expected countryOfOrigin in extra, got %v", p.Extra)
+	}
+
+	out, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var roundTrip map[string]any
+	if err := json.Unmarshal(out, &roundTrip); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if roundTrip["countryOfOrigin"] != "IT" || roundTrip["offers"] == nil {
+		t.Errorf("expected offers and countryOfOrigin to round-trip, got %s", out)
+	}
+}
+
+func TestExtra_SubtypeOverride(t *testing.T) {
+	lb := &LocalBusiness{
+		Type:  "Dentist",
+		Name:  "Smile Dental",
+		Extra: Extra{"medicalSpecialty": "Dentistry"},
+	}
+	lb.ensureDefaults()
+
+	if lb.Type != "Dentist" {
+		t.Errorf("expected @type Dentist to be kept, got %s", lb.Type)
+	}
+	base := &LocalBusiness{Name: "Smile Dental"}
+	if !reflect.DeepEqual(lb.Validate(), base.Validate()) {
+		t.Errorf("expected subtype to be validated as LocalBusiness, got %v", lb.Validate())
+	}
+
+	data, err := json.Marshal(lb)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	things, err := Decode(data)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	decoded, ok := things[0].(*LocalBusiness)
+	if !ok || decoded.Type != "Dentist" || decoded.Extra["medicalSpecialty"] != "Dentistry" {
+		t.Errorf("expected Dentist with medicalSpecialty, got %#v", things[0])
+	}
+}
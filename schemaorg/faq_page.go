@@ -1,6 +1,7 @@
 package schemaorg
 
 import (
+	"encoding/json"
 	"fmt"
 	"html/template"
 
@@ -56,19 +57,39 @@ type FAQPage struct {
 	Context    string      `json:"@context"`
 	Type       string      `json:"@type"`
 	MainEntity []*Question `json:"mainEntity,omitempty"`
+	Extra      Extra       `json:"-"`
 }
 
-// Question represents a Schema.org Question object
+// Question represents a Schema.org Question object.
+// FAQPage only uses Name and AcceptedAnswer; the remaining fields describe
+// user-submitted questions on a QAPage.
 type Question struct {
-	Type           string  `json:"@type"`
-	Name           string  `json:"name,omitempty"`
-	AcceptedAnswer *Answer `json:"acceptedAnswer,omitempty"`
+	Type            string         `json:"@type"`
+	Name            string         `json:"name,omitempty"`
+	Text            string         `json:"text,omitempty"`
+	AnswerCount     int            `json:"answerCount,omitempty"`
+	UpvoteCount     int            `json:"upvoteCount,omitempty"`
+	DatePublished   teseo.DateTime `json:"datePublished,omitempty"`
+	DateModified    teseo.DateTime `json:"dateModified,omitempty"`
+	DateCreated     teseo.DateTime `json:"dateCreated,omitempty"`
+	Author          Agent          `json:"author,omitempty"`
+	AcceptedAnswer  *Answer        `json:"acceptedAnswer,omitempty"`
+	SuggestedAnswer []*Answer      `json:"suggestedAnswer,omitempty"`
+	Extra           Extra          `json:"-"`
 }
 
-// Answer represents a Schema.org Answer object
+// Answer represents a Schema.org Answer object.
+// FAQPage only uses Text; the remaining fields describe answers on a QAPage.
 type Answer struct {
-	Type string `json:"@type"`
-	Text string `json:"text,omitempty"`
+	Type          string         `json:"@type"`
+	Text          string         `json:"text,omitempty"`
+	URL           string         `json:"url,omitempty"`
+	UpvoteCount   int            `json:"upvoteCount,omitempty"`
+	DatePublished teseo.DateTime `json:"datePublished,omitempty"`
+	DateModified  teseo.DateTime `json:"dateModified,omitempty"`
+	DateCreated   teseo.DateTime `json:"dateCreated,omitempty"`
+	Author        Agent          `json:"author,omitempty"`
+	Extra         Extra          `json:"-"`
 }
 
 // NewFAQPage initializes an FAQPage with default context and type.
@@ -101,21 +122,27 @@ func NewAnswer(text string) *Answer {
 }
 
 func (fp *FAQPage) Validate() []string {
-	var warnings []string
+	return issueMessages(fp.ValidationIssues())
+}
+
+// ValidationIssues returns the issues found by Validate on the FAQPage as structured values.
+func (fp *FAQPage) ValidationIssues() []teseo.ValidationIssue {
+	var found issues
 	if len(fp.MainEntity) == 0 {
-		warnings = append(warnings, "FAQPage should contain at least one question")
+		found.addf(teseo.SeverityRequired, teseo.RuleMissingField, "mainEntity", "FAQPage should contain at least one question")
 	}
 	for i, q := range fp.MainEntity {
 		if q.Name == "" {
-			warnings = append(warnings, fmt.Sprintf("Question %d is missing a name", i+1))
+			found.addf(teseo.SeverityRequired, teseo.RuleMissingField, fmt.Sprintf("mainEntity[%d].name", i), "Question %d is missing a name", i+1)
 		}
 		if q.AcceptedAnswer == nil {
-			warnings = append(warnings, fmt.Sprintf("Question %d is missing an accepted answer", i+1))
+			found.addf(teseo.SeverityRequired, teseo.RuleMissingField, fmt.Sprintf("mainEntity[%d].acceptedAnswer", i), "Question %d is missing an accepted answer", i+1)
 		} else if q.AcceptedAnswer.Text == "" {
-			warnings = append(warnings, fmt.Sprintf("Answer for question %d is missing text", i+1))
+			found.addf(teseo.SeverityRequired, teseo.RuleMissingField, fmt.Sprintf("mainEntity[%d].acceptedAnswer.text", i), "Answer for question %d is missing text", i+1)
 		}
 	}
-	return warnings
+	found.add(validateFormats(fp)...)
+	return found
 }
 
 // ToJsonLd converts the FAQPage struct to a JSON-LD `templ.Component`.
@@ -130,6 +157,26 @@ func (fp *FAQPage) ToGoHTMLJsonLd() (template.HTML, error) {
 	return teseo.RenderToHTML(fp.ToJsonLd())
 }
 
+// MarshalJSON encodes a FAQPage, merging the Extra properties into the JSON-LD object.
+func (fp FAQPage) MarshalJSON() ([]byte, error) {
+	type alias FAQPage
+	return marshalWithExtra(alias(fp), fp.Extra)
+}
+
+// UnmarshalJSON decodes a FAQPage, capturing properties without a dedicated field into Extra.
+func (fp *FAQPage) UnmarshalJSON(data []byte) error {
+	type alias FAQPage
+	if err := json.Unmarshal(data, (*alias)(fp)); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, (*alias)(fp))
+	if err != nil {
+		return err
+	}
+	fp.Extra = extra
+	return nil
+}
+
 // ensureDefaults sets default values for FAQPage, Question, and Answer if they are not already set.
 func (fp *FAQPage) ensureDefaults() {
 	if fp.Context == "" {
@@ -145,18 +192,92 @@ func (fp *FAQPage) ensureDefaults() {
 	}
 }
 
+// MarshalJSON encodes a Question, merging the Extra properties into the JSON-LD object.
+func (q Question) MarshalJSON() ([]byte, error) {
+	type alias Question
+	return marshalWithExtra(alias(q), q.Extra)
+}
+
+// UnmarshalJSON decodes a Question, resolving `author` to Person, Organization or @id reference nodes based on their `@type`
+// and capturing properties without a dedicated field into Extra.
+func (q *Question) UnmarshalJSON(data []byte) error {
+	type alias Question
+	aux := struct {
+		*alias
+		Author json.RawMessage `json:"author,omitempty"`
+	}{alias: (*alias)(q)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, aux)
+	if err != nil {
+		return err
+	}
+	q.Extra = extra
+
+	author, err := unmarshalAgent(aux.Author)
+	if err != nil {
+		return fmt.Errorf("Question: invalid author: %w", err)
+	}
+	q.Author = author
+
+	return nil
+}
+
 func (q *Question) ensureDefaults() {
 	if q.Type == "" {
 		q.Type = "Question"
 	}
 
+	ensureAgentDefaults(q.Author)
+
 	if q.AcceptedAnswer != nil {
 		q.AcceptedAnswer.ensureDefaults()
 	}
+
+	for _, a := range q.SuggestedAnswer {
+		if a != nil {
+			a.ensureDefaults()
+		}
+	}
+}
+
+// MarshalJSON encodes an Answer, merging the Extra properties into the JSON-LD object.
+func (a Answer) MarshalJSON() ([]byte, error) {
+	type alias Answer
+	return marshalWithExtra(alias(a), a.Extra)
+}
+
+// UnmarshalJSON decodes an Answer, resolving `author` to Person, Organization or @id reference nodes based on their `@type`
+// and capturing properties without a dedicated field into Extra.
+func (a *Answer) UnmarshalJSON(data []byte) error {
+	type alias Answer
+	aux := struct {
+		*alias
+		Author json.RawMessage `json:"author,omitempty"`
+	}{alias: (*alias)(a)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, aux)
+	if err != nil {
+		return err
+	}
+	a.Extra = extra
+
+	author, err := unmarshalAgent(aux.Author)
+	if err != nil {
+		return fmt.Errorf("Answer: invalid author: %w", err)
+	}
+	a.Author = author
+
+	return nil
 }
 
 func (a *Answer) ensureDefaults() {
 	if a.Type == "" {
 		a.Type = "Answer"
 	}
+
+	ensureAgentDefaults(a.Author)
 }
@@ -0,0 +1,428 @@
+package schemaorg
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/a-h/templ"
+	"github.com/indaco/teseo"
+)
+
+// ImageObject represents a Schema.org ImageObject object.
+// For more details about the meaning of the properties see: https://schema.org/ImageObject
+//
+// It is used for logos and images nested in other entities, or rendered on its
+// own to describe the license of an image for Google Images. In that case
+// ContentURL is required, along with at least one of License, AcquireLicensePage,
+// CreditText, Creator and CopyrightNotice.
+// For more details see: https://developers.google.com/search/docs/appearance/structured-data/image-license-metadata
+//
+// Example usage:
+//
+//	image := schemaorg.NewImageObject("https://www.example.com/photos/1x1/black-labrador-puppy.jpg", 1200, 1200)
+//	image.License = "https://www.example.com/license"
+//	image.AcquireLicensePage = "https://www.example.com/how-to-use-my-images"
+//	image.CreditText = "Labrador PhotoLab"
+//	image.Creator = &schemaorg.Person{Name: "Brixton Brownstone"}
+//	image.CopyrightNotice = "Clara Kent"
+//
+//	templ Page() {
+//		@image.ToJsonLd()
+//	}
+//
+// Expected output:
+//
+//	{
+//		"@context": "https://schema.org",
+//		"@type": "ImageObject",
+//		"contentUrl": "https://www.example.com/photos/1x1/black-labrador-puppy.jpg",
+//		"width": 1200,
+//		"height": 1200,
+//		"license": "https://www.example.com/license",
+//		"acquireLicensePage": "https://www.example.com/how-to-use-my-images",
+//		"creditText": "Labrador PhotoLab",
+//		"creator": {"@type": "Person", "name": "Brixton Brownstone"},
+//		"copyrightNotice": "Clara Kent"
+//	}
+type ImageObject struct {
+	Context            string         `json:"@context,omitempty"`
+	Type               string         `json:"@type"`
+	ID                 string         `json:"@id,omitempty"`
+	URL                string         `json:"url,omitempty"`
+	ContentURL         string         `json:"contentUrl,omitempty"`
+	Name               string         `json:"name,omitempty"`
+	Caption            string         `json:"caption,omitempty"`
+	Description        string         `json:"description,omitempty"`
+	EncodingFormat     string         `json:"encodingFormat,omitempty"`
+	Width              int            `json:"width,omitempty"`
+	Height             int            `json:"height,omitempty"`
+	UploadDate         teseo.DateTime `json:"uploadDate,omitempty"`
+	License            string         `json:"license,omitempty"`
+	AcquireLicensePage string         `json:"acquireLicensePage,omitempty"`
+	CreditText         string         `json:"creditText,omitempty"`
+	Creator            Agent          `json:"creator,omitempty"`
+	CopyrightNotice    string         `json:"copyrightNotice,omitempty"`
+	Extra              Extra          `json:"-"`
+}
+
+// NewImageObject initializes an ImageObject with default type.
+func NewImageObject(contentURL string, width, height int) *ImageObject {
+	img := &ImageObject{
+		ContentURL: contentURL,
+		Width:      width,
+		Height:     height,
+	}
+	img.ensureDefaults()
+	return img
+}
+
+// Validate checks if the ImageObject has the fields required by Google Images
+// to display the license details of the image.
+func (img *ImageObject) Validate() []string {
+	return issueMessages(img.ValidationIssues())
+}
+
+// ValidationIssues returns the issues found by Validate on the ImageObject as structured values.
+func (img *ImageObject) ValidationIssues() []teseo.ValidationIssue {
+	var found issues
+
+	if img.ContentURL == "" {
+		found.required("contentUrl")
+	}
+	if img.License == "" && img.AcquireLicensePage == "" && img.CreditText == "" && isNilAgent(img.Creator) && img.CopyrightNotice == "" {
+		found.missing(teseo.SeverityRequired, "license", "one of license, acquireLicensePage, creditText, creator or copyrightNotice")
+	}
+
+	found.add(img.validate("")...)
+	found.add(validateFormats(img)...)
+	return found
+}
+
+// validate checks the ImageObject fields, prefixing the issue paths with the given path.
+// When nested, the ImageObject only needs a url or a contentUrl.
+func (img *ImageObject) validate(prefix string) []teseo.ValidationIssue {
+	var found issues
+
+	if prefix != "" && img.URL == "" && img.ContentURL == "" {
+		found.missing(teseo.SeverityRequired, fieldPath(prefix, "url"), fieldPath(prefix, "url")+" or "+fieldPath(prefix, "contentUrl"))
+	}
+	if img.Width < 0 {
+		found.warnf(teseo.RuleNegativeValue, fieldPath(prefix, "width"), "%s must not be negative, got %d", fieldPath(prefix, "width"), img.Width)
+	}
+	if img.Height < 0 {
+		found.warnf(teseo.RuleNegativeValue, fieldPath(prefix, "height"), "%s must not be negative, got %d", fieldPath(prefix, "height"), img.Height)
+	}
+	if img.License != "" && img.AcquireLicensePage == "" {
+		found.recommended(fieldPath(prefix, "acquireLicensePage"))
+	}
+	if img.AcquireLicensePage != "" && img.License == "" {
+		found.recommended(fieldPath(prefix, "license"))
+	}
+	found.add(validateDateTime(fieldPath(prefix, "uploadDate"), img.UploadDate)...)
+	found.add(validateAgent(fieldPath(prefix, "creator"), teseo.SeverityRecommended, img.Creator)...)
+
+	return found
+}
+
+// ToJsonLd converts the ImageObject struct to a JSON-LD `templ.Component`.
+func (img *ImageObject) ToJsonLd() templ.Component {
+	img.ensureDefaults()
+	if img.Context == "" {
+		img.Context = "https://schema.org"
+	}
+	id := fmt.Sprintf("%s-%s", "imageObject", teseo.GenerateUniqueKey())
+	return templ.JSONScript(id, img).WithType("application/ld+json")
+}
+
+// ToGoHTMLJsonLd renders the ImageObject struct as `template.HTML` value for Go's `html/template`.
+func (img *ImageObject) ToGoHTMLJsonLd() (template.HTML, error) {
+	return teseo.RenderToHTML(img.ToJsonLd())
+}
+
+// MarshalJSON encodes an ImageObject, merging the Extra properties into the JSON-LD object.
+func (img ImageObject) MarshalJSON() ([]byte, error) {
+	type alias ImageObject
+	return marshalWithExtra(alias(img), img.Extra)
+}
+
+// UnmarshalJSON decodes an ImageObject from either its URL or a JSON-LD object,
+// resolving `creator` to Person, Organization or @id reference nodes based on their `@type`.
+func (img *ImageObject) UnmarshalJSON(data []byte) error {
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '"' {
+		var url string
+		if err := json.Unmarshal(trimmed, &url); err != nil {
+			return err
+		}
+		*img = ImageObject{Type: "ImageObject", URL: url}
+		return nil
+	}
+
+	type alias ImageObject
+	aux := struct {
+		*alias
+		Width   json.RawMessage `json:"width,omitempty"`
+		Height  json.RawMessage `json:"height,omitempty"`
+		Creator json.RawMessage `json:"creator,omitempty"`
+	}{alias: (*alias)(img)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, aux)
+	if err != nil {
+		return err
+	}
+	img.Extra = extra
+
+	if img.Width, err = unmarshalPixels(aux.Width); err != nil {
+		return fmt.Errorf("ImageObject: invalid width: %w", err)
+	}
+	if img.Height, err = unmarshalPixels(aux.Height); err != nil {
+		return fmt.Errorf("ImageObject: invalid height: %w", err)
+	}
+
+	creator, err := unmarshalAgent(aux.Creator)
+	if err != nil {
+		return fmt.Errorf("ImageObject: invalid creator: %w", err)
+	}
+	img.Creator = creator
+
+	return nil
+}
+
+// unmarshalPixels decodes an image dimension given as a number, as a string such
+// as "600" or "600px", or as a QuantitativeValue such as {"@type": "QuantitativeValue", "value": 600}.
+func unmarshalPixels(data json.RawMessage) (int, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || string(data) == "null" {
+		return 0, nil
+	}
+	if data[0] == '"' {
+		var text string
+		if err := json.Unmarshal(data, &text); err != nil {
+			return 0, err
+		}
+		text = strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(text), "px"))
+		if text == "" {
+			return 0, nil
+		}
+		value, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return 0, fmt.Errorf("not a number: %q", text)
+		}
+		return int(math.Round(value)), nil
+	}
+	var qv QuantitativeValue
+	if err := json.Unmarshal(data, &qv); err != nil {
+		return 0, err
+	}
+	if qv.Value == nil {
+		return 0, nil
+	}
+	return int(math.Round(*qv.Value)), nil
+}
+
+// ensureDefaults sets default values for ImageObject if they are not already set.
+// The `@context` is only set when the ImageObject is rendered on its own.
+func (img *ImageObject) ensureDefaults() {
+	if img.Type == "" {
+		img.Type = "ImageObject"
+	}
+
+	ensureAgentDefaults(img.Creator)
+}
+
+// Image is implemented by the values accepted where Schema.org expects an image:
+// ImageURL and *ImageObject.
+//
+// Example usage:
+//
+//	article.Image = schemaorg.Images{
+//		schemaorg.ImageURL("https://www.example.com/photos/1x1/photo.jpg"),
+//		&schemaorg.ImageObject{URL: "https://www.example.com/photos/16x9/photo.jpg", Width: 1600, Height: 900},
+//	}
+type Image interface {
+	ensureDefaults()
+	isImage()
+}
+
+func (ImageURL) isImage()     {}
+func (*ImageObject) isImage() {}
+
+// ImageURL is the URL of an image, rendered as a plain JSON string.
+type ImageURL string
+
+// ensureDefaults is a no-op, an ImageURL has no default values.
+func (ImageURL) ensureDefaults() {}
+
+// Images holds the images of an entity, each being either an ImageURL or an *ImageObject.
+// It is always rendered as an array and decodes from a string, an object or an array of both.
+type Images []Image
+
+// NewImages builds Images from image URLs, skipping empty values.
+func NewImages(urls ...string) Images {
+	var images Images
+	for _, url := range urls {
+		if url != "" {
+			images = append(images, ImageURL(url))
+		}
+	}
+	return images
+}
+
+// URLs returns the URL of each image. For an ImageObject, the url is preferred
+// over the contentUrl.
+func (imgs Images) URLs() []string {
+	var urls []string
+	for _, image := range imgs {
+		switch v := image.(type) {
+		case ImageURL:
+			if v != "" {
+				urls = append(urls, string(v))
+			}
+		case *ImageObject:
+			if v == nil {
+				continue
+			}
+			if v.URL != "" {
+				urls = append(urls, v.URL)
+			} else if v.ContentURL != "" {
+				urls = append(urls, v.ContentURL)
+			}
+		}
+	}
+	return urls
+}
+
+// UnmarshalJSON decodes a single image URL or object, or an array of them.
+func (imgs *Images) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if bytes.Equal(trimmed, []byte("null")) {
+		*imgs = nil
+		return nil
+	}
+
+	var raws []json.RawMessage
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &raws); err != nil {
+			return err
+		}
+	} else {
+		raws = []json.RawMessage{trimmed}
+	}
+
+	images := make(Images, 0, len(raws))
+	for _, raw := range raws {
+		raw = bytes.TrimSpace(raw)
+		if len(raw) > 0 && raw[0] == '"' {
+			var url string
+			if err := json.Unmarshal(raw, &url); err != nil {
+				return err
+			}
+			images = append(images, ImageURL(url))
+			continue
+		}
+		img := &ImageObject{}
+		if err := json.Unmarshal(raw, img); err != nil {
+			return fmt.Errorf("invalid image: %w", err)
+		}
+		images = append(images, img)
+	}
+	*imgs = images
+	return nil
+}
+
+// ensureDefaults sets default values for each ImageObject in the list.
+func (imgs Images) ensureDefaults() {
+	for _, image := range imgs {
+		if !isNilValue(image) {
+			image.ensureDefaults()
+		}
+	}
+}
+
+// validate checks every ImageObject of the list. Issue paths are prefixed with
+// field, or with field[i] when the list holds several images.
+func (imgs Images) validate(field string) []teseo.ValidationIssue {
+	var found issues
+	for i, image := range imgs {
+		img, ok := image.(*ImageObject)
+		if !ok || img == nil {
+			continue
+		}
+		found.add(img.validate(imagePath(field, i, len(imgs)))...)
+	}
+	return found
+}
+
+// imagePath returns the path of the i-th image of a list of n images.
+func imagePath(field string, i, n int) string {
+	if n == 1 {
+		return field
+	}
+	return fmt.Sprintf("%s[%d]", field, i)
+}
+
+// minLogoSize is the minimum width and height, in pixels, of a logo used by search engines.
+// For more details see: https://developers.google.com/search/docs/appearance/structured-data/logo
+const minLogoSize = 112
+
+// validateLogoSize returns an issue for each dimension of the logo that is set and smaller than minLogoSize.
+func validateLogoSize(field string, logo *ImageObject) []teseo.ValidationIssue {
+	if logo == nil {
+		return nil
+	}
+	var found issues
+	if logo.Width > 0 && logo.Width < minLogoSize {
+		found.warnf(teseo.RuleImageSize, field+".width", "%s.width must be at least %d pixels, got %d", field, minLogoSize, logo.Width)
+	}
+	if logo.Height > 0 && logo.Height < minLogoSize {
+		found.warnf(teseo.RuleImageSize, field+".height", "%s.height must be at least %d pixels, got %d", field, minLogoSize, logo.Height)
+	}
+	return found
+}
+
+// minImagePixels is the minimum number of pixels (width multiplied by height)
+// of the images of articles and events.
+// For more details see: https://developers.google.com/search/docs/appearance/structured-data/article
+const minImagePixels = 50000
+
+// aspectRatios lists the aspect ratios recommended for the images of articles and events.
+var aspectRatios = []struct{ width, height int }{{16, 9}, {4, 3}, {1, 1}}
+
+// validateImageDimensions checks the size and aspect ratio of every ImageObject
+// of the list that carries both its width and its height.
+func validateImageDimensions(field string, images Images) []teseo.ValidationIssue {
+	var found issues
+	for i, image := range images {
+		img, ok := image.(*ImageObject)
+		if !ok || img == nil || img.Width <= 0 || img.Height <= 0 {
+			continue
+		}
+		path := imagePath(field, i, len(images))
+		if img.Width*img.Height < minImagePixels {
+			found.warnf(teseo.RuleImageSize, path, "%s must be at least %d pixels (width x height), got %dx%d", path, minImagePixels, img.Width, img.Height)
+		}
+		if !hasRecommendedAspectRatio(img.Width, img.Height) {
+			found.warnf(teseo.RuleImageAspectRatio, path, "%s aspect ratio %dx%d should be 16x9, 4x3 or 1x1", path, img.Width, img.Height)
+		}
+	}
+	return found
+}
+
+// hasRecommendedAspectRatio reports whether width and height match one of the
+// recommended aspect ratios, within 1%.
+func hasRecommendedAspectRatio(width, height int) bool {
+	ratio := float64(width) / float64(height)
+	for _, ar := range aspectRatios {
+		want := float64(ar.width) / float64(ar.height)
+		if math.Abs(ratio-want)/want <= 0.01 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,236 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"html/template"
+	"reflect"
+	"testing"
+)
+
+func TestNewImageObject_SetsFieldsAndDefaults(t *testing.T) {
+	img := NewImageObject("https://example.com/photo.jpg", 1200, 800)
+	if img.Type != "ImageObject" || img.ContentURL != "https://example.com/photo.jpg" || img.Width != 1200 || img.Height != 800 {
+		t.Errorf("unexpected image %#v", img)
+	}
+	if img.Context != "" {
+		t.Errorf("expected no context until rendered on its own, got %q", img.Context)
+	}
+}
+
+func TestImageObject_Validate_LicenseMetadata(t *testing.T) {
+	tests := []struct {
+		name     string
+		img      *ImageObject
+		expected []string
+	}{
+		{
+			name: "complete",
+			img: &ImageObject{
+				ContentURL:         "https://example.com/photo.jpg",
+				License:            "https://example.com/license",
+				AcquireLicensePage: "https://example.com/buy",
+				Creator:            &Person{Name: "Jane Doe"},
+			},
+		},
+		{
+			name: "credit text only",
+			img:  &ImageObject{ContentURL: "https://example.com/photo.jpg", CreditText: "Example Photo"},
+		},
+		{
+			name: "missing content url and license details",
+			img:  &ImageObject{URL: "https://example.com/photo.jpg"},
+			expected: []string{
+				"missing required field: contentUrl",
+				"missing required field: one of license, acquireLicensePage, creditText, creator or copyrightNotice",
+			},
+		},
+		{
+			name: "license without acquire license page",
+			img:  &ImageObject{ContentURL: "https://example.com/photo.jpg", License: "https://example.com/license", Creator: &Person{}},
+			expected: []string{
+				"missing recommended field: acquireLicensePage",
+				"missing recommended field: creator.name",
+			},
+		},
+		{
+			name: "invalid dimensions and upload date",
+			img:  &ImageObject{ContentURL: "https://example.com/photo.jpg", CopyrightNotice: "Example", Width: -1, UploadDate: "yesterday"},
+			expected: []string{
+				"width must not be negative, got -1",
+				`invalid ISO 8601 date-time for uploadDate: "yesterday"`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if w := tt.img.Validate(); !reflect.DeepEqual(w, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, w)
+			}
+		})
+	}
+}
+
+func TestImages_Validate_Nested(t *testing.T) {
+	images := Images{
+		ImageURL("https://example.com/a.jpg"),
+		&ImageObject{Caption: "No URL"},
+		&ImageObject{URL: "https://example.com/c.jpg", AcquireLicensePage: "https://example.com/buy"},
+	}
+	expected := []string{
+		"missing required field: image[1].url or image[1].contentUrl",
+		"missing recommended field: image[2].license",
+	}
+	if w := issueMessages(images.validate("image")); !reflect.DeepEqual(w, expected) {
+		t.Errorf("expected %v, got %v", expected, w)
+	}
+}
+
+func TestValidateImageDimensions(t *testing.T) {
+	images := Images{
+		ImageURL("https://example.com/a.jpg"),
+		&ImageObject{URL: "https://example.com/16x9.jpg", Width: 1600, Height: 900},
+		&ImageObject{URL: "https://example.com/4x3.jpg", Width: 1200, Height: 900},
+		&ImageObject{URL: "https://example.com/small.jpg", Width: 200, Height: 200},
+		&ImageObject{URL: "https://example.com/3x2.jpg", Width: 1200, Height: 800},
+		&ImageObject{URL: "https://example.com/unknown.jpg", Width: 1200},
+	}
+	expected := []string{
+		"image[3] must be at least 50000 pixels (width x height), got 200x200",
+		"image[4] aspect ratio 1200x800 should be 16x9, 4x3 or 1x1",
+	}
+	if w := issueMessages(validateImageDimensions("image", images)); !reflect.DeepEqual(w, expected) {
+		t.Errorf("expected %v, got %v", expected, w)
+	}
+}
+
+func TestArticle_Validate_ImageDimensions(t *testing.T) {
+	art := NewArticle("Headline", nil, &Person{Name: "Jane"}, nil, "2024-01-01", "", "")
+	art.Image = Images{&ImageObject{URL: "https://example.com/photo.jpg", Width: 1200, Height: 800}}
+	w := art.Validate()
+	want := "image aspect ratio 1200x800 should be 16x9, 4x3 or 1x1"
+	if len(w) != 1 || w[0] != want {
+		t.Errorf("expected [%s], got %v", want, w)
+	}
+}
+
+func TestImages_MarshalJSON(t *testing.T) {
+	images := Images{
+		ImageURL("https://example.com/a.jpg"),
+		&ImageObject{Type: "ImageObject", URL: "https://example.com/b.jpg", Width: 1200, Height: 900},
+	}
+	data, err := json.Marshal(images)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `["https://example.com/a.jpg",{"@type":"ImageObject","url":"https://example.com/b.jpg","width":1200,"height":900}]`
+	if string(data) != expected {
+		t.Errorf("expected %s, got %s", expected, data)
+	}
+}
+
+func TestImages_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		expected Images
+	}{
+		{"null", `null`, nil},
+		{"single url", `"https://example.com/a.jpg"`, Images{ImageURL("https://example.com/a.jpg")}},
+		{"single object", `{"@type":"ImageObject","url":"https://example.com/a.jpg","width":800}`, Images{&ImageObject{Type: "ImageObject", URL: "https://example.com/a.jpg", Width: 800}}},
+		{"mixed array", `["https://example.com/a.jpg",{"@type":"ImageObject","contentUrl":"https://example.com/b.jpg"}]`, Images{
+			ImageURL("https://example.com/a.jpg"),
+			&ImageObject{Type: "ImageObject", ContentURL: "https://example.com/b.jpg"},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var images Images
+			if err := json.Unmarshal([]byte(tt.data), &images); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(images, tt.expected) {
+				t.Errorf("expected %#v, got %#v", tt.expected, images)
+			}
+		})
+	}
+}
+
+func TestImages_URLs(t *testing.T) {
+	images := Images{
+		ImageURL("https://example.com/a.jpg"),
+		&ImageObject{ContentURL: "https://example.com/b.jpg"},
+		&ImageObject{URL: "https://example.com/c.jpg", ContentURL: "https://example.com/c-full.jpg"},
+		&ImageObject{},
+	}
+	expected := []string{"https://example.com/a.jpg", "https://example.com/b.jpg", "https://example.com/c.jpg"}
+	if got := images.URLs(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestNewImages_SkipsEmpty(t *testing.T) {
+	if images := NewImages("", "https://example.com/a.jpg"); !reflect.DeepEqual(images, Images{ImageURL("https://example.com/a.jpg")}) {
+		t.Errorf("unexpected images %#v", images)
+	}
+	if images := NewImages(); images != nil {
+		t.Errorf("expected nil images, got %#v", images)
+	}
+}
+
+func TestImageObject_UnmarshalJSON(t *testing.T) {
+	data := `{
+		"@type": "Organization",
+		"name": "Example Corp",
+		"logo": "https://example.com/logo.png"
+	}`
+	var org Organization
+	if err := json.Unmarshal([]byte(data), &org); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if org.Logo == nil || org.Logo.URL != "https://example.com/logo.png" || org.Logo.Type != "ImageObject" {
+		t.Errorf("expected logo decoded from its URL, got %#v", org.Logo)
+	}
+
+	var img ImageObject
+	if err := json.Unmarshal([]byte(`{"@type":"ImageObject","contentUrl":"https://example.com/a.jpg","creator":{"@type":"Organization","name":"Example"},"representativeOfPage":true}`), &img); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if creator, ok := img.Creator.(*Organization); !ok || creator.Name != "Example" {
+		t.Errorf("expected Organization creator, got %#v", img.Creator)
+	}
+	if !reflect.DeepEqual(img.Extra, Extra{"representativeOfPage": true}) {
+		t.Errorf("unexpected extra %v", img.Extra)
+	}
+
+	dimensions := []string{
+		`{"width": 600, "height": 400}`,
+		`{"width": "600", "height": "400px"}`,
+		`{"width": {"@type": "QuantitativeValue", "value": 600}, "height": {"@type": "QuantitativeValue", "value": 400, "unitCode": "E37"}}`,
+	}
+	for _, in := range dimensions {
+		var img ImageObject
+		if err := json.Unmarshal([]byte(in), &img); err != nil {
+			t.Fatalf("unexpected error for %s: %v", in, err)
+		}
+		if img.Width != 600 || img.Height != 400 {
+			t.Errorf("expected 600x400 for %s, got %dx%d", in, img.Width, img.Height)
+		}
+	}
+	if err := json.Unmarshal([]byte(`{"width": "wide"}`), &img); err == nil {
+		t.Errorf("expected an error for a non-numeric width")
+	}
+}
+
+func TestImageObject_ToGoHTMLJsonLd(t *testing.T) {
+	img := NewImageObject("https://example.com/photo.jpg", 1200, 1200)
+	html, err := img.ToGoHTMLJsonLd()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if html == template.HTML("") {
+		t.Errorf("expected non-empty HTML")
+	}
+	if img.Context != "https://schema.org" {
+		t.Errorf("expected context set when rendered on its own, got %q", img.Context)
+	}
+}
@@ -0,0 +1,235 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"reflect"
+
+	"github.com/a-h/templ"
+	"github.com/indaco/teseo"
+)
+
+// ItemList represents a Schema.org ItemList object.
+// For more details about the meaning of the properties see: https://schema.org/ItemList
+//
+// An ItemList can follow either the summary page pattern, where each ListItem
+// only carries the URL of a details page, or the all-in-one page pattern, where
+// each ListItem embeds the full entity (Course, Product, Movie, ...). Both are
+// used by Google to build host carousels on category pages.
+//
+// Example usage:
+//
+// Summary page pattern:
+//
+//	list := schemaorg.NewItemList("Go courses", schemaorg.ItemListOrderUnordered, []schemaorg.ListItem{
+//		schemaorg.NewSummaryListItem(1, "https://www.example.com/courses/go-101"),
+//		schemaorg.NewSummaryListItem(2, "https://www.example.com/courses/go-201"),
+//	})
+//
+// All-in-one page pattern:
+//
+//	list := schemaorg.NewItemList("Go courses", schemaorg.ItemListOrderUnordered, []schemaorg.ListItem{
+//		schemaorg.NewEntityListItem(1, &schemaorg.Course{Name: "Go 101", Description: "Basics"}),
+//		schemaorg.NewEntityListItem(2, &schemaorg.Course{Name: "Go 201", Description: "Concurrency"}),
+//	})
+//
+// // Rendering JSON-LD using templ:
+//
+//	templ Page() {
+//		@list.ToJsonLd()
+//	}
+//
+// // Rendering JSON-LD as `template.HTML` value:
+//
+//	jsonLdHtml := list.ToGoHTMLJsonLd()
+//
+// Expected output (summary page pattern):
+//
+//	{
+//		"@context": "https://schema.org",
+//		"@type": "ItemList",
+//		"name": "Go courses",
+//		"itemListOrder": "https://schema.org/ItemListUnordered",
+//		"numberOfItems": 2,
+//		"itemListElement": [
+//			{"@type": "ListItem", "position": 1, "url": "https://www.example.com/courses/go-101"},
+//			{"@type": "ListItem", "position": 2, "url": "https://www.example.com/courses/go-201"}
+//		]
+//	}
+type ItemList struct {
+	Context         string        `json:"@context"`
+	Type            string        `json:"@type"`
+	Name            string        `json:"name,omitempty"`
+	URL             string        `json:"url,omitempty"`
+	ItemListOrder   ItemListOrder `json:"itemListOrder,omitempty"`
+	NumberOfItems   int           `json:"numberOfItems,omitempty"`
+	ItemListElement []ListItem    `json:"itemListElement"`
+	Extra           Extra         `json:"-"`
+}
+
+// NewItemList initializes an ItemList with default context and type.
+func NewItemList(name string, order ItemListOrder, items []ListItem) *ItemList {
+	list := &ItemList{
+		Name:            name,
+		ItemListOrder:   order,
+		ItemListElement: items,
+	}
+	list.ensureDefaults()
+	return list
+}
+
+// NewSummaryListItem creates a ListItem that only links to the page describing the item.
+func NewSummaryListItem(position int, url string) ListItem {
+	return ListItem{
+		Type:     "ListItem",
+		Position: position,
+		URL:      url,
+	}
+}
+
+// NewEntityListItem creates a ListItem embedding a full entity such as *Course or *Product.
+func NewEntityListItem(position int, entity any) ListItem {
+	item := ListItem{
+		Type:     "ListItem",
+		Position: position,
+		Entity:   entity,
+	}
+	item.ensureDefaults()
+	return item
+}
+
+// Validate checks if the ItemList satisfies the requirements for list carousels.
+// Elements must all follow the same pattern (summary or embedded entity) and
+// embedded entities must all share the same type.
+func (il *ItemList) Validate() []string {
+	return issueMessages(il.ValidationIssues())
+}
+
+// ValidationIssues returns the issues found by Validate on the ItemList as structured values.
+func (il *ItemList) ValidationIssues() []teseo.ValidationIssue {
+	var found issues
+
+	if len(il.ItemListElement) == 0 {
+		found.addf(teseo.SeverityRequired, teseo.RuleMissingField, "itemListElement", "ItemList should contain at least one item")
+		return found
+	}
+
+	var summary, embedded int
+	entityType := ""
+	for i, item := range il.ItemListElement {
+		if item.Position != i+1 {
+			severity := teseo.SeverityRecommended
+			if item.Position == 0 {
+				severity = teseo.SeverityRequired
+			}
+			found.addf(severity, teseo.RuleConstraint, fmt.Sprintf("itemListElement[%d].position", i), "ListItem %d should have position %d, got %d", i+1, i+1, item.Position)
+		}
+
+		switch {
+		case item.Entity != nil:
+			embedded++
+			t := entityTypeOf(item.Entity)
+			if entityType == "" {
+				entityType = t
+			} else if t != entityType {
+				found.warnf(teseo.RuleConstraint, fmt.Sprintf("itemListElement[%d].item", i), "ListItem %d embeds a %s, expected %s", i+1, t, entityType)
+			}
+		case item.Item != "" || item.URL != "":
+			summary++
+		default:
+			found.warnf(teseo.RuleMissingField, fmt.Sprintf("itemListElement[%d].url", i), "ListItem %d is missing a url or item", i+1)
+		}
+	}
+
+	if summary > 0 && embedded > 0 {
+		found.warnf(teseo.RuleConstraint, "itemListElement", "ItemList should not mix summary items (url) with embedded entities (item)")
+	}
+	found.add(validateEnum("itemListOrder", string(il.ItemListOrder), il.ItemListOrder.IsValid())...)
+	if il.NumberOfItems != 0 && il.NumberOfItems != len(il.ItemListElement) {
+		found.warnf(teseo.RuleConstraint, "numberOfItems", "numberOfItems is %d but itemListElement has %d items", il.NumberOfItems, len(il.ItemListElement))
+	}
+
+	found.add(validateFormats(il)...)
+	return found
+}
+
+// ToJsonLd converts the ItemList struct to a JSON-LD `templ.Component`.
+func (il *ItemList) ToJsonLd() templ.Component {
+	il.ensureDefaults()
+	id := fmt.Sprintf("%s-%s", "itemList", teseo.GenerateUniqueKey())
+	return templ.JSONScript(id, il).WithType("application/ld+json")
+}
+
+// ToGoHTMLJsonLd renders the ItemList struct as `template.HTML` value for Go's `html/template`.
+func (il *ItemList) ToGoHTMLJsonLd() (template.HTML, error) {
+	return teseo.RenderToHTML(il.ToJsonLd())
+}
+
+// MarshalJSON encodes an ItemList, merging the Extra properties into the JSON-LD object.
+func (il ItemList) MarshalJSON() ([]byte, error) {
+	type alias ItemList
+	return marshalWithExtra(alias(il), il.Extra)
+}
+
+// UnmarshalJSON decodes an ItemList, capturing properties without a dedicated field into Extra.
+func (il *ItemList) UnmarshalJSON(data []byte) error {
+	type alias ItemList
+	if err := json.Unmarshal(data, (*alias)(il)); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, (*alias)(il))
+	if err != nil {
+		return err
+	}
+	il.Extra = extra
+	return nil
+}
+
+// ensureDefaults sets default values for ItemList and its elements if they are not already set.
+// Missing positions are assigned incrementally starting at 1.
+func (il *ItemList) ensureDefaults() {
+	if il.Context == "" {
+		il.Context = "https://schema.org"
+	}
+
+	if il.Type == "" {
+		il.Type = "ItemList"
+	}
+
+	if il.NumberOfItems == 0 {
+		il.NumberOfItems = len(il.ItemListElement)
+	}
+
+	for i := range il.ItemListElement {
+		if il.ItemListElement[i].Position == 0 {
+			il.ItemListElement[i].Position = i + 1
+		}
+		il.ItemListElement[i].ensureDefaults()
+	}
+}
+
+// entityTypeOf returns the value of the `Type` field of a schemaorg struct,
+// falling back to the Go type name when the field is not available.
+func entityTypeOf(v any) string {
+	if m, ok := v.(map[string]any); ok {
+		t, _ := m["@type"].(string)
+		return t
+	}
+	if rt, ok := v.(*RawThing); ok {
+		if len(rt.Types) > 0 {
+			return normalizeTypeName(rt.Types[0])
+		}
+		return ""
+	}
+	rv := reflect.Indirect(reflect.ValueOf(v))
+	if !rv.IsValid() {
+		return ""
+	}
+	if rv.Kind() == reflect.Struct {
+		if f := rv.FieldByName("Type"); f.IsValid() && f.Kind() == reflect.String && f.String() != "" {
+			return f.String()
+		}
+	}
+	return rv.Type().Name()
+}
@@ -0,0 +1,171 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestNewItemList_SetsDefaults(t *testing.T) {
+	list := NewItemList("Courses", ItemListOrderUnordered, []ListItem{
+		{URL: "https://example.com/a"},
+		{URL: "https://example.com/b"},
+	})
+
+	if list.Context != "https://schema.org" {
+		t.Errorf("expected context schema.org, got %s", list.Context)
+	}
+	if list.Type != "ItemList" {
+		t.Errorf("expected type ItemList, got %s", list.Type)
+	}
+	if list.NumberOfItems != 2 {
+		t.Errorf("expected numberOfItems 2, got %d", list.NumberOfItems)
+	}
+	for i, item := range list.ItemListElement {
+		if item.Type != "ListItem" {
+			t.Errorf("expected ListItem type, got %s", item.Type)
+		}
+		if item.Position != i+1 {
+			t.Errorf("expected position %d, got %d", i+1, item.Position)
+		}
+	}
+}
+
+func TestNewEntityListItem_EnsuresEntityDefaults(t *testing.T) {
+	course := &Course{Name: "Go"}
+	item := NewEntityListItem(1, course)
+
+	if item.Type != "ListItem" {
+		t.Errorf("expected ListItem type, got %s", item.Type)
+	}
+	if course.Type != "Course" || course.Context != "https://schema.org" {
+		t.Errorf("expected embedded course defaults, got %q %q", course.Type, course.Context)
+	}
+}
+
+func TestListItem_ItemURL(t *testing.T) {
+	tests := []struct {
+		name string
+		item ListItem
+		want string
+	}{
+		{"string item", ListItem{Item: "https://example.com/a"}, "https://example.com/a"},
+		{"url field", ListItem{URL: "https://example.com/b"}, "https://example.com/b"},
+		{"entity item", ListItem{Entity: &Course{}, URL: "https://example.com/c"}, "https://example.com/c"},
+		{"empty", ListItem{}, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.item.ItemURL(); got != tt.want {
+				t.Errorf("ItemURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestItemList_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		list     *ItemList
+		expected []string
+	}{
+		{
+			name:     "empty list",
+			list:     &ItemList{},
+			expected: []string{"ItemList should contain at least one item"},
+		},
+		{
+			name: "summary pattern",
+			list: &ItemList{ItemListElement: []ListItem{
+				NewSummaryListItem(1, "https://example.com/a"),
+				NewSummaryListItem(2, "https://example.com/b"),
+			}},
+			expected: nil,
+		},
+		{
+			name: "all-in-one pattern",
+			list: &ItemList{ItemListElement: []ListItem{
+				NewEntityListItem(1, &Course{Name: "A"}),
+				NewEntityListItem(2, &Course{Name: "B"}),
+			}},
+			expected: nil,
+		},
+		{
+			name: "mixed patterns and types",
+			list: &ItemList{NumberOfItems: 5, ItemListElement: []ListItem{
+				NewEntityListItem(1, &Course{Name: "A"}),
+				NewEntityListItem(2, &Product{Name: "B"}),
+				NewSummaryListItem(4, "https://example.com/c"),
+				{Position: 4},
+			}},
+			expected: []string{
+				"ListItem 2 embeds a Product, expected Course",
+				"ListItem 3 should have position 3, got 4",
+				"ListItem 4 is missing a url or item",
+				"ItemList should not mix summary items (url) with embedded entities (item)",
+				"numberOfItems is 5 but itemListElement has 4 items",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.list.Validate()
+			if len(got) != len(tt.expected) {
+				t.Fatalf("expected %d warnings, got %d: %v", len(tt.expected), len(got), got)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] {
+					t.Errorf("warning %d: expected %q, got %q", i, tt.expected[i], got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestItemList_MarshalJSON_EmbeddedEntity(t *testing.T) {
+	list := NewItemList("", "", []ListItem{
+		NewEntityListItem(1, &Course{Name: "Go 101", Description: "Basics"}),
+	})
+
+	data, err := json.Marshal(list)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(string(data), `"item":{"@context":"https://schema.org","@type":"Course","name":"Go 101"`) {
+		t.Errorf("expected embedded course, got %s", data)
+	}
+}
+
+func TestListItem_UnmarshalJSON(t *testing.T) {
+	var list ItemList
+	data := `{"@type":"ItemList","itemListElement":[
+		{"@type":"ListItem","position":1,"item":"https://example.com/a"},
+		{"@type":"ListItem","position":2,"item":{"@type":"Course","name":"Go 101"}}
+	]}`
+	if err := json.Unmarshal([]byte(data), &list); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if first := list.ItemListElement[0]; first.Item != "https://example.com/a" || first.Entity != nil {
+		t.Errorf("expected the item URL in Item, got %+v", first)
+	}
+	course, ok := list.ItemListElement[1].Entity.(*Course)
+	if !ok || course.Name != "Go 101" || list.ItemListElement[1].Item != "" {
+		t.Errorf("expected the embedded course in Entity, got %+v", list.ItemListElement[1])
+	}
+}
+
+func TestItemList_ToGoHTMLJsonLd(t *testing.T) {
+	list := NewItemList("Courses", ItemListOrderAscending, []ListItem{
+		NewSummaryListItem(1, "https://example.com/a"),
+	})
+	html, err := list.ToGoHTMLJsonLd()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if html == "" {
+		t.Errorf("expected non-empty HTML output")
+	}
+}
@@ -1,6 +1,7 @@
 package schemaorg
 
 import (
+	"encoding/json"
 	"fmt"
 	"html/template"
 
@@ -16,10 +17,15 @@ import (
 // Pure struct usage:
 //
 //	localBusiness := &schemaorg.LocalBusiness{
+//		Type:        schemaorg.TypeRestaurant,
 //		Name:        "Example Business",
 //		Address:     &schemaorg.PostalAddress{StreetAddress: "123 Main St", AddressLocality: "Anytown", AddressRegion: "CA", PostalCode: "12345"},
 //		Telephone:   "+1-800-555-1234",
 //		Description: "This is an example local business.",
+//		PriceRange:  "$$",
+//		OpeningHoursSpecification: []*schemaorg.OpeningHoursSpecification{
+//			schemaorg.NewOpeningHoursSpecification([]schemaorg.DayOfWeek{schemaorg.Monday, schemaorg.Tuesday}, "11:30", "22:00"),
+//		},
 //	}
 //
 // Factory method usage:
@@ -58,18 +64,25 @@ import (
 //		"description": "This is an example local business"
 //	}
 type LocalBusiness struct {
-	Context         string           `json:"@context"`
-	Type            string           `json:"@type"`
-	Name            string           `json:"name,omitempty"`
-	Description     string           `json:"description,omitempty"`
-	URL             string           `json:"url,omitempty"`
-	Logo            *ImageObject     `json:"logo,omitempty"`
-	Telephone       string           `json:"telephone,omitempty"`
-	Address         *PostalAddress   `json:"address,omitempty"`
-	OpeningHours    []string         `json:"openingHours,omitempty"`
-	Geo             *GeoCoordinates  `json:"geo,omitempty"`
-	AggregateRating *AggregateRating `json:"aggregateRating,omitempty"`
-	Review          []*Review        `json:"review,omitempty"`
+	Context                   string                       `json:"@context"`
+	Type                      LocalBusinessType            `json:"@type"`
+	Name                      string                       `json:"name,omitempty"`
+	Description               string                       `json:"description,omitempty"`
+	URL                       string                       `json:"url,omitempty"`
+	Logo                      *ImageObject                 `json:"logo,omitempty"`
+	Telephone                 string                       `json:"telephone,omitempty"`
+	Address                   *PostalAddress               `json:"address,omitempty"`
+	OpeningHours              StringList                   `json:"openingHours,omitempty"`
+	OpeningHoursSpecification []*OpeningHoursSpecification `json:"openingHoursSpecification,omitempty"`
+	PriceRange                string                       `json:"priceRange,omitempty"`
+	ServesCuisine             StringList                   `json:"servesCuisine,omitempty"`
+	Menu                      string                       `json:"menu,omitempty"`
+	AcceptsReservations       *bool                        `json:"acceptsReservations,omitempty"`
+	Department                []*LocalBusiness             `json:"department,omitempty"`
+	Geo                       *GeoCoordinates              `json:"geo,omitempty"`
+	AggregateRating           *AggregateRating             `json:"aggregateRating,omitempty"`
+	Review                    []*Review                    `json:"review,omitempty"`
+	Extra                     Extra                        `json:"-"`
 }
 
 // GeoCoordinates represents a Schema.org GeoCoordinates object
@@ -79,6 +92,29 @@ type GeoCoordinates struct {
 	Longitude float64 `json:"longitude,omitempty"`
 }
 
+// GeoShape represents a Schema.org GeoShape object
+// For more details about the meaning of the properties see: https://schema.org/GeoShape
+//
+// Coordinates are expressed as space separated "latitude longitude" pairs, e.g.
+// a Box is "south west north east": "39.3280 120.1633 40.445 123.7878".
+type GeoShape struct {
+	Type    string `json:"@type"`
+	Box     string `json:"box,omitempty"`
+	Circle  string `json:"circle,omitempty"`
+	Line    string `json:"line,omitempty"`
+	Polygon string `json:"polygon,omitempty"`
+}
+
+// GeoLocation is implemented by the types allowed as the `geo` property of a
+// Place: *GeoCoordinates and *GeoShape.
+type GeoLocation interface {
+	ensureDefaults()
+	isGeoLocation()
+}
+
+func (*GeoCoordinates) isGeoLocation() {}
+func (*GeoShape) isGeoLocation()       {}
+
 // NewLocalBusiness initializes a LocalBusiness with default context and type.
 func NewLocalBusiness(name string, description string, url string, telephone string, logo *ImageObject, address *PostalAddress, openingHours []string, geo *GeoCoordinates, aggregateRating *AggregateRating, reviews []*Review) *LocalBusiness {
 	localBusiness := &LocalBusiness{
@@ -97,24 +133,62 @@ func NewLocalBusiness(name string, description string, url string, telephone str
 	return localBusiness
 }
 
-// Validate returns a list of recommended validation warnings for schema.org LocalBusiness.
+// Validate returns a list of required and recommended validation warnings for schema.org LocalBusiness.
 func (lb *LocalBusiness) Validate() []string {
-	var warnings []string
+	return issueMessages(lb.ValidationIssues())
+}
+
+// ValidationIssues returns the issues found by Validate on the LocalBusiness as structured values.
+func (lb *LocalBusiness) ValidationIssues() []teseo.ValidationIssue {
+	var found issues
 
 	if lb.Name == "" {
-		warnings = append(warnings, "missing recommended field: name")
+		found.required("name")
 	}
 	if lb.Address == nil {
-		warnings = append(warnings, "missing recommended field: address")
+		found.required("address")
 	}
 	if lb.Telephone == "" {
-		warnings = append(warnings, "missing recommended field: telephone")
+		found.recommended("telephone")
 	}
 	if lb.Description == "" {
-		warnings = append(warnings, "missing recommended field: description")
+		found.recommended("description")
+	}
+	found.add(validateLogoSize("logo", lb.Logo)...)
+
+	businessType := lb.Type
+	if businessType == "" {
+		businessType = TypeLocalBusiness
+	}
+	if isNearMiss(businessType, localBusinessParents) {
+		found.warnf(teseo.RuleUnknownType, "@type", "unrecognized LocalBusiness subtype %q", lb.Type)
+	} else if businessType.IsValid() && !businessType.IsA(TypeFoodEstablishment) {
+		if !lb.ServesCuisine.IsZero() {
+			found.warnf(teseo.RuleConstraint, "servesCuisine", "servesCuisine only applies to FoodEstablishment types, got %q", businessType)
+		}
+		if lb.Menu != "" {
+			found.warnf(teseo.RuleConstraint, "menu", "menu only applies to FoodEstablishment types, got %q", businessType)
+		}
+		if lb.AcceptsReservations != nil {
+			found.warnf(teseo.RuleConstraint, "acceptsReservations", "acceptsReservations only applies to FoodEstablishment types, got %q", businessType)
+		}
+	}
+
+	for i, value := range lb.OpeningHours {
+		if _, err := ParseOpeningHours(value); err != nil {
+			found.warnf(teseo.RuleInvalidFormat, fmt.Sprintf("openingHours[%d]", i), "%s", err)
+		}
+	}
+	for i, spec := range lb.OpeningHoursSpecification {
+		found.add(spec.validate(fmt.Sprintf("openingHoursSpecification[%d]", i))...)
 	}
 
-	return warnings
+	for i, department := range lb.Department {
+		found.nested(fmt.Sprintf("department[%d]", i), department.ValidationIssues())
+	}
+
+	found.add(validateFormats(lb, "department")...)
+	return found
 }
 
 // ToJsonLd converts the LocalBusiness struct to a JSON-LD `templ.Component`.
@@ -129,6 +203,26 @@ func (lb *LocalBusiness) ToGoHTMLJsonLd() (template.HTML, error) {
 	return teseo.RenderToHTML(lb.ToJsonLd())
 }
 
+// MarshalJSON encodes a LocalBusiness, merging the Extra properties into the JSON-LD object.
+func (lb LocalBusiness) MarshalJSON() ([]byte, error) {
+	type alias LocalBusiness
+	return marshalWithExtra(alias(lb), lb.Extra)
+}
+
+// UnmarshalJSON decodes a LocalBusiness, capturing properties without a dedicated field into Extra.
+func (lb *LocalBusiness) UnmarshalJSON(data []byte) error {
+	type alias LocalBusiness
+	if err := json.Unmarshal(data, (*alias)(lb)); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, (*alias)(lb))
+	if err != nil {
+		return err
+	}
+	lb.Extra = extra
+	return nil
+}
+
 // ensureDefaults sets default values for LocalBusiness and its nested objects if they are not already set.
 func (lb *LocalBusiness) ensureDefaults() {
 	if lb.Context == "" {
@@ -151,6 +245,14 @@ func (lb *LocalBusiness) ensureDefaults() {
 		lb.Geo.ensureDefaults()
 	}
 
+	for _, spec := range lb.OpeningHoursSpecification {
+		spec.ensureDefaults()
+	}
+
+	for _, department := range lb.Department {
+		department.ensureDefaults()
+	}
+
 	if lb.AggregateRating != nil {
 		lb.AggregateRating.ensureDefaults()
 	}
@@ -166,3 +268,10 @@ func (geo *GeoCoordinates) ensureDefaults() {
 		geo.Type = "GeoCoordinates"
 	}
 }
+
+// ensureDefaults sets default values for GeoShape if they are not already set.
+func (gs *GeoShape) ensureDefaults() {
+	if gs.Type == "" {
+		gs.Type = "GeoShape"
+	}
+}
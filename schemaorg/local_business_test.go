@@ -1,7 +1,10 @@
 package schemaorg
 
 import (
+	"encoding/json"
+	"reflect"
 	"slices"
+	"strings"
 	"testing"
 )
 
@@ -65,7 +68,7 @@ func TestLocalBusiness_Validate(t *testing.T) {
 			lb: &LocalBusiness{
 				Name:        "Shop",
 				Address:     &PostalAddress{},
-				Telephone:   "+1-800",
+				Telephone:   "+1-800-555-0100",
 				Description: "desc",
 			},
 			expected: nil,
@@ -73,12 +76,12 @@ func TestLocalBusiness_Validate(t *testing.T) {
 		{
 			name:     "all missing",
 			lb:       &LocalBusiness{},
-			expected: []string{"missing recommended field: name", "missing recommended field: address", "missing recommended field: telephone", "missing recommended field: description"},
+			expected: []string{"missing required field: name", "missing required field: address", "missing recommended field: telephone", "missing recommended field: description"},
 		},
 		{
 			name:     "missing address",
-			lb:       &LocalBusiness{Name: "x", Telephone: "y", Description: "z"},
-			expected: []string{"missing recommended field: address"},
+			lb:       &LocalBusiness{Name: "x", Telephone: "+1-800-555-0100", Description: "z"},
+			expected: []string{"missing required field: address"},
 		},
 	}
 
@@ -109,3 +112,117 @@ func TestLocalBusiness_ToGoHTMLJsonLd(t *testing.T) {
 		t.Errorf("expected non-empty html")
 	}
 }
+
+func TestLocalBusinessType_IsA(t *testing.T) {
+	tests := []struct {
+		t        LocalBusinessType
+		ancestor LocalBusinessType
+		want     bool
+	}{
+		{TypeRestaurant, TypeFoodEstablishment, true},
+		{TypeRestaurant, TypeLocalBusiness, true},
+		{TypeDentist, TypeMedicalBusiness, true},
+		{TypeDentist, TypeFoodEstablishment, false},
+		{TypeLocalBusiness, TypeLocalBusiness, true},
+		{"Resturant", TypeLocalBusiness, false},
+	}
+	for _, tt := range tests {
+		if got := tt.t.IsA(tt.ancestor); got != tt.want {
+			t.Errorf("%s.IsA(%s) = %v, want %v", tt.t, tt.ancestor, got, tt.want)
+		}
+	}
+	for child := range localBusinessParents {
+		if !child.IsA(TypeLocalBusiness) {
+			t.Errorf("expected %s to descend from LocalBusiness", child)
+		}
+	}
+}
+
+func TestLocalBusiness_Validate_Subtypes(t *testing.T) {
+	reservations := true
+	base := LocalBusiness{Name: "x", Address: &PostalAddress{}, Telephone: "+1-800-555-0100", Description: "z"}
+
+	restaurant := base
+	restaurant.Type = TypeRestaurant
+	restaurant.ServesCuisine = StringList{"Italian"}
+	restaurant.Menu = "https://www.example.com/menu"
+	restaurant.AcceptsReservations = &reservations
+	restaurant.PriceRange = "$$"
+	restaurant.OpeningHours = []string{"Mo-Sa 11:00-23:00"}
+	if w := restaurant.Validate(); len(w) != 0 {
+		t.Errorf("expected no warnings for restaurant, got %v", w)
+	}
+
+	store := restaurant
+	store.Type = TypeStore
+	store.OpeningHours = []string{"Mo-Sa 11-23"}
+	store.OpeningHoursSpecification = []*OpeningHoursSpecification{{DayOfWeek: []DayOfWeek{Sunday}, Opens: "10:00"}}
+	store.Department = []*LocalBusiness{{Type: TypePharmacy, Name: "Pharmacy", Address: &PostalAddress{}, Telephone: "+1-800-555-0100"}}
+	expected := []string{
+		`servesCuisine only applies to FoodEstablishment types, got "Store"`,
+		`menu only applies to FoodEstablishment types, got "Store"`,
+		`acceptsReservations only applies to FoodEstablishment types, got "Store"`,
+		`invalid opening hours "Mo-Sa 11-23": invalid time range "11-23"`,
+		"missing required field: openingHoursSpecification[0].closes",
+		"department[0]: missing recommended field: description",
+	}
+	if w := store.Validate(); !reflect.DeepEqual(w, expected) {
+		t.Errorf("expected %v, got %v", expected, w)
+	}
+
+	unknown := base
+	unknown.Type = "Resturant"
+	expected = []string{`unrecognized LocalBusiness subtype "Resturant"`}
+	if w := unknown.Validate(); !reflect.DeepEqual(w, expected) {
+		t.Errorf("expected %v, got %v", expected, w)
+	}
+
+	florist := base
+	florist.Type = "Florist"
+	if w := florist.Validate(); len(w) != 0 {
+		t.Errorf("expected no warnings for a schema.org type outside the catalogue, got %v", w)
+	}
+}
+
+func TestLocalBusiness_EnsureDefaults_OpeningHoursAndDepartments(t *testing.T) {
+	lb := &LocalBusiness{
+		OpeningHoursSpecification: []*OpeningHoursSpecification{{}},
+		Department:                []*LocalBusiness{{Type: TypePharmacy}, {}},
+	}
+	lb.ensureDefaults()
+
+	if lb.OpeningHoursSpecification[0].Type != "OpeningHoursSpecification" {
+		t.Errorf("expected OpeningHoursSpecification type, got %s", lb.OpeningHoursSpecification[0].Type)
+	}
+	if lb.Department[0].Type != TypePharmacy || lb.Department[1].Type != TypeLocalBusiness {
+		t.Errorf("unexpected department types: %s, %s", lb.Department[0].Type, lb.Department[1].Type)
+	}
+}
+
+func TestLocalBusiness_UnmarshalJSON_OpeningHours(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected StringList
+	}{
+		{"single string", `{"@type":"Restaurant","name":"Trattoria","openingHours":"Mo-Fr 09:00-17:00"}`, StringList{"Mo-Fr 09:00-17:00"}},
+		{"list", `{"@type":"Restaurant","name":"Trattoria","openingHours":["Mo-Fr 09:00-17:00","Sa 10:00-14:00"]}`, StringList{"Mo-Fr 09:00-17:00", "Sa 10:00-14:00"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var lb LocalBusiness
+			if err := json.Unmarshal([]byte(tt.input), &lb); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !slices.Equal(lb.OpeningHours, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, lb.OpeningHours)
+			}
+			for _, issue := range lb.ValidationIssues() {
+				if strings.HasPrefix(issue.Path, "openingHours") {
+					t.Errorf("expected valid opening hours, got %q", issue.Message)
+				}
+			}
+		})
+	}
+}
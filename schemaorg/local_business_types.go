@@ -0,0 +1,198 @@
+package schemaorg
+
+// LocalBusinessType is the `@type` of a LocalBusiness: LocalBusiness itself or
+// one of its more specific subtypes. Using the most specific type helps search
+// engines, e.g. a Dentist rather than a LocalBusiness.
+// For the full hierarchy see: https://schema.org/LocalBusiness
+//
+// Subtypes that are not part of the catalogue can still be used as plain strings,
+// but Validate reports them so that typos do not go unnoticed.
+type LocalBusinessType string
+
+const (
+	TypeLocalBusiness LocalBusinessType = "LocalBusiness"
+
+	// Automotive
+	TypeAutomotiveBusiness LocalBusinessType = "AutomotiveBusiness"
+	TypeAutoDealer         LocalBusinessType = "AutoDealer"
+	TypeAutoRental         LocalBusinessType = "AutoRental"
+	TypeAutoRepair         LocalBusinessType = "AutoRepair"
+	TypeGasStation         LocalBusinessType = "GasStation"
+
+	// Entertainment and sports
+	TypeEntertainmentBusiness  LocalBusinessType = "EntertainmentBusiness"
+	TypeMovieTheater           LocalBusinessType = "MovieTheater"
+	TypeNightClub              LocalBusinessType = "NightClub"
+	TypeSportsActivityLocation LocalBusinessType = "SportsActivityLocation"
+	TypeExerciseGym            LocalBusinessType = "ExerciseGym"
+
+	// Financial
+	TypeFinancialService  LocalBusinessType = "FinancialService"
+	TypeAccountingService LocalBusinessType = "AccountingService"
+	TypeBankOrCreditUnion LocalBusinessType = "BankOrCreditUnion"
+	TypeInsuranceAgency   LocalBusinessType = "InsuranceAgency"
+
+	// Food
+	TypeFoodEstablishment  LocalBusinessType = "FoodEstablishment"
+	TypeBakery             LocalBusinessType = "Bakery"
+	TypeBarOrPub           LocalBusinessType = "BarOrPub"
+	TypeBrewery            LocalBusinessType = "Brewery"
+	TypeCafeOrCoffeeShop   LocalBusinessType = "CafeOrCoffeeShop"
+	TypeFastFoodRestaurant LocalBusinessType = "FastFoodRestaurant"
+	TypeIceCreamShop       LocalBusinessType = "IceCreamShop"
+	TypeRestaurant         LocalBusinessType = "Restaurant"
+	TypeWinery             LocalBusinessType = "Winery"
+
+	// Health and beauty
+	TypeHealthAndBeautyBusiness LocalBusinessType = "HealthAndBeautyBusiness"
+	TypeBeautySalon             LocalBusinessType = "BeautySalon"
+	TypeDaySpa                  LocalBusinessType = "DaySpa"
+	TypeHairSalon               LocalBusinessType = "HairSalon"
+	TypeNailSalon               LocalBusinessType = "NailSalon"
+
+	// Legal and professional
+	TypeProfessionalService LocalBusinessType = "ProfessionalService"
+	TypeLegalService        LocalBusinessType = "LegalService"
+	TypeAttorney            LocalBusinessType = "Attorney"
+	TypeNotary              LocalBusinessType = "Notary"
+	TypeRealEstateAgent     LocalBusinessType = "RealEstateAgent"
+	TypeTravelAgency        LocalBusinessType = "TravelAgency"
+	TypeEmploymentAgency    LocalBusinessType = "EmploymentAgency"
+
+	// Lodging
+	TypeLodgingBusiness LocalBusinessType = "LodgingBusiness"
+	TypeBedAndBreakfast LocalBusinessType = "BedAndBreakfast"
+	TypeCampground      LocalBusinessType = "Campground"
+	TypeHostel          LocalBusinessType = "Hostel"
+	TypeHotel           LocalBusinessType = "Hotel"
+	TypeMotel           LocalBusinessType = "Motel"
+	TypeVacationRental  LocalBusinessType = "VacationRental"
+
+	// Medical
+	TypeMedicalBusiness LocalBusinessType = "MedicalBusiness"
+	TypeDentist         LocalBusinessType = "Dentist"
+	TypeMedicalClinic   LocalBusinessType = "MedicalClinic"
+	TypeOptician        LocalBusinessType = "Optician"
+	TypePharmacy        LocalBusinessType = "Pharmacy"
+	TypePhysician       LocalBusinessType = "Physician"
+
+	// Other services
+	TypeChildCare            LocalBusinessType = "ChildCare"
+	TypeDryCleaningOrLaundry LocalBusinessType = "DryCleaningOrLaundry"
+	TypeLibrary              LocalBusinessType = "Library"
+
+	// Stores
+	TypeStore              LocalBusinessType = "Store"
+	TypeBookStore          LocalBusinessType = "BookStore"
+	TypeClothingStore      LocalBusinessType = "ClothingStore"
+	TypeConvenienceStore   LocalBusinessType = "ConvenienceStore"
+	TypeElectronicsStore   LocalBusinessType = "ElectronicsStore"
+	TypeFurnitureStore     LocalBusinessType = "FurnitureStore"
+	TypeGroceryStore       LocalBusinessType = "GroceryStore"
+	TypeHardwareStore      LocalBusinessType = "HardwareStore"
+	TypeJewelryStore       LocalBusinessType = "JewelryStore"
+	TypeShoeStore          LocalBusinessType = "ShoeStore"
+	TypeSportingGoodsStore LocalBusinessType = "SportingGoodsStore"
+	TypeToyStore           LocalBusinessType = "ToyStore"
+)
+
+// localBusinessParents maps every catalogued LocalBusiness type to its parent type.
+var localBusinessParents = map[LocalBusinessType]LocalBusinessType{
+	TypeLocalBusiness: "",
+
+	TypeAutomotiveBusiness: TypeLocalBusiness,
+	TypeAutoDealer:         TypeAutomotiveBusiness,
+	TypeAutoRental:         TypeAutomotiveBusiness,
+	TypeAutoRepair:         TypeAutomotiveBusiness,
+	TypeGasStation:         TypeAutomotiveBusiness,
+
+	TypeEntertainmentBusiness:  TypeLocalBusiness,
+	TypeMovieTheater:           TypeEntertainmentBusiness,
+	TypeNightClub:              TypeEntertainmentBusiness,
+	TypeSportsActivityLocation: TypeLocalBusiness,
+	TypeExerciseGym:            TypeSportsActivityLocation,
+
+	TypeFinancialService:  TypeLocalBusiness,
+	TypeAccountingService: TypeFinancialService,
+	TypeBankOrCreditUnion: TypeFinancialService,
+	TypeInsuranceAgency:   TypeFinancialService,
+
+	TypeFoodEstablishment:  TypeLocalBusiness,
+	TypeBakery:             TypeFoodEstablishment,
+	TypeBarOrPub:           TypeFoodEstablishment,
+	TypeBrewery:            TypeFoodEstablishment,
+	TypeCafeOrCoffeeShop:   TypeFoodEstablishment,
+	TypeFastFoodRestaurant: TypeFoodEstablishment,
+	TypeIceCreamShop:       TypeFoodEstablishment,
+	TypeRestaurant:         TypeFoodEstablishment,
+	TypeWinery:             TypeFoodEstablishment,
+
+	TypeHealthAndBeautyBusiness: TypeLocalBusiness,
+	TypeBeautySalon:             TypeHealthAndBeautyBusiness,
+	TypeDaySpa:                  TypeHealthAndBeautyBusiness,
+	TypeHairSalon:               TypeHealthAndBeautyBusiness,
+	TypeNailSalon:               TypeHealthAndBeautyBusiness,
+
+	TypeProfessionalService: TypeLocalBusiness,
+	TypeLegalService:        TypeLocalBusiness,
+	TypeAttorney:            TypeLegalService,
+	TypeNotary:              TypeLegalService,
+	TypeRealEstateAgent:     TypeLocalBusiness,
+	TypeTravelAgency:        TypeLocalBusiness,
+	TypeEmploymentAgency:    TypeLocalBusiness,
+
+	TypeLodgingBusiness: TypeLocalBusiness,
+	TypeBedAndBreakfast: TypeLodgingBusiness,
+	TypeCampground:      TypeLodgingBusiness,
+	TypeHostel:          TypeLodgingBusiness,
+	TypeHotel:           TypeLodgingBusiness,
+	TypeMotel:           TypeLodgingBusiness,
+	TypeVacationRental:  TypeLodgingBusiness,
+
+	TypeMedicalBusiness: TypeLocalBusiness,
+	TypeDentist:         TypeMedicalBusiness,
+	TypeMedicalClinic:   TypeMedicalBusiness,
+	TypeOptician:        TypeMedicalBusiness,
+	TypePharmacy:        TypeMedicalBusiness,
+	TypePhysician:       TypeMedicalBusiness,
+
+	TypeChildCare:            TypeLocalBusiness,
+	TypeDryCleaningOrLaundry: TypeLocalBusiness,
+	TypeLibrary:              TypeLocalBusiness,
+
+	TypeStore:              TypeLocalBusiness,
+	TypeBookStore:          TypeStore,
+	TypeClothingStore:      TypeStore,
+	TypeConvenienceStore:   TypeStore,
+	TypeElectronicsStore:   TypeStore,
+	TypeFurnitureStore:     TypeStore,
+	TypeGroceryStore:       TypeStore,
+	TypeHardwareStore:      TypeStore,
+	TypeJewelryStore:       TypeStore,
+	TypeShoeStore:          TypeStore,
+	TypeSportingGoodsStore: TypeStore,
+	TypeToyStore:           TypeStore,
+}
+
+// IsValid reports whether the type is LocalBusiness or one of the catalogued subtypes.
+func (t LocalBusinessType) IsValid() bool {
+	_, ok := localBusinessParents[t]
+	return ok
+}
+
+// Parent returns the parent type of a catalogued type, or an empty value for
+// LocalBusiness and for types outside the catalogue.
+func (t LocalBusinessType) Parent() LocalBusinessType {
+	return localBusinessParents[t]
+}
+
+// IsA reports whether the type is ancestor or one of its catalogued subtypes,
+// e.g. TypeRestaurant.IsA(TypeFoodEstablishment) is true.
+func (t LocalBusinessType) IsA(ancestor LocalBusinessType) bool {
+	for current := t; current != ""; current = current.Parent() {
+		if current == ancestor {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,327 @@
+package schemaorg
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html"
+	"html/template"
+	"io"
+	"strings"
+
+	"github.com/a-h/templ"
+	"github.com/indaco/teseo"
+)
+
+// MarkupFormat selects the inline structured data syntax produced by MarkupRenderer.
+type MarkupFormat int
+
+const (
+	// Microdata renders itemscope, itemtype and itemprop attributes.
+	Microdata MarkupFormat = iota
+	// RDFa renders vocab, typeof and property attributes (RDFa Lite).
+	RDFa
+)
+
+// MarkupRenderer renders any schemaorg entity as microdata or RDFa, an
+// alternative to ToJsonLd for templates and email clients that do not support
+// JSON-LD. The entity is walked through its JSON-LD encoding, so the same
+// properties as in ToJsonLd are rendered, Extra included.
+//
+// The entity becomes an element (Tag, "div" by default) carrying its type. Its
+// properties are rendered as hidden `<meta>` elements, or `<link>` elements for
+// URLs and @id references, and nested entities as nested elements. When used as
+// a templ wrapper, the children are rendered inside the element, after the properties.
+//
+// Example usage:
+//
+//	renderer := schemaorg.MarkupRenderer{Format: schemaorg.Microdata}
+//
+//	templ ProductCard(product *schemaorg.Product) {
+//		@renderer.Render(product) {
+//			<h2>{ product.Name }</h2>
+//		}
+//	}
+//
+// Expected output:
+//
+//	<div itemscope itemtype="https://schema.org/Product">
+//		<meta itemprop="name" content="Executive Anvil">
+//		<link itemprop="image" href="https://www.example.com/anvil.jpg">
+//		<div itemprop="offers" itemscope itemtype="https://schema.org/Offer">
+//			<meta itemprop="priceCurrency" content="USD">
+//			<meta itemprop="price" content="119.99">
+//			<link itemprop="availability" href="https://schema.org/InStock">
+//		</div>
+//		<h2>Executive Anvil</h2>
+//	</div>
+type MarkupRenderer struct {
+	Format MarkupFormat
+	Tag    string // element wrapping each entity, "div" when empty
+}
+
+// ToMicrodata renders the entity as hidden microdata markup, wrapping the templ children if any.
+func ToMicrodata(v any) templ.Component {
+	return MarkupRenderer{Format: Microdata}.Render(v)
+}
+
+// ToRDFa renders the entity as hidden RDFa markup, wrapping the templ children if any.
+func ToRDFa(v any) templ.Component {
+	return MarkupRenderer{Format: RDFa}.Render(v)
+}
+
+// Render returns a `templ.Component` rendering the entity in the renderer format.
+func (r MarkupRenderer) Render(v any) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		children := templ.GetChildren(ctx)
+		ctx = templ.ClearChildren(ctx)
+
+		root, err := encodeNode(v)
+		if err != nil {
+			return fmt.Errorf("[MarkupRenderer] %w", err)
+		}
+
+		var sb strings.Builder
+		switch node := root.(type) {
+		case jsonObject:
+			r.writeOpen(&sb, "", node, true)
+			r.writeProperties(&sb, node)
+			if _, err := io.WriteString(w, sb.String()); err != nil {
+				return err
+			}
+			if err := children.Render(ctx, w); err != nil {
+				return err
+			}
+			_, err := io.WriteString(w, "</"+r.tag()+">")
+			return err
+		case []any:
+			for _, item := range node {
+				if obj, ok := item.(jsonObject); ok {
+					r.writeOpen(&sb, "", obj, true)
+					r.writeProperties(&sb, obj)
+					sb.WriteString("</" + r.tag() + ">")
+				}
+			}
+			if _, err := io.WriteString(w, sb.String()); err != nil {
+				return err
+			}
+			return children.Render(ctx, w)
+		default:
+			return fmt.Errorf("[MarkupRenderer] %T is not a Schema.org entity", v)
+		}
+	})
+}
+
+// RenderGoHTML renders the entity in the renderer format as `template.HTML` value for Go's `html/template`.
+func (r MarkupRenderer) RenderGoHTML(v any) (template.HTML, error) {
+	return teseo.RenderToHTML(r.Render(v))
+}
+
+// tag returns the element wrapping each entity.
+func (r MarkupRenderer) tag() string {
+	if r.Tag == "" {
+		return "div"
+	}
+	return r.Tag
+}
+
+// writeOpen writes the opening element of an entity. The root entity declares the
+// RDFa vocabulary, nested ones are attached to their parent through prop.
+func (r MarkupRenderer) writeOpen(sb *strings.Builder, prop string, obj jsonObject, root bool) {
+	types, id := obj.typesAndID()
+
+	sb.WriteString("<" + r.tag())
+	if r.Format == RDFa {
+		if root {
+			sb.WriteString(` vocab="https://schema.org/"`)
+		}
+		if prop != "" {
+			sb.WriteString(` property="` + html.EscapeString(prop) + `"`)
+		}
+		if len(types) > 0 {
+			sb.WriteString(` typeof="` + html.EscapeString(strings.Join(types, " ")) + `"`)
+		}
+		if id != "" {
+			sb.WriteString(` resource="` + html.EscapeString(id) + `"`)
+		}
+	} else {
+		if prop != "" {
+			sb.WriteString(` itemprop="` + html.EscapeString(prop) + `"`)
+		}
+		sb.WriteString(` itemscope`)
+		if len(types) > 0 {
+			urls := make([]string, len(types))
+			for i, t := range types {
+				urls[i] = schemaOrgBaseURL + t
+			}
+			sb.WriteString(` itemtype="` + html.EscapeString(strings.Join(urls, " ")) + `"`)
+		}
+		if id != "" {
+			sb.WriteString(` itemid="` + html.EscapeString(id) + `"`)
+		}
+	}
+	sb.WriteString(">")
+}
+
+// writeProperties writes the properties of an entity, skipping the JSON-LD keywords.
+func (r MarkupRenderer) writeProperties(sb *strings.Builder, obj jsonObject) {
+	for _, m := range obj {
+		if strings.HasPrefix(m.key, "@") {
+			continue
+		}
+		r.writeValue(sb, m.key, m.value)
+	}
+}
+
+// writeValue writes a single property value.
+func (r MarkupRenderer) writeValue(sb *strings.Builder, prop string, value any) {
+	attr := "itemprop"
+	if r.Format == RDFa {
+		attr = "property"
+	}
+
+	switch v := value.(type) {
+	case nil:
+	case []any:
+		for _, item := range v {
+			r.writeValue(sb, prop, item)
+		}
+	case jsonObject:
+		types, id := v.typesAndID()
+		if len(types) == 0 && len(v.properties()) == 0 {
+			if id != "" {
+				sb.WriteString(`<link ` + attr + `="` + html.EscapeString(prop) + `" href="` + html.EscapeString(id) + `">`)
+			}
+			return
+		}
+		r.writeOpen(sb, prop, v, false)
+		r.writeProperties(sb, v)
+		sb.WriteString("</" + r.tag() + ">")
+	case string:
+		if v == "" {
+			return
+		}
+		if isAbsoluteURL(v) {
+			sb.WriteString(`<link ` + attr + `="` + html.EscapeString(prop) + `" href="` + html.EscapeString(v) + `">`)
+			return
+		}
+		sb.WriteString(`<meta ` + attr + `="` + html.EscapeString(prop) + `" content="` + html.EscapeString(v) + `">`)
+	default:
+		sb.WriteString(`<meta ` + attr + `="` + html.EscapeString(prop) + `" content="` + html.EscapeString(fmt.Sprint(v)) + `">`)
+	}
+}
+
+// isAbsoluteURL reports whether s is an http or https URL, rendered as a link.
+func isAbsoluteURL(s string) bool {
+	return strings.HasPrefix(s, "https://") || strings.HasPrefix(s, "http://")
+}
+
+// jsonMember is a property of a JSON object, kept in document order.
+type jsonMember struct {
+	key   string
+	value any
+}
+
+// jsonObject is a JSON object whose members keep their document order, so
+// that the markup follows the order of the struct fields.
+type jsonObject []jsonMember
+
+// typesAndID returns the @type values and the @id of the object.
+func (o jsonObject) typesAndID() ([]string, string) {
+	var types []string
+	var id string
+	for _, m := range o {
+		switch m.key {
+		case "@type":
+			switch t := m.value.(type) {
+			case string:
+				types = append(types, t)
+			case []any:
+				for _, item := range t {
+					if s, ok := item.(string); ok {
+						types = append(types, s)
+					}
+				}
+			}
+		case "@id":
+			id, _ = m.value.(string)
+		}
+	}
+	return types, id
+}
+
+// get returns the value of the member named key, if any.
+func (o jsonObject) get(key string) (any, bool) {
+	for _, m := range o {
+		if m.key == key {
+			return m.value, true
+		}
+	}
+	return nil, false
+}
+
+// properties returns the members of the object that are not JSON-LD keywords.
+func (o jsonObject) properties() []jsonMember {
+	var props []jsonMember
+	for _, m := range o {
+		if !strings.HasPrefix(m.key, "@") {
+			props = append(props, m)
+		}
+	}
+	return props
+}
+
+// encodeNode encodes the entity as JSON-LD and decodes it into ordered nodes.
+func encodeNode(v any) (any, error) {
+	if d, ok := v.(defaulter); ok && !isNilValue(v) {
+		d.ensureDefaults()
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode %T: %w", v, err)
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	return decodeOrdered(dec)
+}
+
+// decodeOrdered decodes the next JSON value, keeping the order of object members.
+func decodeOrdered(dec *json.Decoder) (any, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch t := tok.(type) {
+	case json.Delim:
+		switch t {
+		case '{':
+			var obj jsonObject
+			for dec.More() {
+				keyTok, err := dec.Token()
+				if err != nil {
+					return nil, err
+				}
+				value, err := decodeOrdered(dec)
+				if err != nil {
+					return nil, err
+				}
+				obj = append(obj, jsonMember{key: keyTok.(string), value: value})
+			}
+			_, err := dec.Token()
+			return obj, err
+		case '[':
+			list := []any{}
+			for dec.More() {
+				value, err := decodeOrdered(dec)
+				if err != nil {
+					return nil, err
+				}
+				list = append(list, value)
+			}
+			_, err := dec.Token()
+			return list, err
+		}
+	}
+	return tok, nil
+}
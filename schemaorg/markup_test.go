@@ -0,0 +1,122 @@
+package schemaorg
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+func TestMarkupRenderer_Microdata(t *testing.T) {
+	product := &Product{
+		Name:  "Executive Anvil",
+		Image: NewImages("https://www.example.com/anvil.jpg"),
+		Brand: &Brand{Name: "ACME"},
+		Offers: Offers{{
+			Price:         "119.99",
+			PriceCurrency: "USD",
+			Availability:  InStock,
+		}},
+		Extra: Extra{"countryOfOrigin": "US"},
+	}
+
+	html, err := MarkupRenderer{Format: Microdata}.RenderGoHTML(product)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `<div itemscope itemtype="https://schema.org/Product">` +
+		`<meta itemprop="name" content="Executive Anvil">` +
+		`<link itemprop="image" href="https://www.example.com/anvil.jpg">` +
+		`<div itemprop="brand" itemscope itemtype="https://schema.org/Brand"><meta itemprop="name" content="ACME"></div>` +
+		`<div itemprop="offers" itemscope itemtype="https://schema.org/Offer">` +
+		`<meta itemprop="priceCurrency" content="USD">` +
+		`<meta itemprop="price" content="119.99">` +
+		`<link itemprop="availability" href="https://schema.org/InStock">` +
+		`</div>` +
+		`<meta itemprop="countryOfOrigin" content="US">` +
+		`</div>`
+	if string(html) != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, html)
+	}
+}
+
+func TestMarkupRenderer_RDFa(t *testing.T) {
+	person := &Person{
+		ID:       "https://www.example.com/#jane",
+		Name:     "Jane \"JD\" Doe",
+		WorksFor: &Organization{Type: TypeCorporation, Name: "Example Corp"},
+		SameAs:   []string{"https://social.example.com/jane", "https://code.example.com/jane"},
+	}
+
+	html, err := MarkupRenderer{Format: RDFa, Tag: "span"}.RenderGoHTML(person)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `<span vocab="https://schema.org/" typeof="Person" resource="https://www.example.com/#jane">` +
+		`<meta property="name" content="Jane &#34;JD&#34; Doe">` +
+		`<span property="worksFor" typeof="Corporation"><meta property="name" content="Example Corp"></span>` +
+		`<link property="sameAs" href="https://social.example.com/jane">` +
+		`<link property="sameAs" href="https://code.example.com/jane">` +
+		`</span>`
+	if string(html) != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, html)
+	}
+}
+
+func TestMarkupRenderer_References(t *testing.T) {
+	article := &Article{
+		Headline: "Title",
+		Author:   &NodeReference{ID: "https://www.example.com/#jane"},
+	}
+	html, err := MarkupRenderer{}.RenderGoHTML(article)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(string(html), `<link itemprop="author" href="https://www.example.com/#jane">`) {
+		t.Errorf("expected author rendered as a link, got %s", html)
+	}
+}
+
+func TestToMicrodata_WrapsChildren(t *testing.T) {
+	children := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		_, err := io.WriteString(w, "<h2>Executive Anvil</h2>")
+		return err
+	})
+	var sb strings.Builder
+	ctx := templ.WithChildren(context.Background(), children)
+	if err := ToMicrodata(&Brand{Name: "ACME"}).Render(ctx, &sb); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `<div itemscope itemtype="https://schema.org/Brand"><meta itemprop="name" content="ACME"><h2>Executive Anvil</h2></div>`
+	if sb.String() != expected {
+		t.Errorf("expected %s, got %s", expected, sb.String())
+	}
+}
+
+func TestToRDFa_Array(t *testing.T) {
+	trails := NewBreadcrumbTrails(
+		NewBreadcrumbList([]ListItem{{Name: "Books", Item: "https://example.com/books", Position: 1}}),
+		NewBreadcrumbList([]ListItem{{Name: "Literature", Item: "https://example.com/literature", Position: 1}}),
+	)
+	var sb strings.Builder
+	if err := ToRDFa(trails).Render(context.Background(), &sb); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `<div vocab="https://schema.org/" typeof="BreadcrumbList">` +
+		`<div property="itemListElement" typeof="ListItem"><meta property="position" content="1"><meta property="name" content="Books"><link property="item" href="https://example.com/books"></div>` +
+		`</div>` +
+		`<div vocab="https://schema.org/" typeof="BreadcrumbList">` +
+		`<div property="itemListElement" typeof="ListItem"><meta property="position" content="1"><meta property="name" content="Literature"><link property="item" href="https://example.com/literature"></div>` +
+		`</div>`
+	if sb.String() != expected {
+		t.Errorf("expected\n%s\ngot\n%s", expected, sb.String())
+	}
+}
+
+func TestMarkupRenderer_NotAnEntity(t *testing.T) {
+	if _, err := (MarkupRenderer{}).RenderGoHTML("text"); err == nil {
+		t.Errorf("expected error for a value that is not an entity")
+	}
+}
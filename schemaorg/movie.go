@@ -0,0 +1,188 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+
+	"github.com/a-h/templ"
+	"github.com/indaco/teseo"
+)
+
+// Movie represents a Schema.org Movie object.
+// For more details about the meaning of the properties see: https://schema.org/Movie
+//
+// Director and Actor accept a Person, an @id reference or several of them
+// combined with NewAgents.
+//
+// Example usage:
+//
+// Pure struct usage:
+//
+//	movie := &schemaorg.Movie{
+//		Name:          "Example Movie",
+//		URL:           "https://www.example.com/movies/example-movie",
+//		Image:         schemaorg.NewImages("https://www.example.com/images/movie.jpg"),
+//		DatePublished: "2024-09-15",
+//		Duration:      "PT2H",
+//		Director:      &schemaorg.Person{Name: "Jane Director"},
+//		Actor:         schemaorg.NewAgents(&schemaorg.Person{Name: "Jane Doe"}, &schemaorg.Person{Name: "John Doe"}),
+//	}
+//
+// Factory method usage:
+//
+//	movie := schemaorg.NewMovie(
+//		"Example Movie",
+//		"https://www.example.com/movies/example-movie",
+//		[]string{"https://www.example.com/images/movie.jpg"},
+//		&schemaorg.Person{Name: "Jane Director"},
+//	)
+//
+// // Rendering JSON-LD using templ:
+//
+//	templ Page() {
+//		@movie.ToJsonLd()
+//	}
+//
+// // Rendering JSON-LD as `template.HTML` value:
+//
+//	jsonLdHtml := movie.ToGoHTMLJsonLd()
+//
+// Expected output:
+//
+//	{
+//		"@context": "https://schema.org",
+//		"@type": "Movie",
+//		"name": "Example Movie",
+//		"url": "https://www.example.com/movies/example-movie",
+//		"image": ["https://www.example.com/images/movie.jpg"],
+//		"datePublished": "2024-09-15",
+//		"duration": "PT2H",
+//		"director": {"@type": "Person", "name": "Jane Director"},
+//		"actor": [{"@type": "Person", "name": "Jane Doe"}, {"@type": "Person", "name": "John Doe"}]
+//	}
+type Movie struct {
+	Context         string           `json:"@context"`
+	Type            string           `json:"@type"`
+	Name            string           `json:"name,omitempty"`
+	URL             string           `json:"url,omitempty"`
+	Description     string           `json:"description,omitempty"`
+	Image           Images           `json:"image,omitempty"`
+	DatePublished   teseo.DateTime   `json:"datePublished,omitempty"`
+	Duration        teseo.Duration   `json:"duration,omitempty"`
+	Genre           StringList       `json:"genre,omitempty"`
+	Director        Agent            `json:"director,omitempty"`
+	Actor           Agent            `json:"actor,omitempty"`
+	AggregateRating *AggregateRating `json:"aggregateRating,omitempty"`
+	Extra           Extra            `json:"-"`
+}
+
+// NewMovie initializes a Movie with default context and type.
+func NewMovie(name, url string, images []string, director Agent) *Movie {
+	movie := &Movie{
+		Name:     name,
+		URL:      url,
+		Image:    NewImages(images...),
+		Director: director,
+	}
+	movie.ensureDefaults()
+	return movie
+}
+
+// Validate returns warnings for missing required or recommended Movie fields.
+func (m *Movie) Validate() []string {
+	return issueMessages(m.ValidationIssues())
+}
+
+// ValidationIssues returns the issues found by Validate on the Movie as structured values.
+func (m *Movie) ValidationIssues() []teseo.ValidationIssue {
+	var found issues
+
+	if m.Name == "" {
+		found.required("name")
+	}
+	if len(m.Image) == 0 {
+		found.required("image")
+	}
+	if isNilAgent(m.Director) {
+		found.recommended("director")
+	} else {
+		found.add(validateAgent("director", teseo.SeverityRecommended, m.Director)...)
+	}
+	found.add(validateAgent("actor", teseo.SeverityRecommended, m.Actor)...)
+	found.add(validateDateTime("datePublished", m.DatePublished)...)
+	found.add(validateDuration("duration", m.Duration)...)
+	if m.AggregateRating != nil {
+		found.add(validateRatingRange("aggregateRating", m.AggregateRating.RatingValue, m.AggregateRating.BestRating, m.AggregateRating.WorstRating)...)
+	}
+	found.add(m.Image.validate("image")...)
+
+	found.add(validateFormats(m)...)
+	return found
+}
+
+// ToJsonLd converts the Movie struct to a JSON-LD `templ.Component`.
+func (m *Movie) ToJsonLd() templ.Component {
+	m.ensureDefaults()
+	id := fmt.Sprintf("%s-%s", "movie", teseo.GenerateUniqueKey())
+	return templ.JSONScript(id, m).WithType("application/ld+json")
+}
+
+// ToGoHTMLJsonLd renders the Movie struct as `template.HTML` value for Go's `html/template`.
+func (m *Movie) ToGoHTMLJsonLd() (template.HTML, error) {
+	return teseo.RenderToHTML(m.ToJsonLd())
+}
+
+// MarshalJSON encodes a Movie, merging the Extra properties into the JSON-LD object.
+func (m Movie) MarshalJSON() ([]byte, error) {
+	type alias Movie
+	return marshalWithExtra(alias(m), m.Extra)
+}
+
+// UnmarshalJSON decodes a Movie, resolving `director` and `actor` to Person, Organization or @id reference nodes based on their `@type`.
+func (m *Movie) UnmarshalJSON(data []byte) error {
+	type alias Movie
+	aux := struct {
+		*alias
+		Director json.RawMessage `json:"director,omitempty"`
+		Actor    json.RawMessage `json:"actor,omitempty"`
+	}{alias: (*alias)(m)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, aux)
+	if err != nil {
+		return err
+	}
+	m.Extra = extra
+
+	if m.Director, err = unmarshalAgent(aux.Director); err != nil {
+		return fmt.Errorf("Movie: invalid director: %w", err)
+	}
+	if m.Actor, err = unmarshalAgent(aux.Actor); err != nil {
+		return fmt.Errorf("Movie: invalid actor: %w", err)
+	}
+
+	return nil
+}
+
+// ensureDefaults sets default values for Movie and its nested objects if they are not already set.
+func (m *Movie) ensureDefaults() {
+	if m.Context == "" {
+		m.Context = "https://schema.org"
+	}
+
+	if m.Type == "" {
+		m.Type = "Movie"
+	}
+
+	ensureAgentDefaults(m.Director)
+
+	ensureAgentDefaults(m.Actor)
+
+	if m.AggregateRating != nil {
+		m.AggregateRating.ensureDefaults()
+	}
+
+	m.Image.ensureDefaults()
+}
@@ -0,0 +1,83 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestNewMovie_SetsDefaults(t *testing.T) {
+	rating := &AggregateRating{RatingValue: 4.5, RatingCount: 10}
+	movie := NewMovie("Example Movie", "https://www.example.com/movie", []string{"https://www.example.com/movie.jpg"}, &Person{Name: "Jane Director"})
+	movie.AggregateRating = rating
+	movie.ensureDefaults()
+
+	if movie.Context != "https://schema.org" || movie.Type != "Movie" {
+		t.Errorf("expected default context and type, got %s %s", movie.Context, movie.Type)
+	}
+	if movie.Director.(*Person).Type != "Person" {
+		t.Errorf("expected director type Person")
+	}
+	if rating.Type != "AggregateRating" {
+		t.Errorf("expected rating type AggregateRating, got %s", rating.Type)
+	}
+}
+
+func TestMovie_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		movie    *Movie
+		expected []string
+	}{
+		{
+			name:  "valid movie",
+			movie: &Movie{Name: "Example Movie", Image: NewImages("https://www.example.com/movie.jpg"), Director: &Person{Name: "Jane Director"}, Duration: "PT2H"},
+		},
+		{
+			name:  "missing fields",
+			movie: &Movie{},
+			expected: []string{
+				"missing required field: name",
+				"missing required field: image",
+				"missing recommended field: director",
+			},
+		},
+		{
+			name: "invalid values",
+			movie: &Movie{
+				Name:            "Example Movie",
+				Image:           NewImages("https://www.example.com/movie.jpg"),
+				Director:        &Person{Name: "Jane Director"},
+				Actor:           NewAgents(&Person{Name: "Jane Doe"}, &Person{}),
+				Duration:        "2 hours",
+				AggregateRating: &AggregateRating{RatingValue: 7},
+			},
+			expected: []string{
+				"missing recommended field: actor[1].name",
+				`invalid duration for duration: "2 hours"`,
+				"aggregateRating.ratingValue 7 is out of range [1, 5]",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.movie.Validate(); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestMovie_UnmarshalJSON(t *testing.T) {
+	data := `{"@type":"Movie","name":"Example Movie","director":{"@type":"Person","name":"Jane Director"},"actor":[{"@type":"Person","name":"Jane Doe"},{"@id":"#john"}]}`
+	var movie Movie
+	if err := json.Unmarshal([]byte(data), &movie); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if director, ok := movie.Director.(*Person); !ok || director.Name != "Jane Director" {
+		t.Errorf("expected director Jane Director, got %#v", movie.Director)
+	}
+	if actors, ok := movie.Actor.(Agents); !ok || len(actors) != 2 {
+		t.Errorf("expected two actors, got %#v", movie.Actor)
+	}
+}
@@ -0,0 +1,421 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+
+	"github.com/a-h/templ"
+	"github.com/indaco/teseo"
+)
+
+// MusicAlbum represents a Schema.org MusicAlbum object, with its tracks listed in Track.
+// For more details about the meaning of the properties see: https://schema.org/MusicAlbum
+//
+// MusicAlbum, MusicRecording and MusicPlaylist can be rendered on their own or
+// nested in each other; the `@context` is only set when they are rendered on their own.
+// ByArtist accepts a Person, an Organization (e.g. with Type "MusicGroup" for a
+// band), an @id reference or several of them combined with NewAgents.
+//
+// Example usage:
+//
+// Pure struct usage:
+//
+//	album := &schemaorg.MusicAlbum{
+//		Name:          "Example Album",
+//		URL:           "https://www.example.com/music/album/example-album",
+//		ByArtist:      &schemaorg.Organization{Type: "MusicGroup", Name: "Example Band"},
+//		DatePublished: "2024-09-15",
+//		Genre:         schemaorg.StringList{"Rock"},
+//		NumTracks:     2,
+//		Track: []*schemaorg.MusicRecording{
+//			{Name: "First Song", Duration: "PT3M45S"},
+//			{Name: "Second Song", Duration: "PT4M10S"},
+//		},
+//	}
+//
+// Factory method usage:
+//
+//	album := schemaorg.NewMusicAlbum(
+//		"Example Album",
+//		"https://www.example.com/music/album/example-album",
+//		&schemaorg.Organization{Type: "MusicGroup", Name: "Example Band"},
+//		tracks...,
+//	)
+//
+// // Rendering JSON-LD using templ:
+//
+//	templ Page() {
+//		@album.ToJsonLd()
+//	}
+//
+// // Rendering JSON-LD as `template.HTML` value:
+//
+//	jsonLdHtml := album.ToGoHTMLJsonLd()
+//
+// Expected output:
+//
+//	{
+//		"@context": "https://schema.org",
+//		"@type": "MusicAlbum",
+//		"name": "Example Album",
+//		"url": "https://www.example.com/music/album/example-album",
+//		"byArtist": {"@type": "MusicGroup", "name": "Example Band"},
+//		"datePublished": "2024-09-15",
+//		"genre": "Rock",
+//		"numTracks": 2,
+//		"track": [
+//			{"@type": "MusicRecording", "name": "First Song", "duration": "PT3M45S"},
+//			{"@type": "MusicRecording", "name": "Second Song", "duration": "PT4M10S"}
+//		]
+//	}
+type MusicAlbum struct {
+	Context       string            `json:"@context,omitempty"`
+	Type          string            `json:"@type"`
+	Name          string            `json:"name,omitempty"`
+	URL           string            `json:"url,omitempty"`
+	Description   string            `json:"description,omitempty"`
+	Image         Images            `json:"image,omitempty"`
+	ByArtist      Agent             `json:"byArtist,omitempty"`
+	DatePublished teseo.DateTime    `json:"datePublished,omitempty"`
+	Genre         StringList        `json:"genre,omitempty"`
+	NumTracks     int               `json:"numTracks,omitempty"`
+	Track         []*MusicRecording `json:"track,omitempty"`
+	Extra         Extra             `json:"-"`
+}
+
+// MusicRecording represents a Schema.org MusicRecording object, a single song or track.
+// For more details about the meaning of the properties see: https://schema.org/MusicRecording
+type MusicRecording struct {
+	Context       string         `json:"@context,omitempty"`
+	Type          string         `json:"@type"`
+	Name          string         `json:"name,omitempty"`
+	URL           string         `json:"url,omitempty"`
+	Description   string         `json:"description,omitempty"`
+	Image         Images         `json:"image,omitempty"`
+	ByArtist      Agent          `json:"byArtist,omitempty"`
+	Duration      teseo.Duration `json:"duration,omitempty"`
+	DatePublished teseo.DateTime `json:"datePublished,omitempty"`
+	Genre         StringList     `json:"genre,omitempty"`
+	ISRCCode      string         `json:"isrcCode,omitempty"`
+	InAlbum       *MusicAlbum    `json:"inAlbum,omitempty"`
+	Extra         Extra          `json:"-"`
+}
+
+// MusicPlaylist represents a Schema.org MusicPlaylist object.
+// For more details about the meaning of the properties see: https://schema.org/MusicPlaylist
+type MusicPlaylist struct {
+	Context     string            `json:"@context,omitempty"`
+	Type        string            `json:"@type"`
+	Name        string            `json:"name,omitempty"`
+	URL         string            `json:"url,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Image       Images            `json:"image,omitempty"`
+	NumTracks   int               `json:"numTracks,omitempty"`
+	Track       []*MusicRecording `json:"track,omitempty"`
+	Extra       Extra             `json:"-"`
+}
+
+// NewMusicAlbum initializes a MusicAlbum with default type.
+func NewMusicAlbum(name, url string, byArtist Agent, tracks ...*MusicRecording) *MusicAlbum {
+	album := &MusicAlbum{
+		Name:      name,
+		URL:       url,
+		ByArtist:  byArtist,
+		NumTracks: len(tracks),
+		Track:     tracks,
+	}
+	album.ensureDefaults()
+	return album
+}
+
+// NewMusicRecording initializes a MusicRecording with default type.
+func NewMusicRecording(name, url string, byArtist Agent, duration string) *MusicRecording {
+	recording := &MusicRecording{
+		Name:     name,
+		URL:      url,
+		ByArtist: byArtist,
+		Duration: teseo.Duration(duration),
+	}
+	recording.ensureDefaults()
+	return recording
+}
+
+// NewMusicPlaylist initializes a MusicPlaylist with default type.
+func NewMusicPlaylist(name, url string, tracks ...*MusicRecording) *MusicPlaylist {
+	playlist := &MusicPlaylist{
+		Name:      name,
+		URL:       url,
+		NumTracks: len(tracks),
+		Track:     tracks,
+	}
+	playlist.ensureDefaults()
+	return playlist
+}
+
+// Validate returns warnings for missing recommended MusicAlbum fields, including its tracks.
+func (ma *MusicAlbum) Validate() []string {
+	return issueMessages(ma.ValidationIssues())
+}
+
+// ValidationIssues returns the issues found by Validate on the MusicAlbum as structured values.
+func (ma *MusicAlbum) ValidationIssues() []teseo.ValidationIssue {
+	return append(ma.validate(""), validateFormats(ma)...)
+}
+
+// validate checks the MusicAlbum fields, prefixing the issue paths with the given path.
+func (ma *MusicAlbum) validate(prefix string) []teseo.ValidationIssue {
+	var found issues
+
+	if ma.Name == "" {
+		found.required(fieldPath(prefix, "name"))
+	}
+	if isNilAgent(ma.ByArtist) {
+		found.recommended(fieldPath(prefix, "byArtist"))
+	} else {
+		found.add(validateAgent(fieldPath(prefix, "byArtist"), teseo.SeverityRecommended, ma.ByArtist)...)
+	}
+	found.add(validateDateTime(fieldPath(prefix, "datePublished"), ma.DatePublished)...)
+	found.add(validateTracks(prefix, ma.NumTracks, ma.Track)...)
+	found.add(ma.Image.validate(fieldPath(prefix, "image"))...)
+
+	return found
+}
+
+// Validate returns warnings for missing recommended MusicRecording fields.
+func (mr *MusicRecording) Validate() []string {
+	return issueMessages(mr.ValidationIssues())
+}
+
+// ValidationIssues returns the issues found by Validate on the MusicRecording as structured values.
+func (mr *MusicRecording) ValidationIssues() []teseo.ValidationIssue {
+	return append(mr.validate(""), validateFormats(mr)...)
+}
+
+// validate checks the MusicRecording fields, prefixing the issue paths with the given path.
+func (mr *MusicRecording) validate(prefix string) []teseo.ValidationIssue {
+	var found issues
+
+	if mr.Name == "" {
+		found.required(fieldPath(prefix, "name"))
+	}
+	found.add(validateAgent(fieldPath(prefix, "byArtist"), teseo.SeverityRecommended, mr.ByArtist)...)
+	found.add(validateDuration(fieldPath(prefix, "duration"), mr.Duration)...)
+	found.add(validateDateTime(fieldPath(prefix, "datePublished"), mr.DatePublished)...)
+	if mr.InAlbum != nil && mr.InAlbum.Name == "" && mr.InAlbum.URL == "" {
+		found.recommended(fieldPath(prefix, "inAlbum.name"))
+	}
+	found.add(mr.Image.validate(fieldPath(prefix, "image"))...)
+
+	return found
+}
+
+// Validate returns warnings for missing recommended MusicPlaylist fields, including its tracks.
+func (mp *MusicPlaylist) Validate() []string {
+	return issueMessages(mp.ValidationIssues())
+}
+
+// ValidationIssues returns the issues found by Validate on the MusicPlaylist as structured values.
+func (mp *MusicPlaylist) ValidationIssues() []teseo.ValidationIssue {
+	var found issues
+
+	if mp.Name == "" {
+		found.required("name")
+	}
+	if len(mp.Track) == 0 {
+		found.recommended("track")
+	}
+	found.add(validateTracks("", mp.NumTracks, mp.Track)...)
+	found.add(mp.Image.validate("image")...)
+
+	found.add(validateFormats(mp)...)
+	return found
+}
+
+// validateTracks checks that numTracks is consistent with the listed tracks and validates each of them.
+func validateTracks(prefix string, numTracks int, tracks []*MusicRecording) []teseo.ValidationIssue {
+	var found issues
+
+	if numTracks < 0 {
+		found.warnf(teseo.RuleNegativeValue, fieldPath(prefix, "numTracks"), "%s must not be negative, got %d", fieldPath(prefix, "numTracks"), numTracks)
+	} else if numTracks > 0 && numTracks < len(tracks) {
+		found.warnf(teseo.RuleConstraint, fieldPath(prefix, "numTracks"), "%s %d is less than the %d tracks listed", fieldPath(prefix, "numTracks"), numTracks, len(tracks))
+	}
+	for i, track := range tracks {
+		if track != nil {
+			found.add(track.validate(fieldPath(prefix, fmt.Sprintf("track[%d]", i)))...)
+		}
+	}
+
+	return found
+}
+
+// ToJsonLd converts the MusicAlbum struct to a JSON-LD `templ.Component`.
+func (ma *MusicAlbum) ToJsonLd() templ.Component {
+	ma.ensureDefaults()
+	if ma.Context == "" {
+		ma.Context = "https://schema.org"
+	}
+	id := fmt.Sprintf("%s-%s", "musicalbum", teseo.GenerateUniqueKey())
+	return templ.JSONScript(id, ma).WithType("application/ld+json")
+}
+
+// ToGoHTMLJsonLd renders the MusicAlbum struct as `template.HTML` value for Go's `html/template`.
+func (ma *MusicAlbum) ToGoHTMLJsonLd() (template.HTML, error) {
+	return teseo.RenderToHTML(ma.ToJsonLd())
+}
+
+// ToJsonLd converts the MusicRecording struct to a JSON-LD `templ.Component`.
+func (mr *MusicRecording) ToJsonLd() templ.Component {
+	mr.ensureDefaults()
+	if mr.Context == "" {
+		mr.Context = "https://schema.org"
+	}
+	id := fmt.Sprintf("%s-%s", "musicrecording", teseo.GenerateUniqueKey())
+	return templ.JSONScript(id, mr).WithType("application/ld+json")
+}
+
+// ToGoHTMLJsonLd renders the MusicRecording struct as `template.HTML` value for Go's `html/template`.
+func (mr *MusicRecording) ToGoHTMLJsonLd() (template.HTML, error) {
+	return teseo.RenderToHTML(mr.ToJsonLd())
+}
+
+// ToJsonLd converts the MusicPlaylist struct to a JSON-LD `templ.Component`.
+func (mp *MusicPlaylist) ToJsonLd() templ.Component {
+	mp.ensureDefaults()
+	if mp.Context == "" {
+		mp.Context = "https://schema.org"
+	}
+	id := fmt.Sprintf("%s-%s", "musicplaylist", teseo.GenerateUniqueKey())
+	return templ.JSONScript(id, mp).WithType("application/ld+json")
+}
+
+// ToGoHTMLJsonLd renders the MusicPlaylist struct as `template.HTML` value for Go's `html/template`.
+func (mp *MusicPlaylist) ToGoHTMLJsonLd() (template.HTML, error) {
+	return teseo.RenderToHTML(mp.ToJsonLd())
+}
+
+// MarshalJSON encodes a MusicAlbum, merging the Extra properties into the JSON-LD object.
+func (ma MusicAlbum) MarshalJSON() ([]byte, error) {
+	type alias MusicAlbum
+	return marshalWithExtra(alias(ma), ma.Extra)
+}
+
+// UnmarshalJSON decodes a MusicAlbum, resolving `byArtist` to Person, Organization or @id reference nodes based on their `@type`.
+func (ma *MusicAlbum) UnmarshalJSON(data []byte) error {
+	type alias MusicAlbum
+	aux := struct {
+		*alias
+		ByArtist json.RawMessage `json:"byArtist,omitempty"`
+	}{alias: (*alias)(ma)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, aux)
+	if err != nil {
+		return err
+	}
+	ma.Extra = extra
+
+	if ma.ByArtist, err = unmarshalAgent(aux.ByArtist); err != nil {
+		return fmt.Errorf("MusicAlbum: invalid byArtist: %w", err)
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes a MusicRecording, merging the Extra properties into the JSON-LD object.
+func (mr MusicRecording) MarshalJSON() ([]byte, error) {
+	type alias MusicRecording
+	return marshalWithExtra(alias(mr), mr.Extra)
+}
+
+// UnmarshalJSON decodes a MusicRecording, resolving `byArtist` to Person, Organization or @id reference nodes based on their `@type`.
+func (mr *MusicRecording) UnmarshalJSON(data []byte) error {
+	type alias MusicRecording
+	aux := struct {
+		*alias
+		ByArtist json.RawMessage `json:"byArtist,omitempty"`
+	}{alias: (*alias)(mr)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, aux)
+	if err != nil {
+		return err
+	}
+	mr.Extra = extra
+
+	if mr.ByArtist, err = unmarshalAgent(aux.ByArtist); err != nil {
+		return fmt.Errorf("MusicRecording: invalid byArtist: %w", err)
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes a MusicPlaylist, merging the Extra properties into the JSON-LD object.
+func (mp MusicPlaylist) MarshalJSON() ([]byte, error) {
+	type alias MusicPlaylist
+	return marshalWithExtra(alias(mp), mp.Extra)
+}
+
+// UnmarshalJSON decodes a MusicPlaylist, capturing properties without a dedicated field into Extra.
+func (mp *MusicPlaylist) UnmarshalJSON(data []byte) error {
+	type alias MusicPlaylist
+	if err := json.Unmarshal(data, (*alias)(mp)); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, (*alias)(mp))
+	if err != nil {
+		return err
+	}
+	mp.Extra = extra
+	return nil
+}
+
+// ensureDefaults sets default values for MusicAlbum and its tracks if they are not already set.
+func (ma *MusicAlbum) ensureDefaults() {
+	if ma.Type == "" {
+		ma.Type = "MusicAlbum"
+	}
+
+	ensureAgentDefaults(ma.ByArtist)
+
+	for _, track := range ma.Track {
+		if track != nil {
+			track.ensureDefaults()
+		}
+	}
+
+	ma.Image.ensureDefaults()
+}
+
+// ensureDefaults sets default values for MusicRecording and its album if they are not already set.
+func (mr *MusicRecording) ensureDefaults() {
+	if mr.Type == "" {
+		mr.Type = "MusicRecording"
+	}
+
+	ensureAgentDefaults(mr.ByArtist)
+
+	if mr.InAlbum != nil {
+		mr.InAlbum.ensureDefaults()
+	}
+
+	mr.Image.ensureDefaults()
+}
+
+// ensureDefaults sets default values for MusicPlaylist and its tracks if they are not already set.
+func (mp *MusicPlaylist) ensureDefaults() {
+	if mp.Type == "" {
+		mp.Type = "MusicPlaylist"
+	}
+
+	for _, track := range mp.Track {
+		if track != nil {
+			track.ensureDefaults()
+		}
+	}
+
+	mp.Image.ensureDefaults()
+}
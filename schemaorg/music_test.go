@@ -0,0 +1,78 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestNewMusicAlbum_SetsDefaults(t *testing.T) {
+	track := &MusicRecording{Name: "First Song", InAlbum: &MusicAlbum{Name: "Example Album"}}
+	album := NewMusicAlbum("Example Album", "https://www.example.com/album", &Organization{Type: "MusicGroup", Name: "Example Band"}, track)
+
+	if album.Type != "MusicAlbum" || album.Context != "" {
+		t.Errorf("expected type MusicAlbum without context, got %q %q", album.Type, album.Context)
+	}
+	if album.NumTracks != 1 {
+		t.Errorf("expected numTracks 1, got %d", album.NumTracks)
+	}
+	if track.Type != "MusicRecording" || track.InAlbum.Type != "MusicAlbum" {
+		t.Errorf("expected nested defaults, got %q %q", track.Type, track.InAlbum.Type)
+	}
+	if album.ByArtist.(*Organization).Type != "MusicGroup" {
+		t.Errorf("expected artist type MusicGroup, got %s", album.ByArtist.(*Organization).Type)
+	}
+}
+
+func TestMusic_Validate(t *testing.T) {
+	album := &MusicAlbum{
+		Name:      "Example Album",
+		NumTracks: 1,
+		Track:     []*MusicRecording{{Name: "First Song"}, {Duration: "3:45"}},
+	}
+	expected := []string{
+		"missing recommended field: byArtist",
+		"numTracks 1 is less than the 2 tracks listed",
+		"missing required field: track[1].name",
+		`invalid duration for track[1].duration: "3:45"`,
+	}
+	if got := album.Validate(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	playlist := &MusicPlaylist{}
+	expected = []string{"missing required field: name", "missing recommended field: track"}
+	if got := playlist.Validate(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	recording := &MusicRecording{Name: "First Song", InAlbum: &MusicAlbum{}}
+	expected = []string{"missing recommended field: inAlbum.name"}
+	if got := recording.Validate(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestMusicRecording_UnmarshalJSON(t *testing.T) {
+	data := `{"@type":"MusicRecording","name":"First Song","byArtist":{"@type":"MusicGroup","name":"Example Band"},"duration":"PT3M45S","inAlbum":{"@type":"MusicAlbum","name":"Example Album"}}`
+	var recording MusicRecording
+	if err := json.Unmarshal([]byte(data), &recording); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if artist, ok := recording.ByArtist.(*Organization); !ok || artist.Name != "Example Band" {
+		t.Errorf("expected artist Example Band, got %#v", recording.ByArtist)
+	}
+	if recording.InAlbum == nil || recording.InAlbum.Name != "Example Album" {
+		t.Errorf("expected album Example Album, got %#v", recording.InAlbum)
+	}
+}
+
+func TestMusicPlaylist_ToGoHTMLJsonLd(t *testing.T) {
+	html, err := NewMusicPlaylist("Playlist", "https://www.example.com/playlist", &MusicRecording{Name: "Song"}).ToGoHTMLJsonLd()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if html == "" {
+		t.Errorf("expected non-empty HTML")
+	}
+}
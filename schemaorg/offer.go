@@ -0,0 +1,463 @@
+package schemaorg
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/indaco/teseo"
+)
+
+// Offer represents a Schema.org Offer object
+// For more details about the meaning of the properties see: https://schema.org/Offer
+type Offer struct {
+	Type                    string                  `json:"@type"`
+	URL                     string                  `json:"url,omitempty"`
+	PriceCurrency           string                  `json:"priceCurrency,omitempty"`
+	Price                   Number                  `json:"price,omitempty"`
+	PriceValidUntil         teseo.Date              `json:"priceValidUntil,omitempty"`
+	Availability            ItemAvailability        `json:"availability,omitempty"`
+	ItemCondition           OfferItemCondition      `json:"itemCondition,omitempty"`
+	Category                string                  `json:"category,omitempty"`
+	Seller                  *Organization           `json:"seller,omitempty"`
+	ShippingDetails         []*OfferShippingDetails `json:"shippingDetails,omitempty"`
+	HasMerchantReturnPolicy *MerchantReturnPolicy   `json:"hasMerchantReturnPolicy,omitempty"`
+	Extra                   Extra                   `json:"-"`
+}
+
+// AggregateOffer represents a Schema.org AggregateOffer object
+// For more details about the meaning of the properties see: https://schema.org/AggregateOffer
+type AggregateOffer struct {
+	Type          string   `json:"@type"`
+	LowPrice      Number   `json:"lowPrice,omitempty"`
+	HighPrice     Number   `json:"highPrice,omitempty"`
+	PriceCurrency string   `json:"priceCurrency,omitempty"`
+	OfferCount    int      `json:"offerCount,omitempty"`
+	Offers        []*Offer `json:"offers,omitempty"`
+}
+
+// Offers holds the offers of a Product. It is rendered as a JSON object when it
+// holds a single offer and as an array otherwise, and decodes either form.
+type Offers []*Offer
+
+// NewOffers groups the given offers, skipping nil values.
+func NewOffers(offers ...*Offer) Offers {
+	var list Offers
+	for _, o := range offers {
+		if o != nil {
+			list = append(list, o)
+		}
+	}
+	return list
+}
+
+// MarshalJSON renders a single offer as an object and multiple offers as an array.
+func (o Offers) MarshalJSON() ([]byte, error) {
+	if len(o) == 1 {
+		return json.Marshal(o[0])
+	}
+	return json.Marshal([]*Offer(o))
+}
+
+// UnmarshalJSON decodes a single offer object or an array of offers.
+func (o *Offers) UnmarshalJSON(data []byte) error {
+	offers, err := unmarshalObjects[Offer](data)
+	if err != nil {
+		return err
+	}
+	*o = offers
+	return nil
+}
+
+// paths returns the path of each offer under field: the field itself for a
+// single offer, as rendered, and an indexed path otherwise.
+func (o Offers) paths(field string) []string {
+	paths := make([]string, len(o))
+	for i := range o {
+		paths[i] = field
+		if len(o) > 1 {
+			paths[i] = fmt.Sprintf("%s[%d]", field, i)
+		}
+	}
+	return paths
+}
+
+// ensureDefaults sets default values for each offer in the list.
+func (o Offers) ensureDefaults() {
+	for _, offer := range o {
+		if offer != nil {
+			offer.ensureDefaults()
+		}
+	}
+}
+
+// OfferShippingDetails represents a Schema.org OfferShippingDetails object
+// For more details about the meaning of the properties see: https://schema.org/OfferShippingDetails
+type OfferShippingDetails struct {
+	Type                string                `json:"@type"`
+	ShippingRate        *MonetaryAmount       `json:"shippingRate,omitempty"`
+	ShippingDestination []*DefinedRegion      `json:"shippingDestination,omitempty"`
+	DeliveryTime        *ShippingDeliveryTime `json:"deliveryTime,omitempty"`
+	DoesNotShip         bool                  `json:"doesNotShip,omitempty"`
+}
+
+// ShippingDeliveryTime represents a Schema.org ShippingDeliveryTime object
+// For more details about the meaning of the properties see: https://schema.org/ShippingDeliveryTime
+type ShippingDeliveryTime struct {
+	Type         string             `json:"@type"`
+	HandlingTime *QuantitativeValue `json:"handlingTime,omitempty"`
+	TransitTime  *QuantitativeValue `json:"transitTime,omitempty"`
+}
+
+// DefinedRegion represents a Schema.org DefinedRegion object
+// For more details about the meaning of the properties see: https://schema.org/DefinedRegion
+type DefinedRegion struct {
+	Type           string     `json:"@type"`
+	AddressCountry string     `json:"addressCountry,omitempty"`
+	AddressRegion  StringList `json:"addressRegion,omitempty"`
+	PostalCode     StringList `json:"postalCode,omitempty"`
+}
+
+// MonetaryAmount represents a Schema.org MonetaryAmount object
+// For more details about the meaning of the properties see: https://schema.org/MonetaryAmount
+type MonetaryAmount struct {
+	Type     string  `json:"@type"`
+	Value    float64 `json:"value"`
+	Currency string  `json:"currency,omitempty"`
+}
+
+// QuantitativeValue represents a Schema.org QuantitativeValue object
+// For more details about the meaning of the properties see: https://schema.org/QuantitativeValue
+//
+// Values are pointers so that a zero (e.g. a same-day handling time) is still rendered.
+type QuantitativeValue struct {
+	Type     string   `json:"@type"`
+	Value    *float64 `json:"value,omitempty"`
+	MinValue *float64 `json:"minValue,omitempty"`
+	MaxValue *float64 `json:"maxValue,omitempty"`
+	UnitCode string   `json:"unitCode,omitempty"`
+}
+
+// UnmarshalJSON decodes a QuantitativeValue object, or a bare number such as
+// "numberOfEmployees": 250 into its Value.
+func (qv *QuantitativeValue) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && trimmed[0] != '{' {
+		var number json.Number
+		if err := json.Unmarshal(trimmed, &number); err != nil {
+			return fmt.Errorf("QuantitativeValue: invalid value: %s", string(trimmed))
+		}
+		value, err := number.Float64()
+		if err != nil {
+			return fmt.Errorf("QuantitativeValue: invalid value: %s", string(trimmed))
+		}
+		*qv = QuantitativeValue{Value: &value}
+		return nil
+	}
+	type alias QuantitativeValue
+	return json.Unmarshal(data, (*alias)(qv))
+}
+
+// MerchantReturnPolicy represents a Schema.org MerchantReturnPolicy object
+// For more details about the meaning of the properties see: https://schema.org/MerchantReturnPolicy
+type MerchantReturnPolicy struct {
+	Type                     string                       `json:"@type"`
+	ApplicableCountry        StringList                   `json:"applicableCountry,omitempty"`
+	ReturnPolicyCategory     MerchantReturnPolicyCategory `json:"returnPolicyCategory,omitempty"`
+	MerchantReturnDays       int                          `json:"merchantReturnDays,omitempty"`
+	ReturnMethod             ReturnMethod                 `json:"returnMethod,omitempty"`
+	ReturnFees               ReturnFees                   `json:"returnFees,omitempty"`
+	ReturnShippingFeesAmount *MonetaryAmount              `json:"returnShippingFeesAmount,omitempty"`
+	MerchantReturnLink       string                       `json:"merchantReturnLink,omitempty"`
+}
+
+// NewOffer initializes an Offer with default type.
+func NewOffer(url, price, priceCurrency string, availability ItemAvailability, itemCondition OfferItemCondition) *Offer {
+	offer := &Offer{
+		URL:           url,
+		Price:         Number(price),
+		PriceCurrency: priceCurrency,
+		Availability:  availability,
+		ItemCondition: itemCondition,
+	}
+	offer.ensureDefaults()
+	return offer
+}
+
+// NewAggregateOffer initializes an AggregateOffer with default type.
+func NewAggregateOffer(lowPrice, highPrice, priceCurrency string, offerCount int) *AggregateOffer {
+	offer := &AggregateOffer{
+		LowPrice:      Number(lowPrice),
+		HighPrice:     Number(highPrice),
+		PriceCurrency: priceCurrency,
+		OfferCount:    offerCount,
+	}
+	offer.ensureDefaults()
+	return offer
+}
+
+// NewShippingDetails initializes an OfferShippingDetails for a single destination country,
+// with handling and transit times expressed in days.
+func NewShippingDetails(rate float64, currency, country string, handlingDays, transitDays [2]float64) *OfferShippingDetails {
+	details := &OfferShippingDetails{
+		ShippingRate:        &MonetaryAmount{Value: rate, Currency: currency},
+		ShippingDestination: []*DefinedRegion{{AddressCountry: country}},
+		DeliveryTime: &ShippingDeliveryTime{
+			HandlingTime: NewQuantitativeRange(handlingDays[0], handlingDays[1], "DAY"),
+			TransitTime:  NewQuantitativeRange(transitDays[0], transitDays[1], "DAY"),
+		},
+	}
+	details.ensureDefaults()
+	return details
+}
+
+// NewQuantitativeRange initializes a QuantitativeValue with a min and max value.
+func NewQuantitativeRange(minValue, maxValue float64, unitCode string) *QuantitativeValue {
+	return &QuantitativeValue{
+		Type:     "QuantitativeValue",
+		MinValue: &minValue,
+		MaxValue: &maxValue,
+		UnitCode: unitCode,
+	}
+}
+
+// NewMerchantReturnPolicy initializes a MerchantReturnPolicy with default type.
+func NewMerchantReturnPolicy(country string, category MerchantReturnPolicyCategory, days int, method ReturnMethod, fees ReturnFees) *MerchantReturnPolicy {
+	policy := &MerchantReturnPolicy{
+		ApplicableCountry:    StringList{country},
+		ReturnPolicyCategory: category,
+		MerchantReturnDays:   days,
+		ReturnMethod:         method,
+		ReturnFees:           fees,
+	}
+	policy.ensureDefaults()
+	return policy
+}
+
+// validateMerchantOffer checks an Offer against the Google merchant listing requirements.
+func validateMerchantOffer(prefix string, o *Offer) []teseo.ValidationIssue {
+	var found issues
+
+	if o.Price == "" {
+		found.required(prefix + ".price")
+	} else if price, err := o.Price.Float64(); err != nil {
+		found.warnf(teseo.RuleInvalidFormat, prefix+".price", "%s.price %q is not a number", prefix, o.Price)
+	} else if price <= 0 {
+		found.warnf(teseo.RuleOutOfRange, prefix+".price", "%s.price must be greater than zero", prefix)
+	}
+	if o.PriceCurrency == "" {
+		found.required(prefix + ".priceCurrency")
+	}
+	if o.Availability == "" {
+		found.recommended(prefix + ".availability")
+	}
+	if o.ItemCondition == "" {
+		found.recommended(prefix + ".itemCondition")
+	}
+	found.add(o.validateEnums(prefix)...)
+	if len(o.ShippingDetails) == 0 {
+		found.recommended(prefix + ".shippingDetails")
+	}
+	for i, sd := range o.ShippingDetails {
+		found.add(sd.validate(fmt.Sprintf("%s.shippingDetails[%d]", prefix, i))...)
+	}
+	if o.HasMerchantReturnPolicy == nil {
+		found.recommended(prefix + ".hasMerchantReturnPolicy")
+	} else {
+		found.add(o.HasMerchantReturnPolicy.validate(prefix + ".hasMerchantReturnPolicy")...)
+	}
+
+	return found
+}
+
+// validateEnums returns an issue for every enumeration or date field of the Offer holding an unknown or malformed value.
+func (o *Offer) validateEnums(prefix string) []teseo.ValidationIssue {
+	var found issues
+	found.add(validateEnum(prefix+".availability", string(o.Availability), o.Availability.IsValid())...)
+	found.add(validateEnum(prefix+".itemCondition", string(o.ItemCondition), o.ItemCondition.IsValid())...)
+	found.add(validateDate(prefix+".priceValidUntil", o.PriceValidUntil)...)
+	return found
+}
+
+// validate checks the OfferShippingDetails fields used by merchant listings.
+func (sd *OfferShippingDetails) validate(prefix string) []teseo.ValidationIssue {
+	var found issues
+
+	if len(sd.ShippingDestination) == 0 {
+		found.required(prefix + ".shippingDestination")
+	}
+	for i, dest := range sd.ShippingDestination {
+		if dest.AddressCountry == "" {
+			found.required(fmt.Sprintf("%s.shippingDestination[%d].addressCountry", prefix, i))
+		}
+	}
+	if sd.DoesNotShip {
+		return found
+	}
+	if sd.ShippingRate == nil {
+		found.recommended(prefix + ".shippingRate")
+	} else if sd.ShippingRate.Currency == "" {
+		found.required(prefix + ".shippingRate.currency")
+	}
+	if sd.DeliveryTime != nil {
+		times := []struct {
+			name string
+			qv   *QuantitativeValue
+		}{
+			{"handlingTime", sd.DeliveryTime.HandlingTime},
+			{"transitTime", sd.DeliveryTime.TransitTime},
+		}
+		for _, t := range times {
+			if t.qv != nil && t.qv.MinValue != nil && t.qv.MaxValue != nil && *t.qv.MinValue > *t.qv.MaxValue {
+				found.warnf(teseo.RuleOutOfRange, fmt.Sprintf("%s.deliveryTime.%s", prefix, t.name), "%s.deliveryTime.%s minValue is greater than maxValue", prefix, t.name)
+			}
+		}
+	}
+
+	return found
+}
+
+// validate checks the MerchantReturnPolicy fields used by merchant listings.
+func (mrp *MerchantReturnPolicy) validate(prefix string) []teseo.ValidationIssue {
+	var found issues
+
+	if mrp.ApplicableCountry.IsZero() {
+		found.required(prefix + ".applicableCountry")
+	}
+	switch canonical(mrp.ReturnPolicyCategory) {
+	case "":
+		found.required(prefix + ".returnPolicyCategory")
+	case MerchantReturnFiniteReturnWindow:
+		if mrp.MerchantReturnDays <= 0 {
+			found.required(prefix + ".merchantReturnDays")
+		}
+	}
+	found.add(validateEnum(prefix+".returnPolicyCategory", string(mrp.ReturnPolicyCategory), mrp.ReturnPolicyCategory.IsValid())...)
+	found.add(validateEnum(prefix+".returnMethod", string(mrp.ReturnMethod), mrp.ReturnMethod.IsValid())...)
+	found.add(validateEnum(prefix+".returnFees", string(mrp.ReturnFees), mrp.ReturnFees.IsValid())...)
+	if canonical(mrp.ReturnFees) == ReturnShippingFees && mrp.ReturnShippingFeesAmount == nil {
+		found.required(prefix + ".returnShippingFeesAmount")
+	}
+
+	return found
+}
+
+// MarshalJSON encodes an Offer, merging the Extra properties into the JSON-LD object.
+func (o Offer) MarshalJSON() ([]byte, error) {
+	type alias Offer
+	return marshalWithExtra(alias(o), o.Extra)
+}
+
+// UnmarshalJSON decodes an Offer, capturing properties without a dedicated field into Extra.
+func (o *Offer) UnmarshalJSON(data []byte) error {
+	type alias Offer
+	if err := json.Unmarshal(data, (*alias)(o)); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, (*alias)(o))
+	if err != nil {
+		return err
+	}
+	o.Extra = extra
+	return nil
+}
+
+// ensureDefaults sets default values for Offer and its nested objects if they are not already set.
+func (o *Offer) ensureDefaults() {
+	if o.Type == "" {
+		o.Type = "Offer"
+	}
+
+	if o.Seller != nil {
+		o.Seller.ensureDefaults()
+	}
+
+	for _, sd := range o.ShippingDetails {
+		sd.ensureDefaults()
+	}
+
+	if o.HasMerchantReturnPolicy != nil {
+		o.HasMerchantReturnPolicy.ensureDefaults()
+	}
+}
+
+// ensureDefaults sets default values for AggregateOffer and its offers if they are not already set.
+func (ao *AggregateOffer) ensureDefaults() {
+	if ao.Type == "" {
+		ao.Type = "AggregateOffer"
+	}
+
+	if ao.OfferCount == 0 {
+		ao.OfferCount = len(ao.Offers)
+	}
+
+	for _, o := range ao.Offers {
+		o.ensureDefaults()
+	}
+}
+
+// ensureDefaults sets default values for OfferShippingDetails and its nested objects if they are not already set.
+func (sd *OfferShippingDetails) ensureDefaults() {
+	if sd.Type == "" {
+		sd.Type = "OfferShippingDetails"
+	}
+
+	if sd.ShippingRate != nil {
+		sd.ShippingRate.ensureDefaults()
+	}
+
+	for _, dest := range sd.ShippingDestination {
+		dest.ensureDefaults()
+	}
+
+	if sd.DeliveryTime != nil {
+		sd.DeliveryTime.ensureDefaults()
+	}
+}
+
+// ensureDefaults sets default values for ShippingDeliveryTime and its nested objects if they are not already set.
+func (dt *ShippingDeliveryTime) ensureDefaults() {
+	if dt.Type == "" {
+		dt.Type = "ShippingDeliveryTime"
+	}
+
+	if dt.HandlingTime != nil {
+		dt.HandlingTime.ensureDefaults()
+	}
+
+	if dt.TransitTime != nil {
+		dt.TransitTime.ensureDefaults()
+	}
+}
+
+// ensureDefaults sets default values for DefinedRegion if they are not already set.
+func (dr *DefinedRegion) ensureDefaults() {
+	if dr.Type == "" {
+		dr.Type = "DefinedRegion"
+	}
+}
+
+// ensureDefaults sets default values for MonetaryAmount if they are not already set.
+func (ma *MonetaryAmount) ensureDefaults() {
+	if ma.Type == "" {
+		ma.Type = "MonetaryAmount"
+	}
+}
+
+// ensureDefaults sets default values for QuantitativeValue if they are not already set.
+func (qv *QuantitativeValue) ensureDefaults() {
+	if qv.Type == "" {
+		qv.Type = "QuantitativeValue"
+	}
+}
+
+// ensureDefaults sets default values for MerchantReturnPolicy and its nested objects if they are not already set.
+func (mrp *MerchantReturnPolicy) ensureDefaults() {
+	if mrp.Type == "" {
+		mrp.Type = "MerchantReturnPolicy"
+	}
+
+	if mrp.ReturnShippingFeesAmount != nil {
+		mrp.ReturnShippingFeesAmount.ensureDefaults()
+	}
+}
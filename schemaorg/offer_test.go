@@ -0,0 +1,143 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func merchantOffer() *Offer {
+	offer := NewOffer("https://example.com/p", "29.99", "USD", InStock, NewCondition)
+	offer.ShippingDetails = []*OfferShippingDetails{
+		NewShippingDetails(0, "USD", "US", [2]float64{0, 1}, [2]float64{1, 5}),
+	}
+	offer.HasMerchantReturnPolicy = NewMerchantReturnPolicy("US", MerchantReturnFiniteReturnWindow, 30, ReturnByMail, FreeReturn)
+	return offer
+}
+
+func TestNewOffer_SetsDefaults(t *testing.T) {
+	offer := merchantOffer()
+
+	if offer.Type != "Offer" {
+		t.Errorf("expected type Offer, got %s", offer.Type)
+	}
+	sd := offer.ShippingDetails[0]
+	if sd.Type != "OfferShippingDetails" || sd.ShippingRate.Type != "MonetaryAmount" {
+		t.Errorf("unexpected shipping details defaults: %+v", sd)
+	}
+	if sd.ShippingDestination[0].Type != "DefinedRegion" {
+		t.Errorf("expected DefinedRegion type, got %s", sd.ShippingDestination[0].Type)
+	}
+	if sd.DeliveryTime.Type != "ShippingDeliveryTime" || sd.DeliveryTime.HandlingTime.Type != "QuantitativeValue" {
+		t.Errorf("unexpected delivery time defaults: %+v", sd.DeliveryTime)
+	}
+	if offer.HasMerchantReturnPolicy.Type != "MerchantReturnPolicy" {
+		t.Errorf("expected MerchantReturnPolicy type, got %s", offer.HasMerchantReturnPolicy.Type)
+	}
+}
+
+func TestOffer_MarshalJSON_ZeroValues(t *testing.T) {
+	data, err := json.Marshal(merchantOffer())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	out := string(data)
+	for _, want := range []string{
+		`"availability":"https://schema.org/InStock"`,
+		`"itemCondition":"https://schema.org/NewCondition"`,
+		`"shippingRate":{"@type":"MonetaryAmount","value":0,"currency":"USD"}`,
+		`"handlingTime":{"@type":"QuantitativeValue","minValue":0,"maxValue":1,"unitCode":"DAY"}`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("expected %s in %s", want, out)
+		}
+	}
+}
+
+func TestNewAggregateOffer_SetsDefaults(t *testing.T) {
+	ao := NewAggregateOffer("10.00", "20.00", "EUR", 0)
+	ao.Offers = []*Offer{{Price: "10.00"}, {Price: "20.00"}}
+	ao.ensureDefaults()
+
+	if ao.Type != "AggregateOffer" {
+		t.Errorf("expected type AggregateOffer, got %s", ao.Type)
+	}
+	if ao.OfferCount != 2 {
+		t.Errorf("expected offerCount 2, got %d", ao.OfferCount)
+	}
+	if ao.Offers[0].Type != "Offer" {
+		t.Errorf("expected nested Offer type, got %s", ao.Offers[0].Type)
+	}
+}
+
+func TestValidateMerchantOffer(t *testing.T) {
+	tests := []struct {
+		name     string
+		offer    *Offer
+		expected []string
+	}{
+		{
+			name:     "all good",
+			offer:    merchantOffer(),
+			expected: nil,
+		},
+		{
+			name:  "missing fields",
+			offer: &Offer{},
+			expected: []string{
+				"missing required field: offers.price",
+				"missing required field: offers.priceCurrency",
+				"missing recommended field: offers.availability",
+				"missing recommended field: offers.itemCondition",
+				"missing recommended field: offers.shippingDetails",
+				"missing recommended field: offers.hasMerchantReturnPolicy",
+			},
+		},
+		{
+			name: "invalid nested values",
+			offer: &Offer{
+				Price:         "0",
+				PriceCurrency: "USD",
+				Availability:  InStock,
+				ItemCondition: UsedCondition,
+				ShippingDetails: []*OfferShippingDetails{{
+					ShippingRate:        &MonetaryAmount{Value: 5},
+					ShippingDestination: []*DefinedRegion{{}},
+					DeliveryTime:        &ShippingDeliveryTime{TransitTime: NewQuantitativeRange(5, 2, "DAY")},
+				}},
+				HasMerchantReturnPolicy: &MerchantReturnPolicy{
+					ReturnPolicyCategory: MerchantReturnFiniteReturnWindow,
+					ReturnFees:           ReturnShippingFees,
+				},
+			},
+			expected: []string{
+				"offers.price must be greater than zero",
+				"missing required field: offers.shippingDetails[0].shippingDestination[0].addressCountry",
+				"missing required field: offers.shippingDetails[0].shippingRate.currency",
+				"offers.shippingDetails[0].deliveryTime.transitTime minValue is greater than maxValue",
+				"missing required field: offers.hasMerchantReturnPolicy.applicableCountry",
+				"missing required field: offers.hasMerchantReturnPolicy.merchantReturnDays",
+				"missing required field: offers.hasMerchantReturnPolicy.returnShippingFeesAmount",
+			},
+		},
+		{
+			name:     "non numeric price",
+			offer:    &Offer{Price: "free", PriceCurrency: "USD", Availability: InStock, ItemCondition: NewCondition, ShippingDetails: merchantOffer().ShippingDetails, HasMerchantReturnPolicy: merchantOffer().HasMerchantReturnPolicy},
+			expected: []string{`offers.price "free" is not a number`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := issueMessages(validateMerchantOffer("offers", tt.offer))
+			if len(got) != len(tt.expected) {
+				t.Fatalf("expected %d warnings, got %d: %v", len(tt.expected), len(got), got)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] {
+					t.Errorf("warning %d: expected %q, got %q", i, tt.expected[i], got[i])
+				}
+			}
+		})
+	}
+}
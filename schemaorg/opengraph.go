@@ -0,0 +1,235 @@
+package schemaorg
+
+import (
+	"time"
+
+	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/opengraph"
+)
+
+// Open Graph refers to people (authors, actors, musicians...) by the URL of
+// their profile page, while Schema.org describes them as Person nodes. The
+// conversions below map each URL to a Person with that URL and back, and keep
+// only the first image, as Open Graph objects hold a single one.
+
+// BookFromOpenGraph converts an Open Graph book into a Schema.org Book.
+func BookFromOpenGraph(og *opengraph.Book) *Book {
+	book := &Book{
+		Name:          og.Title,
+		URL:           og.URL,
+		Description:   og.Description,
+		Image:         NewImages(og.Image),
+		Author:        agentFromURLs(og.Author...),
+		ISBN:          og.ISBN,
+		DatePublished: og.ReleaseDate,
+		Keywords:      StringList(og.Tag),
+	}
+	book.ensureDefaults()
+	return book
+}
+
+// ToOpenGraph converts the Book into an Open Graph book.
+// When the Book itself has no ISBN, the ISBN of its first edition is used.
+func (b *Book) ToOpenGraph() *opengraph.Book {
+	isbn := b.ISBN
+	for _, edition := range b.WorkExample {
+		if isbn != "" {
+			break
+		}
+		if edition != nil {
+			isbn = edition.ISBN
+		}
+	}
+	return opengraph.NewBook(b.Name, b.URL, b.Description, firstValue(b.Image.URLs()), isbn, b.DatePublished.String(), agentURLs(b.Author), b.Keywords)
+}
+
+// MovieFromOpenGraph converts an Open Graph video.movie into a Schema.org Movie.
+func MovieFromOpenGraph(og *opengraph.VideoMovie) *Movie {
+	movie := &Movie{
+		Name:          og.Title,
+		URL:           og.URL,
+		Description:   og.Description,
+		Image:         NewImages(og.Image),
+		DatePublished: og.ReleaseDate,
+		Duration:      og.Duration,
+		Director:      agentFromURLs(og.DirectorURL),
+		Actor:         agentFromURLs(og.ActorURLs...),
+	}
+	movie.ensureDefaults()
+	return movie
+}
+
+// ToOpenGraph converts the Movie into an Open Graph video.movie.
+func (m *Movie) ToOpenGraph() *opengraph.VideoMovie {
+	return opengraph.NewVideoMovie(m.Name, m.URL, m.Description, firstValue(m.Image.URLs()), m.Duration.String(), agentURLs(m.Actor), firstValue(agentURLs(m.Director)), m.DatePublished.String())
+}
+
+// TVEpisodeFromOpenGraph converts an Open Graph video.episode into a Schema.org TVEpisode.
+func TVEpisodeFromOpenGraph(og *opengraph.VideoEpisode) *TVEpisode {
+	episode := &TVEpisode{
+		Name:          og.Title,
+		URL:           og.URL,
+		Description:   og.Description,
+		Image:         NewImages(og.Image),
+		EpisodeNumber: og.EpisodeNumber,
+		DatePublished: og.ReleaseDate,
+		Duration:      og.Duration,
+		Director:      agentFromURLs(og.DirectorURL),
+		Actor:         agentFromURLs(og.ActorURLs...),
+	}
+	if og.SeriesURL != "" {
+		episode.PartOfSeries = &TVSeries{URL: og.SeriesURL}
+	}
+	episode.ensureDefaults()
+	return episode
+}
+
+// ToOpenGraph converts the TVEpisode into an Open Graph video.episode.
+func (e *TVEpisode) ToOpenGraph() *opengraph.VideoEpisode {
+	var seriesURL string
+	if e.PartOfSeries != nil {
+		seriesURL = e.PartOfSeries.URL
+	}
+	return opengraph.NewVideoEpisode(e.Name, e.URL, e.Description, firstValue(e.Image.URLs()), e.Duration.String(), seriesURL, agentURLs(e.Actor), firstValue(agentURLs(e.Director)), e.DatePublished.String(), e.EpisodeNumber)
+}
+
+// MusicAlbumFromOpenGraph converts an Open Graph music.album into a Schema.org MusicAlbum.
+func MusicAlbumFromOpenGraph(og *opengraph.MusicAlbum) *MusicAlbum {
+	album := &MusicAlbum{
+		Name:          og.Title,
+		URL:           og.URL,
+		Description:   og.Description,
+		Image:         NewImages(og.Image),
+		ByArtist:      agentFromURLs(og.Musician...),
+		DatePublished: og.ReleaseDate,
+	}
+	if og.Genre != "" {
+		album.Genre = StringList{og.Genre}
+	}
+	album.ensureDefaults()
+	return album
+}
+
+// ToOpenGraph converts the MusicAlbum into an Open Graph music.album, keeping its first genre.
+func (ma *MusicAlbum) ToOpenGraph() *opengraph.MusicAlbum {
+	return opengraph.NewMusicAlbum(ma.Name, ma.URL, ma.Description, firstValue(ma.Image.URLs()), ma.DatePublished.String(), firstValue(ma.Genre), agentURLs(ma.ByArtist))
+}
+
+// MusicRecordingFromOpenGraph converts an Open Graph music.song into a Schema.org MusicRecording.
+func MusicRecordingFromOpenGraph(og *opengraph.MusicSong) *MusicRecording {
+	recording := &MusicRecording{
+		Name:        og.Title,
+		URL:         og.URL,
+		Description: og.Description,
+		Image:       NewImages(og.Image),
+		ByArtist:    agentFromURLs(og.MusicianURLs...),
+		Duration:    og.Duration,
+	}
+	if og.AlbumURL != "" {
+		recording.InAlbum = &MusicAlbum{URL: og.AlbumURL}
+	}
+	recording.ensureDefaults()
+	return recording
+}
+
+// ToOpenGraph converts the MusicRecording into an Open Graph music.song.
+func (mr *MusicRecording) ToOpenGraph() *opengraph.MusicSong {
+	var albumURL string
+	if mr.InAlbum != nil {
+		albumURL = mr.InAlbum.URL
+	}
+	return opengraph.NewMusicSong(mr.Name, mr.URL, mr.Description, firstValue(mr.Image.URLs()), mr.Duration.String(), albumURL, agentURLs(mr.ByArtist))
+}
+
+// MusicPlaylistFromOpenGraph converts an Open Graph music.playlist into a Schema.org MusicPlaylist.
+// Schema.org has no duration for playlists, so the Open Graph duration is not kept.
+func MusicPlaylistFromOpenGraph(og *opengraph.MusicPlaylist) *MusicPlaylist {
+	playlist := &MusicPlaylist{
+		Name:        og.Title,
+		URL:         og.URL,
+		Description: og.Description,
+		Image:       NewImages(og.Image),
+	}
+	for _, url := range og.SongURLs {
+		if url != "" {
+			playlist.Track = append(playlist.Track, &MusicRecording{URL: url})
+		}
+	}
+	playlist.NumTracks = len(playlist.Track)
+	playlist.ensureDefaults()
+	return playlist
+}
+
+// ToOpenGraph converts the MusicPlaylist into an Open Graph music.playlist.
+// The duration is the sum of the track durations, when every track has one.
+func (mp *MusicPlaylist) ToOpenGraph() *opengraph.MusicPlaylist {
+	var songURLs []string
+	var total time.Duration
+	complete := len(mp.Track) > 0
+	for _, track := range mp.Track {
+		if track == nil {
+			continue
+		}
+		if track.URL != "" {
+			songURLs = append(songURLs, track.URL)
+		}
+		d, err := track.Duration.Duration()
+		if track.Duration == "" || err != nil {
+			complete = false
+		}
+		total += d
+	}
+
+	var duration string
+	if complete {
+		duration = teseo.NewDuration(total).String()
+	}
+	return opengraph.NewMusicPlaylist(mp.Name, mp.URL, mp.Description, firstValue(mp.Image.URLs()), songURLs, duration)
+}
+
+// agentFromURLs returns a Person for each non-empty profile URL, or nil when there is none.
+func agentFromURLs(urls ...string) Agent {
+	var agents Agents
+	for _, url := range urls {
+		if url != "" {
+			agents = append(agents, &Person{URL: url})
+		}
+	}
+	switch len(agents) {
+	case 0:
+		return nil
+	case 1:
+		return agents[0]
+	}
+	return agents
+}
+
+// agentURLs returns the URL of every Person or Organization node of a and the @id of every reference.
+func agentURLs(a Agent) []string {
+	var urls []string
+	for _, node := range agentList(a) {
+		var url string
+		switch n := node.(type) {
+		case *Person:
+			url = n.URL
+		case *Organization:
+			url = n.URL
+		case *EducationalOrganization:
+			url = n.URL
+		case *NodeReference:
+			url = n.ID
+		}
+		if url != "" {
+			urls = append(urls, url)
+		}
+	}
+	return urls
+}
+
+// firstValue returns the first value of the list, if any.
+func firstValue(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
@@ -0,0 +1,92 @@
+package schemaorg
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/indaco/teseo/opengraph"
+)
+
+func TestBookFromOpenGraph_RoundTrip(t *testing.T) {
+	og := opengraph.NewBook("Book", "https://www.example.com/book", "desc", "https://www.example.com/book.jpg", "9780316769174", "2024-09-15", []string{"https://www.example.com/authors/jane"}, []string{"fiction"})
+
+	book := BookFromOpenGraph(og)
+	if author, ok := book.Author.(*Person); !ok || author.URL != "https://www.example.com/authors/jane" {
+		t.Errorf("expected author Person with profile URL, got %#v", book.Author)
+	}
+	if book.Type != "Book" || book.ISBN != og.ISBN || !reflect.DeepEqual(book.Image.URLs(), []string{og.Image}) {
+		t.Errorf("unexpected book %#v", book)
+	}
+
+	if back := book.ToOpenGraph(); !reflect.DeepEqual(back, og) {
+		t.Errorf("expected %#v, got %#v", og, back)
+	}
+}
+
+func TestBook_ToOpenGraph_EditionISBN(t *testing.T) {
+	book := &Book{Name: "Book", WorkExample: []*BookEdition{nil, {ISBN: "9780316769174"}}}
+	if got := book.ToOpenGraph().ISBN; got != "9780316769174" {
+		t.Errorf("expected edition ISBN, got %q", got)
+	}
+}
+
+func TestMovieFromOpenGraph_RoundTrip(t *testing.T) {
+	og := opengraph.NewVideoMovie("Movie", "https://www.example.com/movie", "desc", "https://www.example.com/movie.jpg", "PT2H", []string{"https://www.example.com/actors/jane", "https://www.example.com/actors/john"}, "https://www.example.com/directors/ann", "2024-09-15")
+
+	movie := MovieFromOpenGraph(og)
+	if actors, ok := movie.Actor.(Agents); !ok || len(actors) != 2 {
+		t.Errorf("expected two actors, got %#v", movie.Actor)
+	}
+	if back := movie.ToOpenGraph(); !reflect.DeepEqual(back, og) {
+		t.Errorf("expected %#v, got %#v", og, back)
+	}
+}
+
+func TestTVEpisodeFromOpenGraph_RoundTrip(t *testing.T) {
+	og := opengraph.NewVideoEpisode("Pilot", "https://www.example.com/episode", "desc", "", "1800", "https://www.example.com/series", nil, "", "2024-09-15", 1)
+
+	episode := TVEpisodeFromOpenGraph(og)
+	if episode.PartOfSeries == nil || episode.PartOfSeries.URL != og.SeriesURL || episode.PartOfSeries.Type != "TVSeries" {
+		t.Errorf("expected series reference, got %#v", episode.PartOfSeries)
+	}
+	if episode.Director != nil || episode.Image != nil {
+		t.Errorf("expected no director and no image, got %#v %#v", episode.Director, episode.Image)
+	}
+	if back := episode.ToOpenGraph(); !reflect.DeepEqual(back, og) {
+		t.Errorf("expected %#v, got %#v", og, back)
+	}
+}
+
+func TestMusicFromOpenGraph_RoundTrip(t *testing.T) {
+	album := opengraph.NewMusicAlbum("Album", "https://www.example.com/album", "desc", "", "2024-09-15", "Rock", []string{"https://www.example.com/band"})
+	if back := MusicAlbumFromOpenGraph(album).ToOpenGraph(); !reflect.DeepEqual(back, album) {
+		t.Errorf("expected %#v, got %#v", album, back)
+	}
+
+	song := opengraph.NewMusicSong("Song", "https://www.example.com/song", "desc", "", "PT3M", "https://www.example.com/album", []string{"https://www.example.com/band"})
+	recording := MusicRecordingFromOpenGraph(song)
+	if recording.InAlbum == nil || recording.InAlbum.URL != song.AlbumURL {
+		t.Errorf("expected album reference, got %#v", recording.InAlbum)
+	}
+	if back := recording.ToOpenGraph(); !reflect.DeepEqual(back, song) {
+		t.Errorf("expected %#v, got %#v", song, back)
+	}
+}
+
+func TestMusicPlaylist_ToOpenGraph_Duration(t *testing.T) {
+	og := opengraph.NewMusicPlaylist("Playlist", "https://www.example.com/playlist", "", "", []string{"https://www.example.com/song/1", "https://www.example.com/song/2"}, "600")
+	playlist := MusicPlaylistFromOpenGraph(og)
+	if playlist.NumTracks != 2 || playlist.Track[1].URL != "https://www.example.com/song/2" {
+		t.Fatalf("expected two tracks, got %#v", playlist.Track)
+	}
+
+	if got := playlist.ToOpenGraph().Duration; got != "" {
+		t.Errorf("expected no duration when tracks have none, got %q", got)
+	}
+
+	playlist.Track[0].Duration = "PT4M"
+	playlist.Track[1].Duration = "PT6M"
+	if got := playlist.ToOpenGraph().Duration.Seconds(); got != "600" {
+		t.Errorf("expected 600 seconds, got %q", got)
+	}
+}
@@ -0,0 +1,186 @@
+package schemaorg
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/indaco/teseo"
+)
+
+// OpeningHoursSpecification represents a Schema.org OpeningHoursSpecification object.
+// For more details about the meaning of the properties see: https://schema.org/OpeningHoursSpecification
+//
+// Regular hours list the days of the week; holiday or seasonal hours set
+// ValidFrom and ValidThrough instead. A business closed all day is described
+// with Opens and Closes both set to "00:00".
+//
+// Example usage:
+//
+//	hours := []*schemaorg.OpeningHoursSpecification{
+//		schemaorg.NewOpeningHoursSpecification([]schemaorg.DayOfWeek{schemaorg.Monday, schemaorg.Friday}, "09:00", "17:00"),
+//		{Opens: "00:00", Closes: "00:00", ValidFrom: "2024-12-25", ValidThrough: "2024-12-25"},
+//	}
+//
+// The compact form used by `openingHours` can be converted with ParseOpeningHours:
+//
+//	hours, err := schemaorg.ParseOpeningHours("Mo-Fr 09:00-17:00", "Sa 10:00-14:00")
+type OpeningHoursSpecification struct {
+	Type         string         `json:"@type"`
+	DayOfWeek    []DayOfWeek    `json:"dayOfWeek,omitempty"`
+	Opens        string         `json:"opens,omitempty"`
+	Closes       string         `json:"closes,omitempty"`
+	ValidFrom    teseo.DateTime `json:"validFrom,omitempty"`
+	ValidThrough teseo.DateTime `json:"validThrough,omitempty"`
+}
+
+// NewOpeningHoursSpecification initializes an OpeningHoursSpecification with default type.
+// Opens and closes are local times in the "hh:mm" or "hh:mm:ss" form.
+func NewOpeningHoursSpecification(days []DayOfWeek, opens, closes string) *OpeningHoursSpecification {
+	spec := &OpeningHoursSpecification{
+		DayOfWeek: days,
+		Opens:     opens,
+		Closes:    closes,
+	}
+	spec.ensureDefaults()
+	return spec
+}
+
+// validate checks the OpeningHoursSpecification fields, prefixing the issue paths with the given path.
+func (ohs *OpeningHoursSpecification) validate(prefix string) []teseo.ValidationIssue {
+	var found issues
+
+	if len(ohs.DayOfWeek) == 0 && ohs.ValidFrom == "" && ohs.ValidThrough == "" {
+		found.required(prefix + ".dayOfWeek")
+	}
+	for i, day := range ohs.DayOfWeek {
+		found.add(validateEnum(fmt.Sprintf("%s.dayOfWeek[%d]", prefix, i), string(day), day.IsValid())...)
+	}
+
+	if ohs.Opens == "" {
+		found.required(prefix + ".opens")
+	} else if !timeOfDayRe.MatchString(ohs.Opens) {
+		found.warnf(teseo.RuleInvalidTime, prefix+".opens", "invalid time for %s.opens: %q", prefix, ohs.Opens)
+	}
+	if ohs.Closes == "" {
+		found.required(prefix + ".closes")
+	} else if !timeOfDayRe.MatchString(ohs.Closes) {
+		found.warnf(teseo.RuleInvalidTime, prefix+".closes", "invalid time for %s.closes: %q", prefix, ohs.Closes)
+	}
+
+	found.add(validateDateTime(prefix+".validFrom", ohs.ValidFrom)...)
+	found.add(validateDateTime(prefix+".validThrough", ohs.ValidThrough)...)
+	found.add(validateTimeOrder(prefix+".validFrom", ohs.ValidFrom, prefix+".validThrough", ohs.ValidThrough)...)
+
+	return found
+}
+
+// ensureDefaults sets default values for OpeningHoursSpecification if they are not already set.
+func (ohs *OpeningHoursSpecification) ensureDefaults() {
+	if ohs.Type == "" {
+		ohs.Type = "OpeningHoursSpecification"
+	}
+}
+
+// timeOfDayRe matches a local time in the "hh:mm" or "hh:mm:ss" form. "24:00" is accepted as end of day.
+var timeOfDayRe = regexp.MustCompile(`^(?:[01]\d|2[0-3]):[0-5]\d(?::[0-5]\d)?$|^24:00(?::00)?$`)
+
+// weekDays lists the days of the week in order with their two-letter abbreviation.
+var weekDays = []struct {
+	abbr string
+	day  DayOfWeek
+}{
+	{"Mo", Monday},
+	{"Tu", Tuesday},
+	{"We", Wednesday},
+	{"Th", Thursday},
+	{"Fr", Friday},
+	{"Sa", Saturday},
+	{"Su", Sunday},
+}
+
+// ParseOpeningHours converts opening hours in the compact form used by the
+// `openingHours` property (e.g. "Mo-Fr 09:00-17:00", "Tu,Th 16:00-20:00" or
+// "Mo-Sa 09:00-12:00,14:00-18:00") into OpeningHoursSpecification values.
+// Each time range yields one specification. A value without days applies to
+// the whole week and a value without times ("Mo-Su") means open all day.
+func ParseOpeningHours(values ...string) ([]*OpeningHoursSpecification, error) {
+	var specs []*OpeningHoursSpecification
+
+	for _, value := range values {
+		fields := strings.Fields(value)
+		if len(fields) == 0 || len(fields) > 2 {
+			return nil, fmt.Errorf("invalid opening hours %q", value)
+		}
+
+		daysPart, timesPart := fields[0], ""
+		if len(fields) == 2 {
+			timesPart = fields[1]
+		} else if strings.Contains(daysPart, ":") {
+			daysPart, timesPart = "Mo-Su", fields[0]
+		}
+
+		days, err := parseDays(daysPart)
+		if err != nil {
+			return nil, fmt.Errorf("invalid opening hours %q: %w", value, err)
+		}
+
+		if timesPart == "" {
+			specs = append(specs, NewOpeningHoursSpecification(days, "00:00", "23:59"))
+			continue
+		}
+		for _, r := range strings.Split(timesPart, ",") {
+			opens, closes, ok := strings.Cut(r, "-")
+			if !ok || !timeOfDayRe.MatchString(opens) || !timeOfDayRe.MatchString(closes) {
+				return nil, fmt.Errorf("invalid opening hours %q: invalid time range %q", value, r)
+			}
+			specs = append(specs, NewOpeningHoursSpecification(days, opens, closes))
+		}
+	}
+
+	return specs, nil
+}
+
+// parseDays parses a comma separated list of two-letter days or day ranges
+// (e.g. "Mo-Fr", "Sa,Su" or "Fr-Mo"), returning the days in week order.
+func parseDays(s string) ([]DayOfWeek, error) {
+	selected := make([]bool, len(weekDays))
+
+	for _, part := range strings.Split(s, ",") {
+		from, to, isRange := strings.Cut(part, "-")
+		start, ok := weekDayIndex(from)
+		if !ok {
+			return nil, fmt.Errorf("unknown day %q", from)
+		}
+		end := start
+		if isRange {
+			if end, ok = weekDayIndex(to); !ok {
+				return nil, fmt.Errorf("unknown day %q", to)
+			}
+		}
+		for i := start; ; i = (i + 1) % len(weekDays) {
+			selected[i] = true
+			if i == end {
+				break
+			}
+		}
+	}
+
+	var days []DayOfWeek
+	for i, ok := range selected {
+		if ok {
+			days = append(days, weekDays[i].day)
+		}
+	}
+	return days, nil
+}
+
+// weekDayIndex returns the position in weekDays of a two-letter day abbreviation.
+func weekDayIndex(abbr string) (int, bool) {
+	for i, d := range weekDays {
+		if strings.EqualFold(d.abbr, abbr) {
+			return i, true
+		}
+	}
+	return 0, false
+}
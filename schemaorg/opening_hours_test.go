@@ -0,0 +1,136 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestParseOpeningHours(t *testing.T) {
+	weekdays := []DayOfWeek{Monday, Tuesday, Wednesday, Thursday, Friday}
+	tests := []struct {
+		name     string
+		values   []string
+		expected []*OpeningHoursSpecification
+	}{
+		{
+			name:   "day range",
+			values: []string{"Mo-Fr 09:00-17:00"},
+			expected: []*OpeningHoursSpecification{
+				{Type: "OpeningHoursSpecification", DayOfWeek: weekdays, Opens: "09:00", Closes: "17:00"},
+			},
+		},
+		{
+			name:   "day list and multiple values",
+			values: []string{"Tu,Th 16:00-20:00", "sa 10:00-14:00"},
+			expected: []*OpeningHoursSpecification{
+				{Type: "OpeningHoursSpecification", DayOfWeek: []DayOfWeek{Tuesday, Thursday}, Opens: "16:00", Closes: "20:00"},
+				{Type: "OpeningHoursSpecification", DayOfWeek: []DayOfWeek{Saturday}, Opens: "10:00", Closes: "14:00"},
+			},
+		},
+		{
+			name:   "split shift",
+			values: []string{"Mo-Fr 09:00-12:00,14:00-18:00"},
+			expected: []*OpeningHoursSpecification{
+				{Type: "OpeningHoursSpecification", DayOfWeek: weekdays, Opens: "09:00", Closes: "12:00"},
+				{Type: "OpeningHoursSpecification", DayOfWeek: weekdays, Opens: "14:00", Closes: "18:00"},
+			},
+		},
+		{
+			name:   "wrapping range",
+			values: []string{"Fr-Mo 18:00-02:00"},
+			expected: []*OpeningHoursSpecification{
+				{Type: "OpeningHoursSpecification", DayOfWeek: []DayOfWeek{Monday, Friday, Saturday, Sunday}, Opens: "18:00", Closes: "02:00"},
+			},
+		},
+		{
+			name:   "all day",
+			values: []string{"Sa-Su"},
+			expected: []*OpeningHoursSpecification{
+				{Type: "OpeningHoursSpecification", DayOfWeek: []DayOfWeek{Saturday, Sunday}, Opens: "00:00", Closes: "23:59"},
+			},
+		},
+		{
+			name:   "every day",
+			values: []string{"10:00-22:00"},
+			expected: []*OpeningHoursSpecification{
+				{Type: "OpeningHoursSpecification", DayOfWeek: []DayOfWeek{Monday, Tuesday, Wednesday, Thursday, Friday, Saturday, Sunday}, Opens: "10:00", Closes: "22:00"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseOpeningHours(tt.values...)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %+v, got %+v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestParseOpeningHours_Errors(t *testing.T) {
+	inputs := []string{
+		"",
+		"Mo-Fr 09:00-17:00 extra",
+		"Xx 09:00-17:00",
+		"Mo-Zz 09:00-17:00",
+		"Mo 9-17",
+		"Mo 25:00-26:00",
+	}
+	for _, in := range inputs {
+		if _, err := ParseOpeningHours(in); err == nil {
+			t.Errorf("expected error for %q", in)
+		}
+	}
+}
+
+func TestOpeningHoursSpecification_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		spec     *OpeningHoursSpecification
+		expected []string
+	}{
+		{"regular hours", NewOpeningHoursSpecification([]DayOfWeek{Monday}, "09:00", "17:00:00"), nil},
+		{"holiday closure", &OpeningHoursSpecification{Opens: "00:00", Closes: "00:00", ValidFrom: "2024-12-25", ValidThrough: "2024-12-25"}, nil},
+		{
+			"missing fields",
+			&OpeningHoursSpecification{},
+			[]string{
+				"missing required field: ohs.dayOfWeek",
+				"missing required field: ohs.opens",
+				"missing required field: ohs.closes",
+			},
+		},
+		{
+			"invalid values",
+			&OpeningHoursSpecification{DayOfWeek: []DayOfWeek{"Funday"}, Opens: "9am", Closes: "17:00", ValidFrom: "2024-12-31", ValidThrough: "2024-12-24"},
+			[]string{
+				`unknown ohs.dayOfWeek[0] value "Funday"`,
+				`invalid time for ohs.opens: "9am"`,
+				`ohs.validThrough "2024-12-24" is before ohs.validFrom "2024-12-31"`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := issueMessages(tt.spec.validate("ohs")); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestOpeningHoursSpecification_MarshalJSON(t *testing.T) {
+	spec := NewOpeningHoursSpecification([]DayOfWeek{"Saturday", Sunday}, "10:00", "14:00")
+	data, err := json.Marshal(spec)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `{"@type":"OpeningHoursSpecification","dayOfWeek":["https://schema.org/Saturday","https://schema.org/Sunday"],"opens":"10:00","closes":"14:00"}`
+	if string(data) != expected {
+		t.Errorf("expected %s, got %s", expected, data)
+	}
+}
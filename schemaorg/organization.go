@@ -1,8 +1,10 @@
 package schemaorg
 
 import (
+	"encoding/json"
 	"fmt"
 	"html/template"
+	"regexp"
 
 	"github.com/a-h/templ"
 	"github.com/indaco/teseo"
@@ -11,15 +13,25 @@ import (
 // Organization represents a Schema.org Organization object.
 // For more details about the meaning of the properties, see:https://schema.org/Organization
 //
+// Besides name, url and logo, the administrative details (legalName, address,
+// vatID, iso6523Code, duns...) help search engines disambiguate the organization
+// in knowledge panels. Setting Type selects a subtype such as Corporation, NGO
+// or OnlineStore. When the logo carries its width and height, Validate checks
+// that it meets the minimum size used by search engines.
+//
 // Example usage:
 //
 // Pure struct usage:
 //
 // 	organization := &schemaorg.Organization{
-// 		Name:        "Example Organization",
-// 		URL:         "https://www.example.com",
-// 		Logo:        "https://www.example.com/logo.jpg",
-// 		Description: "This is an example organization.",
+// 		Type:         schemaorg.TypeOnlineStore,
+// 		Name:         "Example Organization",
+// 		LegalName:    "Example Organization Ltd",
+// 		URL:          "https://www.example.com",
+// 		Logo:         &schemaorg.ImageObject{URL: "https://www.example.com/logo.jpg", Width: 512, Height: 512},
+// 		Description:  "This is an example organization.",
+// 		FoundingDate: "2004-05-12",
+// 		VatID:        "FR12345678901",
 // 	}
 //
 // Factory method usage:
@@ -55,32 +67,108 @@ import (
 // Organization represents a Schema.org Organization object
 // For more details about the meaning of the properties see: https://schema.org/Organization
 type Organization struct {
-	Context       string         `json:"@context"`
-	Type          string         `json:"@type"`
-	Name          string         `json:"name,omitempty"`
-	URL           string         `json:"url,omitempty"`
-	Logo          *ImageObject   `json:"logo,omitempty"`
-	ContactPoints []ContactPoint `json:"contactPoint,omitempty"`
-	SameAs        []string       `json:"sameAs,omitempty"`
+	Context                   string                `json:"@context"`
+	Type                      OrganizationType      `json:"@type"`
+	ID                        string                `json:"@id,omitempty"`
+	Name                      string                `json:"name,omitempty"`
+	LegalName                 string                `json:"legalName,omitempty"`
+	AlternateName             string                `json:"alternateName,omitempty"`
+	Description               string                `json:"description,omitempty"`
+	URL                       string                `json:"url,omitempty"`
+	Logo                      *ImageObject          `json:"logo,omitempty"`
+	Email                     string                `json:"email,omitempty"`
+	Telephone                 string                `json:"telephone,omitempty"`
+	Address                   *PostalAddress        `json:"address,omitempty"`
+	ContactPoints             []ContactPoint        `json:"contactPoint,omitempty"`
+	SameAs                    StringList            `json:"sameAs,omitempty"`
+	FoundingDate              teseo.Date            `json:"foundingDate,omitempty"`
+	Founder                   Agent                 `json:"founder,omitempty"`
+	NumberOfEmployees         *QuantitativeValue    `json:"numberOfEmployees,omitempty"`
+	VatID                     string                `json:"vatID,omitempty"`
+	TaxID                     string                `json:"taxID,omitempty"`
+	ISO6523Code               string                `json:"iso6523Code,omitempty"`
+	DUNS                      string                `json:"duns,omitempty"`
+	LeiCode                   string                `json:"leiCode,omitempty"`
+	ParentOrganization        *Organization         `json:"parentOrganization,omitempty"`
+	SubOrganization           []*Organization       `json:"subOrganization,omitempty"`
+	HasMerchantReturnPolicy   *MerchantReturnPolicy `json:"hasMerchantReturnPolicy,omitempty"`
+	InteractionStatistic      InteractionCounters   `json:"interactionStatistic,omitempty"`
+	AgentInteractionStatistic InteractionCounters   `json:"agentInteractionStatistic,omitempty"`
+	Extra                     Extra                 `json:"-"`
 }
 
-// Validate checks for recommended fields in Organization.
+// Validate checks for recommended fields in Organization, the format of its
+// identifiers and its parent and sub-organizations.
 func (org *Organization) Validate() []string {
-	var warnings []string
+	return issueMessages(org.ValidationIssues())
+}
+
+// ValidationIssues returns the issues found by Validate on the Organization as structured values.
+func (org *Organization) ValidationIssues() []teseo.ValidationIssue {
+	var found issues
+	found.add(org.validate("")...)
+	found.add(validateFormats(org)...)
+	return found
+}
+
+// validate checks the Organization fields, prefixing the issue paths with the given path.
+func (org *Organization) validate(prefix string) []teseo.ValidationIssue {
+	var found issues
 
 	if org.Name == "" {
-		warnings = append(warnings, "missing recommended field: name")
+		found.recommended(fieldPath(prefix, "name"))
 	}
 	if org.URL == "" {
-		warnings = append(warnings, "missing recommended field: url")
+		found.recommended(fieldPath(prefix, "url"))
 	}
 	if org.Logo == nil || org.Logo.URL == "" {
-		warnings = append(warnings, "missing recommended field: logo.url")
+		found.recommended(fieldPath(prefix, "logo.url"))
+	}
+	found.add(validateLogoSize(fieldPath(prefix, "logo"), org.Logo)...)
+	if isNearMiss(org.Type, organizationParents) {
+		found.warnf(teseo.RuleUnknownType, fieldPath(prefix, "@type"), "unrecognized Organization subtype %q", org.Type)
+	}
+
+	found.add(validateDate(fieldPath(prefix, "foundingDate"), org.FoundingDate)...)
+	found.add(validateAgent(fieldPath(prefix, "founder"), teseo.SeverityRecommended, org.Founder)...)
+	if qv := org.NumberOfEmployees; qv != nil {
+		for _, v := range []*float64{qv.Value, qv.MinValue, qv.MaxValue} {
+			if v != nil && *v < 0 {
+				found.warnf(teseo.RuleNegativeValue, fieldPath(prefix, "numberOfEmployees"), "%s must not be negative, got %g", fieldPath(prefix, "numberOfEmployees"), *v)
+				break
+			}
+		}
+	}
+	if org.ISO6523Code != "" && !iso6523CodeRe.MatchString(org.ISO6523Code) {
+		found.warnf(teseo.RuleInvalidFormat, fieldPath(prefix, "iso6523Code"), "invalid %s %q: expected an ISO 6523 ICD prefix followed by the identifier, e.g. \"0199:724500PMK2A2M1SQQ228\"", fieldPath(prefix, "iso6523Code"), org.ISO6523Code)
+	}
+	if org.DUNS != "" && !dunsRe.MatchString(org.DUNS) {
+		found.warnf(teseo.RuleInvalidFormat, fieldPath(prefix, "duns"), "invalid %s %q: expected 9 digits", fieldPath(prefix, "duns"), org.DUNS)
 	}
 
-	return warnings
+	if org.HasMerchantReturnPolicy != nil {
+		found.add(org.HasMerchantReturnPolicy.validate(fieldPath(prefix, "hasMerchantReturnPolicy"))...)
+	}
+	if org.ParentOrganization != nil && org.ParentOrganization.Name == "" {
+		found.recommended(fieldPath(prefix, "parentOrganization.name"))
+	}
+	for i, sub := range org.SubOrganization {
+		if sub != nil && sub.Name == "" {
+			found.recommended(fieldPath(prefix, fmt.Sprintf("subOrganization[%d].name", i)))
+		}
+	}
+	found.add(validateInteractionStatistics(prefix, org.InteractionStatistic, org.AgentInteractionStatistic)...)
+
+	return found
 }
 
+var (
+	// iso6523CodeRe matches an ISO 6523 identifier: a 4-digit ICD prefix, a colon and the identifier.
+	iso6523CodeRe = regexp.MustCompile(`^\d{4}:\S+$`)
+	// dunsRe matches a Dun & Bradstreet DUNS number, optionally written with dashes.
+	dunsRe = regexp.MustCompile(`^\d{2}-?\d{3}-?\d{4}$`)
+)
+
 // ToJsonLd converts the Organization struct to a JSON-LD `templ.Component`.
 func (org *Organization) ToJsonLd() templ.Component {
 	org.ensureDefaults()
@@ -109,6 +197,37 @@ func NewOrganization(name string, url string, logoURL string, contactPoints []Co
 	return org
 }
 
+// MarshalJSON encodes an Organization, merging the Extra properties into the JSON-LD object.
+func (org Organization) MarshalJSON() ([]byte, error) {
+	type alias Organization
+	return marshalWithExtra(alias(org), org.Extra)
+}
+
+// UnmarshalJSON decodes an Organization, resolving `founder` to Person, Organization or @id reference nodes based on their `@type`.
+func (org *Organization) UnmarshalJSON(data []byte) error {
+	type alias Organization
+	aux := struct {
+		*alias
+		Founder json.RawMessage `json:"founder,omitempty"`
+	}{alias: (*alias)(org)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, aux)
+	if err != nil {
+		return err
+	}
+	org.Extra = extra
+
+	founder, err := unmarshalAgent(aux.Founder)
+	if err != nil {
+		return fmt.Errorf("Organization: invalid founder: %w", err)
+	}
+	org.Founder = founder
+
+	return nil
+}
+
 func (org *Organization) ensureDefaults() {
 	if org.Context == "" {
 		org.Context = "https://schema.org"
@@ -121,4 +240,30 @@ func (org *Organization) ensureDefaults() {
 	if org.Logo != nil {
 		org.Logo.ensureDefaults()
 	}
+
+	if org.Address != nil {
+		org.Address.ensureDefaults()
+	}
+
+	ensureAgentDefaults(org.Founder)
+
+	if org.NumberOfEmployees != nil {
+		org.NumberOfEmployees.ensureDefaults()
+	}
+
+	if org.ParentOrganization != nil {
+		org.ParentOrganization.ensureDefaults()
+	}
+
+	for _, sub := range org.SubOrganization {
+		if sub != nil {
+			sub.ensureDefaults()
+		}
+	}
+
+	if org.HasMerchantReturnPolicy != nil {
+		org.HasMerchantReturnPolicy.ensureDefaults()
+	}
+
+	ensureInteractionStatisticsDefaults(org.InteractionStatistic, org.AgentInteractionStatistic)
 }
@@ -1,7 +1,10 @@
 package schemaorg
 
 import (
+	"encoding/json"
 	"html/template"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -141,3 +144,122 @@ func TestOrganization_ToGoHTMLJsonLd(t *testing.T) {
 		t.Errorf("expected non-empty HTML")
 	}
 }
+
+func TestOrganization_Validate_KnowledgePanelFields(t *testing.T) {
+	employees := 120.0
+	org := &Organization{
+		Name:              "Example Corp",
+		URL:               "https://example.com",
+		Logo:              &ImageObject{URL: "https://example.com/logo.png", Width: 512, Height: 512},
+		LegalName:         "Example Corporation Ltd",
+		FoundingDate:      "2004-05-12",
+		Founder:           &Person{Name: "Jane Doe"},
+		NumberOfEmployees: &QuantitativeValue{Value: &employees},
+		VatID:             "FR12345678901",
+		ISO6523Code:       "0199:724500PMK2A2M1SQQ228",
+		DUNS:              "15-048-3782",
+		SubOrganization:   []*Organization{{Name: "Example Labs"}},
+	}
+	if w := org.Validate(); len(w) != 0 {
+		t.Errorf("expected no warnings, got %v", w)
+	}
+}
+
+func TestOrganization_Validate_InvalidFields(t *testing.T) {
+	employees := -1.0
+	org := &Organization{
+		Name:               "Example Corp",
+		URL:                "https://example.com",
+		Logo:               &ImageObject{URL: "https://example.com/logo.png", Width: 64, Height: 200},
+		FoundingDate:       "12/05/2004",
+		Founder:            &Person{},
+		NumberOfEmployees:  &QuantitativeValue{Value: &employees},
+		ISO6523Code:        "724500PMK2A2M1SQQ228",
+		DUNS:               "1504837",
+		ParentOrganization: &Organization{},
+		SubOrganization:    []*Organization{{Name: "Example Labs"}, {}},
+	}
+	expected := []string{
+		"logo.width must be at least 112 pixels, got 64",
+		`invalid ISO 8601 date for foundingDate: "12/05/2004"`,
+		"missing recommended field: founder.name",
+		"numberOfEmployees must not be negative, got -1",
+		`invalid iso6523Code "724500PMK2A2M1SQQ228": expected an ISO 6523 ICD prefix followed by the identifier, e.g. "0199:724500PMK2A2M1SQQ228"`,
+		`invalid duns "1504837": expected 9 digits`,
+		"missing recommended field: parentOrganization.name",
+		"missing recommended field: subOrganization[1].name",
+	}
+	if w := org.Validate(); !reflect.DeepEqual(w, expected) {
+		t.Errorf("expected %v, got %v", expected, w)
+	}
+}
+
+func TestOrganization_UnmarshalJSON_Founder(t *testing.T) {
+	data := `{
+		"@context": "https://schema.org",
+		"@type": "Corporation",
+		"name": "Example Corp",
+		"founder": [{"@type": "Person", "name": "Jane Doe"}, {"@id": "https://example.com/#john"}],
+		"iso6523Code": "0199:724500PMK2A2M1SQQ228",
+		"vatID": "FR12345678901"
+	}`
+	var org Organization
+	if err := json.Unmarshal([]byte(data), &org); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	founders, ok := org.Founder.(Agents)
+	if !ok || len(founders) != 2 {
+		t.Fatalf("expected 2 founders, got %#v", org.Founder)
+	}
+	if p, ok := founders[0].(*Person); !ok || p.Name != "Jane Doe" {
+		t.Errorf("expected Person Jane Doe, got %#v", founders[0])
+	}
+	if _, ok := founders[1].(*NodeReference); !ok {
+		t.Errorf("expected *NodeReference, got %T", founders[1])
+	}
+	if org.ISO6523Code != "0199:724500PMK2A2M1SQQ228" || org.VatID != "FR12345678901" {
+		t.Errorf("unexpected identifiers: %q %q", org.ISO6523Code, org.VatID)
+	}
+	if org.Extra != nil {
+		t.Errorf("expected no extra properties, got %v", org.Extra)
+	}
+}
+
+func TestOrganization_UnmarshalJSON_TextShapes(t *testing.T) {
+	data := `{
+		"@type": "Organization",
+		"name": "Example Corp",
+		"numberOfEmployees": 250,
+		"address": "1600 Amphitheatre Pkwy, Mountain View, CA"
+	}`
+	things, err := Decode([]byte(data))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	org, ok := things[0].(*Organization)
+	if !ok {
+		t.Fatalf("expected *Organization, got %T", things[0])
+	}
+	if org.NumberOfEmployees == nil || org.NumberOfEmployees.Value == nil || *org.NumberOfEmployees.Value != 250 {
+		t.Errorf("expected 250 employees, got %#v", org.NumberOfEmployees)
+	}
+	if org.Address == nil || org.Address.Text != "1600 Amphitheatre Pkwy, Mountain View, CA" {
+		t.Errorf("expected a free-text address, got %#v", org.Address)
+	}
+
+	org.ensureDefaults()
+	out, err := json.Marshal(org)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for _, want := range []string{`"address":"1600 Amphitheatre Pkwy, Mountain View, CA"`, `"numberOfEmployees":{"@type":"QuantitativeValue","value":250}`} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("expected %s in %s", want, out)
+		}
+	}
+
+	var qv QuantitativeValue
+	if err := json.Unmarshal([]byte(`"many"`), &qv); err == nil {
+		t.Errorf("expected an error for a non-numeric value")
+	}
+}
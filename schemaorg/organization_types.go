@@ -0,0 +1,82 @@
+package schemaorg
+
+// OrganizationType is the `@type` of an Organization: Organization itself or
+// one of its subtypes, such as Corporation, NGO or OnlineStore.
+// LocalBusiness and EducationalOrganization are also Organization subtypes but
+// have their own structs and catalogues.
+// For the full hierarchy see: https://schema.org/Organization
+type OrganizationType string
+
+const (
+	TypeOrganization             OrganizationType = "Organization"
+	TypeAirline                  OrganizationType = "Airline"
+	TypeConsortium               OrganizationType = "Consortium"
+	TypeCorporation              OrganizationType = "Corporation"
+	TypeFundingScheme            OrganizationType = "FundingScheme"
+	TypeGovernmentOrganization   OrganizationType = "GovernmentOrganization"
+	TypeLibrarySystem            OrganizationType = "LibrarySystem"
+	TypeMedicalOrganization      OrganizationType = "MedicalOrganization"
+	TypeNGO                      OrganizationType = "NGO"
+	TypeNewsMediaOrganization    OrganizationType = "NewsMediaOrganization"
+	TypeOnlineBusiness           OrganizationType = "OnlineBusiness"
+	TypeOnlineStore              OrganizationType = "OnlineStore"
+	TypePerformingGroup          OrganizationType = "PerformingGroup"
+	TypeDanceGroup               OrganizationType = "DanceGroup"
+	TypeMusicGroup               OrganizationType = "MusicGroup"
+	TypeTheaterGroup             OrganizationType = "TheaterGroup"
+	TypePoliticalParty           OrganizationType = "PoliticalParty"
+	TypeResearchOrganization     OrganizationType = "ResearchOrganization"
+	TypeSearchRescueOrganization OrganizationType = "SearchRescueOrganization"
+	TypeSportsOrganization       OrganizationType = "SportsOrganization"
+	TypeSportsTeam               OrganizationType = "SportsTeam"
+	TypeWorkersUnion             OrganizationType = "WorkersUnion"
+)
+
+// organizationParents maps every catalogued Organization type to its parent type.
+var organizationParents = map[OrganizationType]OrganizationType{
+	TypeOrganization:             "",
+	TypeAirline:                  TypeOrganization,
+	TypeConsortium:               TypeOrganization,
+	TypeCorporation:              TypeOrganization,
+	TypeFundingScheme:            TypeOrganization,
+	TypeGovernmentOrganization:   TypeOrganization,
+	TypeLibrarySystem:            TypeOrganization,
+	TypeMedicalOrganization:      TypeOrganization,
+	TypeNGO:                      TypeOrganization,
+	TypeNewsMediaOrganization:    TypeOrganization,
+	TypeOnlineBusiness:           TypeOrganization,
+	TypeOnlineStore:              TypeOnlineBusiness,
+	TypePerformingGroup:          TypeOrganization,
+	TypeDanceGroup:               TypePerformingGroup,
+	TypeMusicGroup:               TypePerformingGroup,
+	TypeTheaterGroup:             TypePerformingGroup,
+	TypePoliticalParty:           TypeOrganization,
+	TypeResearchOrganization:     TypeOrganization,
+	TypeSearchRescueOrganization: TypeOrganization,
+	TypeSportsOrganization:       TypeOrganization,
+	TypeSportsTeam:               TypeSportsOrganization,
+	TypeWorkersUnion:             TypeOrganization,
+}
+
+// IsValid reports whether the type is Organization or one of the catalogued subtypes.
+func (t OrganizationType) IsValid() bool {
+	_, ok := organizationParents[t]
+	return ok
+}
+
+// Parent returns the parent type of a catalogued type, or an empty value for
+// Organization and for types outside the catalogue.
+func (t OrganizationType) Parent() OrganizationType {
+	return organizationParents[t]
+}
+
+// IsA reports whether the type is ancestor or one of its catalogued subtypes,
+// e.g. TypeOnlineStore.IsA(TypeOnlineBusiness) is true.
+func (t OrganizationType) IsA(ancestor OrganizationType) bool {
+	for current := t; current != ""; current = current.Parent() {
+		if current == ancestor {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package schemaorg
+
+import "testing"
+
+func TestOrganizationType_IsA(t *testing.T) {
+	tests := []struct {
+		t        OrganizationType
+		ancestor OrganizationType
+		want     bool
+	}{
+		{TypeOnlineStore, TypeOnlineBusiness, true},
+		{TypeOnlineStore, TypeOrganization, true},
+		{TypeMusicGroup, TypePerformingGroup, true},
+		{TypeNGO, TypeNGO, true},
+		{TypeCorporation, TypeOnlineBusiness, false},
+		{"Startup", TypeOrganization, false},
+	}
+	for _, tt := range tests {
+		if got := tt.t.IsA(tt.ancestor); got != tt.want {
+			t.Errorf("%s.IsA(%s) = %v, want %v", tt.t, tt.ancestor, got, tt.want)
+		}
+	}
+}
+
+func TestOrganization_Validate_Subtype(t *testing.T) {
+	org := &Organization{Type: "Corporaton", Name: "Example", URL: "https://example.com", Logo: &ImageObject{URL: "https://example.com/logo.png"}}
+	w := org.Validate()
+	if len(w) != 1 || w[0] != `unrecognized Organization subtype "Corporaton"` {
+		t.Errorf("expected subtype warning, got %v", w)
+	}
+
+	org.Type = "Startup"
+	if w := org.Validate(); len(w) != 0 {
+		t.Errorf("expected no warnings for a type outside the catalogue, got %v", w)
+	}
+
+	org.Type = TypeCorporation
+	if w := org.Validate(); len(w) != 0 {
+		t.Errorf("expected no warnings, got %v", w)
+	}
+}
+
+func TestDecode_OrganizationSubtype(t *testing.T) {
+	things, err := Decode([]byte(`{"@context":"https://schema.org","@type":"OnlineStore","name":"Example Shop"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	org, ok := things[0].(*Organization)
+	if !ok {
+		t.Fatalf("expected *Organization, got %T", things[0])
+	}
+	if org.Type != TypeOnlineStore {
+		t.Errorf("expected type OnlineStore, got %s", org.Type)
+	}
+}
+
+func TestUnmarshalAgent_OrganizationSubtype(t *testing.T) {
+	agent, err := unmarshalAgent([]byte(`{"@type":"WorkersUnion","name":"Example Union"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := agent.(*Organization); !ok {
+		t.Errorf("expected *Organization, got %T", agent)
+	}
+}
@@ -1,6 +1,8 @@
 package schemaorg
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"html/template"
 
@@ -55,21 +57,29 @@ import (
 // Person represents a Schema.org Person object
 // For more details about the meaning of the properties see: https://schema.org/Person
 type Person struct {
-	Context     string         `json:"@context"`
-	Type        string         `json:"@type"`
-	Name        string         `json:"name,omitempty"`
-	URL         string         `json:"url,omitempty"`
-	Email       string         `json:"email,omitempty"`
-	Image       *ImageObject   `json:"image,omitempty"`
-	JobTitle    string         `json:"jobTitle,omitempty"`
-	WorksFor    *Organization  `json:"worksFor,omitempty"`
-	SameAs      []string       `json:"sameAs,omitempty"`
-	Gender      string         `json:"gender,omitempty"`
-	BirthDate   string         `json:"birthDate,omitempty"`
-	Nationality string         `json:"nationality,omitempty"`
-	Telephone   string         `json:"telephone,omitempty"`
-	Address     *PostalAddress `json:"address,omitempty"`
-	Affiliation *Organization  `json:"affiliation,omitempty"`
+	Context                   string              `json:"@context"`
+	Type                      string              `json:"@type"`
+	ID                        string              `json:"@id,omitempty"`
+	Name                      string              `json:"name,omitempty"`
+	AlternateName             string              `json:"alternateName,omitempty"`
+	GivenName                 string              `json:"givenName,omitempty"`
+	FamilyName                string              `json:"familyName,omitempty"`
+	Description               string              `json:"description,omitempty"`
+	URL                       string              `json:"url,omitempty"`
+	Email                     string              `json:"email,omitempty"`
+	Image                     *ImageObject        `json:"image,omitempty"`
+	JobTitle                  string              `json:"jobTitle,omitempty"`
+	WorksFor                  *Organization       `json:"worksFor,omitempty"`
+	SameAs                    StringList          `json:"sameAs,omitempty"`
+	Gender                    GenderType          `json:"gender,omitempty"`
+	BirthDate                 teseo.Date          `json:"birthDate,omitempty"`
+	Nationality               string              `json:"nationality,omitempty"`
+	Telephone                 string              `json:"telephone,omitempty"`
+	Address                   *PostalAddress      `json:"address,omitempty"`
+	Affiliation               *Organization       `json:"affiliation,omitempty"`
+	InteractionStatistic      InteractionCounters `json:"interactionStatistic,omitempty"`
+	AgentInteractionStatistic InteractionCounters `json:"agentInteractionStatistic,omitempty"`
+	Extra                     Extra               `json:"-"`
 }
 
 // PostalAddress represents a Schema.org PostalAddress object
@@ -80,6 +90,30 @@ type PostalAddress struct {
 	AddressRegion   string `json:"addressRegion,omitempty"`
 	PostalCode      string `json:"postalCode,omitempty"`
 	AddressCountry  string `json:"addressCountry,omitempty"`
+	Text            string `json:"-"` // free-text address, rendered as a string when no other field is set
+}
+
+// MarshalJSON renders a free-text address as a string and a structured one as an object.
+func (addr PostalAddress) MarshalJSON() ([]byte, error) {
+	type alias PostalAddress
+	structured := addr
+	structured.Type, structured.Text = "", ""
+	if addr.Text != "" && structured == (PostalAddress{}) {
+		return json.Marshal(addr.Text)
+	}
+	return json.Marshal(alias(addr))
+}
+
+// UnmarshalJSON decodes a PostalAddress object, or a free-text address such as
+// "address": "1600 Amphitheatre Pkwy, Mountain View, CA" into Text.
+func (addr *PostalAddress) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) > 0 && trimmed[0] == '"' {
+		*addr = PostalAddress{}
+		return json.Unmarshal(trimmed, &addr.Text)
+	}
+	type alias PostalAddress
+	return json.Unmarshal(data, (*alias)(addr))
 }
 
 // ensureDefaults sets default values for PostalAddress if they are not already set.
@@ -99,8 +133,8 @@ func NewPerson(name string, url string, email string, image *ImageObject, jobTit
 		JobTitle:    jobTitle,
 		WorksFor:    worksFor,
 		SameAs:      sameAs,
-		Gender:      gender,
-		BirthDate:   birthDate,
+		Gender:      GenderType(gender),
+		BirthDate:   teseo.Date(birthDate),
 		Nationality: nationality,
 		Telephone:   telephone,
 		Address:     address,
@@ -110,23 +144,28 @@ func NewPerson(name string, url string, email string, image *ImageObject, jobTit
 	return person
 }
 
-// Validate checks for recommended or required fields in Person.
+// Validate checks for recommended fields in Person. Email and jobTitle are
+// optional: no search feature requires them.
 func (p *Person) Validate() []string {
-	var warnings []string
+	return issueMessages(p.ValidationIssues())
+}
 
-	if p.Name == "" {
-		warnings = append(warnings, "missing recommended field: name")
-	}
+// ValidationIssues returns the issues found by Validate on the Person as structured values.
+func (p *Person) ValidationIssues() []teseo.ValidationIssue {
+	var found issues
 
-	if p.Email == "" {
-		warnings = append(warnings, "missing recommended field: email")
+	if p.Name == "" {
+		found.recommended("name")
 	}
 
-	if p.JobTitle == "" {
-		warnings = append(warnings, "missing recommended field: jobTitle")
+	found.add(validateDate("birthDate", p.BirthDate)...)
+	if p.Image != nil {
+		found.add(p.Image.validate("image")...)
 	}
+	found.add(validateInteractionStatistics("", p.InteractionStatistic, p.AgentInteractionStatistic)...)
 
-	return warnings
+	found.add(validateFormats(p)...)
+	return found
 }
 
 // ToJsonLd converts the Person struct to a JSON-LD `templ.Component`.
@@ -141,6 +180,26 @@ func (p *Person) ToGoHTMLJsonLd() (template.HTML, error) {
 	return teseo.RenderToHTML(p.ToJsonLd())
 }
 
+// MarshalJSON encodes a Person, merging the Extra properties into the JSON-LD object.
+func (p Person) MarshalJSON() ([]byte, error) {
+	type alias Person
+	return marshalWithExtra(alias(p), p.Extra)
+}
+
+// UnmarshalJSON decodes a Person, capturing properties without a dedicated field into Extra.
+func (p *Person) UnmarshalJSON(data []byte) error {
+	type alias Person
+	if err := json.Unmarshal(data, (*alias)(p)); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, (*alias)(p))
+	if err != nil {
+		return err
+	}
+	p.Extra = extra
+	return nil
+}
+
 // ensureDefaults sets default values for Person and its nested objects if they are not already set.
 func (p *Person) ensureDefaults() {
 	if p.Context == "" {
@@ -166,4 +225,6 @@ func (p *Person) ensureDefaults() {
 	if p.Affiliation != nil {
 		p.Affiliation.ensureDefaults()
 	}
+
+	ensureInteractionStatisticsDefaults(p.InteractionStatistic, p.AgentInteractionStatistic)
 }
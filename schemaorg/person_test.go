@@ -103,12 +103,12 @@ func TestPerson_Validate_MissingFields(t *testing.T) {
 		{
 			name:     "missing all",
 			person:   &Person{},
-			expected: []string{"missing recommended field: name", "missing recommended field: email", "missing recommended field: jobTitle"},
+			expected: []string{"missing recommended field: name"},
 		},
 		{
-			name:     "missing email and job title",
+			name:     "name only",
 			person:   &Person{Name: "Jane"},
-			expected: []string{"missing recommended field: email", "missing recommended field: jobTitle"},
+			expected: nil,
 		},
 	}
 
@@ -1,6 +1,8 @@
 package schemaorg
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"html/template"
 
@@ -20,7 +22,7 @@ import (
 //		Description: "This is an example product description.",
 //		SKU:         "12345",
 //		Brand:       &schemaorg.Brand{Name: "Example Brand"},
-//		Offers:      &schemaorg.Offer{Price: "29.99", PriceCurrency: "USD"},
+//		Offers:      schemaorg.NewOffers(&schemaorg.Offer{Price: "29.99", PriceCurrency: "USD"}),
 //	}
 //
 // Factory method usage:
@@ -28,9 +30,11 @@ import (
 //	product := schemaorg.NewProduct(
 //		"Example Product",
 //		"This is an example product description.",
+//		[]string{"https://www.example.com/product.jpg"},
 //		"12345",
 //		&schemaorg.Brand{Name: "Example Brand"},
-//		&schemaorg.Offer{Price: "29.99", PriceCurrency: "USD"},
+//		schemaorg.NewOffers(&schemaorg.Offer{Price: "29.99", PriceCurrency: "USD"}),
+//		"", nil, nil,
 //	)
 //
 // // Rendering JSON-LD using templ:
@@ -58,18 +62,34 @@ import (
 //			"priceCurrency": "USD"
 //		}
 //	}
+//
+// Offers may hold several offers, rendered as an array. AggregateOffer summarises
+// the offers of several sellers for product snippets; it is rendered as the
+// `offers` property, after the Offers when both are set. Merchant listings need
+// Offers with a price and do not accept an AggregateOffer alone.
 type Product struct {
-	Context         string           `json:"@context"`
-	Type            string           `json:"@type"`
-	Name            string           `json:"name,omitempty"`
-	Description     string           `json:"description,omitempty"`
-	Image           []string         `json:"image,omitempty"`
-	SKU             string           `json:"sku,omitempty"`
-	Brand           *Brand           `json:"brand,omitempty"`
-	Offers          *Offer           `json:"offers,omitempty"`
-	Category        string           `json:"category,omitempty"`
-	AggregateRating *AggregateRating `json:"aggregateRating,omitempty"`
-	Review          []*Review        `json:"review,omitempty"`
+	Context              string           `json:"@context"`
+	Type                 string           `json:"@type"`
+	Name                 string           `json:"name,omitempty"`
+	Description          string           `json:"description,omitempty"`
+	URL                  string           `json:"url,omitempty"`
+	Image                Images           `json:"image,omitempty"`
+	SKU                  string           `json:"sku,omitempty"`
+	GTIN                 string           `json:"gtin,omitempty"`
+	MPN                  string           `json:"mpn,omitempty"`
+	Brand                *Brand           `json:"brand,omitempty"`
+	Offers               Offers           `json:"offers,omitempty"`
+	AggregateOffer       *AggregateOffer  `json:"-"`
+	Category             string           `json:"category,omitempty"`
+	Color                string           `json:"color,omitempty"`
+	Size                 string           `json:"size,omitempty"`
+	Material             string           `json:"material,omitempty"`
+	Pattern              string           `json:"pattern,omitempty"`
+	InProductGroupWithID string           `json:"inProductGroupWithID,omitempty"`
+	IsVariantOf          *ProductGroup    `json:"isVariantOf,omitempty"`
+	AggregateRating      *AggregateRating `json:"aggregateRating,omitempty"`
+	Review               []*Review        `json:"review,omitempty"`
+	Extra                Extra            `json:"-"`
 }
 
 // Brand represents a Schema.org Brand object
@@ -78,48 +98,15 @@ type Brand struct {
 	Name string `json:"name,omitempty"`
 }
 
-// Offer represents a Schema.org Offer object
-type Offer struct {
-	Type          string `json:"@type"`
-	URL           string `json:"url,omitempty"`
-	PriceCurrency string `json:"priceCurrency,omitempty"`
-	Price         string `json:"price,omitempty"`
-	Availability  string `json:"availability,omitempty"`
-	ItemCondition string `json:"itemCondition,omitempty"`
-}
-
-// AggregateRating represents a Schema.org AggregateRating object
-type AggregateRating struct {
-	Type        string  `json:"@type"`
-	RatingValue float64 `json:"ratingValue,omitempty"`
-	ReviewCount int     `json:"reviewCount,omitempty"`
-}
-
-// Review represents a Schema.org Review object
-type Review struct {
-	Type          string  `json:"@type"`
-	Author        *Person `json:"author,omitempty"`
-	DatePublished string  `json:"datePublished,omitempty"`
-	ReviewBody    string  `json:"reviewBody,omitempty"`
-	ReviewRating  *Rating `json:"reviewRating,omitempty"`
-}
-
-// Rating represents a Schema.org Rating object
-type Rating struct {
-	Type        string  `json:"@type"`
-	RatingValue float64 `json:"ratingValue,omitempty"`
-	BestRating  float64 `json:"bestRating,omitempty"`
-}
-
 // NewProduct initializes a Product with default context and type.
-func NewProduct(name, description string, image []string, sku string, brand *Brand, offers *Offer, category string, aggregateRating *AggregateRating, reviews []*Review) *Product {
+func NewProduct(name, description string, image []string, sku string, brand *Brand, offers Offers, category string, aggregateRating *AggregateRating, reviews []*Review) *Product {
 	product := &Product{
 		Name:            name,
 		Description:     description,
-		Image:           image,
+		Image:           NewImages(image...),
 		SKU:             sku,
 		Brand:           brand,
-		Offers:          offers,
+		Offers:          NewOffers(offers...),
 		Category:        category,
 		AggregateRating: aggregateRating,
 		Review:          reviews,
@@ -128,6 +115,160 @@ func NewProduct(name, description string, image []string, sku string, brand *Bra
 	return product
 }
 
+// MarshalJSON encodes the Product, rendering AggregateOffer as `offers`, after the
+// Offers when both are set, and merging the Extra properties into the JSON-LD object.
+func (p Product) MarshalJSON() ([]byte, error) {
+	type alias Product
+	if p.AggregateOffer == nil {
+		return marshalWithExtra(alias(p), p.Extra)
+	}
+	var offers any = p.AggregateOffer
+	if len(p.Offers) > 0 {
+		list := make([]any, 0, len(p.Offers)+1)
+		for _, o := range p.Offers {
+			list = append(list, o)
+		}
+		offers = append(list, p.AggregateOffer)
+	}
+	return marshalWithExtra(struct {
+		alias
+		Offers any `json:"offers,omitempty"`
+	}{alias(p), offers}, p.Extra)
+}
+
+// UnmarshalJSON decodes a Product, resolving each node of `offers`, an object or
+// an array, to Offers or AggregateOffer based on its `@type`.
+func (p *Product) UnmarshalJSON(data []byte) error {
+	type alias Product
+	aux := struct {
+		*alias
+		Offers json.RawMessage `json:"offers,omitempty"`
+	}{alias: (*alias)(p)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, aux)
+	if err != nil {
+		return err
+	}
+	p.Extra = extra
+	p.Offers, p.AggregateOffer = nil, nil
+
+	nodes, err := unmarshalObjects[json.RawMessage](aux.Offers)
+	if err != nil {
+		return fmt.Errorf("Product: invalid offers: %w", err)
+	}
+	for _, node := range nodes {
+		var probe struct {
+			Type string `json:"@type"`
+		}
+		if err := json.Unmarshal(*node, &probe); err != nil {
+			return fmt.Errorf("Product: invalid offers: %w", err)
+		}
+		if probe.Type != "AggregateOffer" {
+			offer := &Offer{}
+			if err := json.Unmarshal(*node, offer); err != nil {
+				return fmt.Errorf("Product: invalid offers: %w", err)
+			}
+			p.Offers = append(p.Offers, offer)
+			continue
+		}
+		if p.AggregateOffer != nil {
+			return errors.New("Product: invalid offers: more than one AggregateOffer")
+		}
+		p.AggregateOffer = &AggregateOffer{}
+		if err := json.Unmarshal(*node, p.AggregateOffer); err != nil {
+			return fmt.Errorf("Product: invalid offers: %w", err)
+		}
+	}
+	return nil
+}
+
+// Validate checks if the Product has the required fields for product snippets.
+func (p *Product) Validate() []string {
+	return issueMessages(p.ValidationIssues())
+}
+
+// ValidationIssues returns the issues found by Validate on the Product as structured values.
+func (p *Product) ValidationIssues() []teseo.ValidationIssue {
+	var found issues
+
+	if p.Name == "" {
+		found.required("name")
+	}
+	if len(p.Offers) == 0 && p.AggregateOffer == nil && p.AggregateRating == nil && len(p.Review) == 0 {
+		found.missing(teseo.SeverityRequired, "offers", "one of offers, review or aggregateRating")
+	}
+	if len(p.Image) == 0 {
+		found.recommended("image")
+	}
+	if p.Brand == nil || p.Brand.Name == "" {
+		found.recommended("brand.name")
+	}
+	paths := p.Offers.paths("offers")
+	for i, o := range p.Offers {
+		if o != nil {
+			found.add(o.validateEnums(paths[i])...)
+		}
+	}
+	if len(p.Offers) > 0 && p.AggregateOffer != nil {
+		found.warnf(teseo.RuleConstraint, "offers", "offers: both Offers and AggregateOffer are set, AggregateOffer should summarise the offers on its own")
+	}
+	if p.AggregateOffer != nil {
+		for i, o := range p.AggregateOffer.Offers {
+			found.add(o.validateEnums(fmt.Sprintf("offers.offers[%d]", i))...)
+		}
+	}
+	found.add(p.Image.validate("image")...)
+
+	found.add(validateFormats(p)...)
+	return found
+}
+
+// ValidateMerchantListing checks if the Product satisfies the Google merchant listing requirements.
+// For more details see: https://developers.google.com/search/docs/appearance/structured-data/merchant-listing
+func (p *Product) ValidateMerchantListing() []string {
+	return issueMessages(p.merchantListingIssues())
+}
+
+// merchantListingIssues returns the issues found by ValidateMerchantListing as structured values.
+func (p *Product) merchantListingIssues() []teseo.ValidationIssue {
+	var found issues
+
+	if p.Name == "" {
+		found.required("name")
+	}
+	if len(p.Image) == 0 {
+		found.required("image")
+	}
+
+	paths := p.Offers.paths("offers")
+	for i, o := range p.Offers {
+		if o != nil {
+			found.add(validateMerchantOffer(paths[i], o)...)
+		}
+	}
+	if len(p.Offers) == 0 {
+		if p.AggregateOffer != nil {
+			found.addf(teseo.SeverityRequired, teseo.RuleMissingField, "offers.price", "missing required field: offers.price (merchant listings need an Offer, AggregateOffer is only supported by product snippets)")
+		} else {
+			found.required("offers")
+		}
+	}
+
+	if p.GTIN == "" && p.MPN == "" {
+		found.missing(teseo.SeverityRecommended, "gtin", "gtin or mpn")
+	}
+	if p.Brand == nil || p.Brand.Name == "" {
+		found.recommended("brand.name")
+	}
+	if p.Description == "" {
+		found.recommended("description")
+	}
+
+	return found
+}
+
 // ToJsonLd converts the Product struct to a JSON-LD `templ.Component`.
 func (p *Product) ToJsonLd() templ.Component {
 	p.ensureDefaults()
@@ -154,8 +295,14 @@ func (p *Product) ensureDefaults() {
 		p.Brand.ensureDefaults()
 	}
 
-	if p.Offers != nil {
-		p.Offers.ensureDefaults()
+	p.Offers.ensureDefaults()
+
+	if p.AggregateOffer != nil {
+		p.AggregateOffer.ensureDefaults()
+	}
+
+	if p.IsVariantOf != nil {
+		p.IsVariantOf.ensureDefaults()
 	}
 
 	if p.AggregateRating != nil {
@@ -166,6 +313,8 @@ func (p *Product) ensureDefaults() {
 		review.ensureDefaults()
 
 	}
+
+	p.Image.ensureDefaults()
 }
 
 // ensureDefaults sets default values for Brand if they are not already set.
@@ -174,39 +323,3 @@ func (b *Brand) ensureDefaults() {
 		b.Type = "Brand"
 	}
 }
-
-// ensureDefaults sets default values for Offer if they are not already set.
-func (o *Offer) ensureDefaults() {
-	if o.Type == "" {
-		o.Type = "Offer"
-	}
-}
-
-// ensureDefaults sets default values for AggregateRating if they are not already set.
-func (ar *AggregateRating) ensureDefaults() {
-	if ar.Type == "" {
-		ar.Type = "AggregateRating"
-	}
-}
-
-// ensureDefaults sets default values for Review if they are not already set.
-func (r *Review) ensureDefaults() {
-	if r.Type == "" {
-		r.Type = "Review"
-	}
-
-	if r.Author != nil {
-		r.Author.ensureDefaults()
-	}
-
-	if r.ReviewRating != nil {
-		r.ReviewRating.ensureDefaults()
-	}
-}
-
-// ensureDefaults sets default values for Rating if they are not already set.
-func (ra *Rating) ensureDefaults() {
-	if ra.Type == "" {
-		ra.Type = "Rating"
-	}
-}
@@ -0,0 +1,201 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+
+	"github.com/a-h/templ"
+	"github.com/indaco/teseo"
+)
+
+// ProductGroup represents a Schema.org ProductGroup object.
+// For more details about the meaning of the properties see: https://schema.org/ProductGroup
+//
+// A ProductGroup groups the variants of a product (e.g. sizes and colors of a
+// t-shirt) that share the same productGroupID.
+//
+// Example usage:
+//
+// Pure struct usage:
+//
+//	group := &schemaorg.ProductGroup{
+//		Name:           "Wool winter coat",
+//		ProductGroupID: "44E01",
+//		Brand:          &schemaorg.Brand{Name: "Good brand"},
+//		VariesBy:       []string{"https://schema.org/size", "https://schema.org/color"},
+//		HasVariant: []*schemaorg.Product{
+//			{
+//				Name:   "Small green coat",
+//				SKU:    "44E01-M11000",
+//				GTIN:   "4067896011002",
+//				Image:  schemaorg.NewImages("https://www.example.com/coat_small_green.jpg"),
+//				Size:   "small",
+//				Color:  "Green",
+//				Offers: schemaorg.NewOffers(schemaorg.NewOffer("https://www.example.com/coat?size=small&color=green", "39.99", "USD", schemaorg.InStock, schemaorg.NewCondition)),
+//			},
+//		},
+//	}
+//
+// Factory method usage:
+//
+//	group := schemaorg.NewProductGroup(
+//		"Wool winter coat",
+//		"44E01",
+//		&schemaorg.Brand{Name: "Good brand"},
+//		[]string{"https://schema.org/size", "https://schema.org/color"},
+//		variants,
+//	)
+//
+// // Rendering JSON-LD using templ:
+//
+//	templ Page() {
+//		@group.ToJsonLd()
+//	}
+//
+// // Rendering JSON-LD as `template.HTML` value:
+//
+//	jsonLdHtml := group.ToGoHTMLJsonLd()
+//
+// Expected output:
+//
+//	{
+//		"@context": "https://schema.org",
+//		"@type": "ProductGroup",
+//		"name": "Wool winter coat",
+//		"productGroupID": "44E01",
+//		"brand": {"@type": "Brand", "name": "Good brand"},
+//		"variesBy": ["https://schema.org/size", "https://schema.org/color"],
+//		"hasVariant": [
+//			{
+//				"@context": "https://schema.org",
+//				"@type": "Product",
+//				"name": "Small green coat",
+//				...
+//			}
+//		]
+//	}
+type ProductGroup struct {
+	Context         string           `json:"@context"`
+	Type            string           `json:"@type"`
+	Name            string           `json:"name,omitempty"`
+	Description     string           `json:"description,omitempty"`
+	URL             string           `json:"url,omitempty"`
+	ProductGroupID  string           `json:"productGroupID,omitempty"`
+	Brand           *Brand           `json:"brand,omitempty"`
+	VariesBy        StringList       `json:"variesBy,omitempty"`
+	HasVariant      []*Product       `json:"hasVariant,omitempty"`
+	AggregateRating *AggregateRating `json:"aggregateRating,omitempty"`
+	Review          []*Review        `json:"review,omitempty"`
+	Extra           Extra            `json:"-"`
+}
+
+// NewProductGroup initializes a ProductGroup with default context and type.
+func NewProductGroup(name, productGroupID string, brand *Brand, variesBy []string, variants []*Product) *ProductGroup {
+	group := &ProductGroup{
+		Name:           name,
+		ProductGroupID: productGroupID,
+		Brand:          brand,
+		VariesBy:       variesBy,
+		HasVariant:     variants,
+	}
+	group.ensureDefaults()
+	return group
+}
+
+// Validate checks if the ProductGroup and each of its variants satisfy the
+// Google merchant listing requirements for product variants.
+func (pg *ProductGroup) Validate() []string {
+	return issueMessages(pg.ValidationIssues())
+}
+
+// ValidationIssues returns the issues found by Validate on the ProductGroup as structured values.
+func (pg *ProductGroup) ValidationIssues() []teseo.ValidationIssue {
+	var found issues
+
+	if pg.Name == "" {
+		found.required("name")
+	}
+	if pg.ProductGroupID == "" {
+		found.recommended("productGroupID")
+	}
+	if pg.VariesBy.IsZero() {
+		found.recommended("variesBy")
+	}
+	if len(pg.HasVariant) == 0 {
+		found.required("hasVariant")
+	}
+
+	for i, variant := range pg.HasVariant {
+		found.nested(fmt.Sprintf("hasVariant[%d]", i), variant.merchantListingIssues())
+		if pg.ProductGroupID != "" && variant.InProductGroupWithID != "" && variant.InProductGroupWithID != pg.ProductGroupID {
+			found.warnf(teseo.RuleConstraint, fmt.Sprintf("hasVariant[%d].inProductGroupWithID", i), "hasVariant[%d]: inProductGroupWithID %q does not match productGroupID %q", i, variant.InProductGroupWithID, pg.ProductGroupID)
+		}
+	}
+
+	found.add(validateFormats(pg)...)
+	return found
+}
+
+// ToJsonLd converts the ProductGroup struct to a JSON-LD `templ.Component`.
+func (pg *ProductGroup) ToJsonLd() templ.Component {
+	pg.ensureDefaults()
+	id := fmt.Sprintf("%s-%s", "productGroup", teseo.GenerateUniqueKey())
+	return templ.JSONScript(id, pg).WithType("application/ld+json")
+}
+
+// ToGoHTMLJsonLd renders the ProductGroup struct as `template.HTML` value for Go's `html/template`.
+func (pg *ProductGroup) ToGoHTMLJsonLd() (template.HTML, error) {
+	return teseo.RenderToHTML(pg.ToJsonLd())
+}
+
+// MarshalJSON encodes a ProductGroup, merging the Extra properties into the JSON-LD object.
+func (pg ProductGroup) MarshalJSON() ([]byte, error) {
+	type alias ProductGroup
+	return marshalWithExtra(alias(pg), pg.Extra)
+}
+
+// UnmarshalJSON decodes a ProductGroup, capturing properties without a dedicated field into Extra.
+func (pg *ProductGroup) UnmarshalJSON(data []byte) error {
+	type alias ProductGroup
+	if err := json.Unmarshal(data, (*alias)(pg)); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, (*alias)(pg))
+	if err != nil {
+		return err
+	}
+	pg.Extra = extra
+	return nil
+}
+
+// ensureDefaults sets default values for ProductGroup and its nested objects if they are not already set.
+func (pg *ProductGroup) ensureDefaults() {
+	if pg.Context == "" {
+		pg.Context = "https://schema.org"
+	}
+
+	if pg.Type == "" {
+		pg.Type = "ProductGroup"
+	}
+
+	if pg.Brand != nil {
+		pg.Brand.ensureDefaults()
+	}
+
+	for _, variant := range pg.HasVariant {
+		// Variants referencing back to this group would otherwise recurse forever.
+		if variant.IsVariantOf == pg {
+			variant.IsVariantOf = nil
+		}
+		variant.ensureDefaults()
+	}
+
+	if pg.AggregateRating != nil {
+		pg.AggregateRating.ensureDefaults()
+	}
+
+	for _, review := range pg.Review {
+		review.ensureDefaults()
+	}
+}
@@ -0,0 +1,113 @@
+package schemaorg
+
+import (
+	"strings"
+	"testing"
+)
+
+func merchantVariant(sku, size string) *Product {
+	return &Product{
+		Name:                 "Coat " + size,
+		SKU:                  sku,
+		GTIN:                 "4067896011002",
+		Image:                NewImages("https://example.com/coat.jpg"),
+		Description:          "A warm coat",
+		Brand:                &Brand{Name: "Good brand"},
+		Size:                 size,
+		InProductGroupWithID: "44E01",
+		Offers:               Offers{merchantOffer()},
+	}
+}
+
+func TestNewProductGroup_SetsDefaults(t *testing.T) {
+	variant := merchantVariant("44E01-S", "small")
+	group := NewProductGroup("Coat", "44E01", &Brand{Name: "Good brand"}, []string{"https://schema.org/size"}, []*Product{variant})
+
+	if group.Context != "https://schema.org" || group.Type != "ProductGroup" {
+		t.Errorf("unexpected defaults: %q %q", group.Context, group.Type)
+	}
+	if group.Brand.Type != "Brand" {
+		t.Errorf("expected brand type Brand, got %s", group.Brand.Type)
+	}
+	if variant.Type != "Product" || variant.Offers[0].Type != "Offer" {
+		t.Errorf("expected variant defaults, got %q %q", variant.Type, variant.Offers[0].Type)
+	}
+}
+
+func TestProductGroup_EnsureDefaults_BreaksCycle(t *testing.T) {
+	group := &ProductGroup{Name: "Coat"}
+	variant := &Product{Name: "Coat S", IsVariantOf: group}
+	group.HasVariant = []*Product{variant}
+
+	group.ensureDefaults()
+
+	if variant.IsVariantOf != nil {
+		t.Errorf("expected back-reference to the group to be cleared")
+	}
+}
+
+func TestProductGroup_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		group    *ProductGroup
+		expected []string
+	}{
+		{
+			name: "all good",
+			group: &ProductGroup{
+				Name:           "Coat",
+				ProductGroupID: "44E01",
+				VariesBy:       StringList{"https://schema.org/size"},
+				HasVariant:     []*Product{merchantVariant("44E01-S", "small"), merchantVariant("44E01-M", "medium")},
+			},
+			expected: nil,
+		},
+		{
+			name:  "missing fields",
+			group: &ProductGroup{},
+			expected: []string{
+				"missing required field: name",
+				"missing recommended field: productGroupID",
+				"missing recommended field: variesBy",
+				"missing required field: hasVariant",
+			},
+		},
+		{
+			name: "invalid variant",
+			group: &ProductGroup{
+				Name:           "Coat",
+				ProductGroupID: "OTHER",
+				VariesBy:       StringList{"https://schema.org/size"},
+				HasVariant:     []*Product{merchantVariant("44E01-S", "small"), {Name: "Coat M", Image: NewImages("https://www.example.com/x.jpg"), MPN: "M", Brand: &Brand{Name: "B"}, Description: "d", Offers: Offers{merchantOffer()}}},
+			},
+			expected: []string{
+				`hasVariant[0]: inProductGroupWithID "44E01" does not match productGroupID "OTHER"`,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.group.Validate()
+			if len(got) != len(tt.expected) {
+				t.Fatalf("expected %d warnings, got %d: %v", len(tt.expected), len(got), got)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] {
+					t.Errorf("warning %d: expected %q, got %q", i, tt.expected[i], got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestProductGroup_ToGoHTMLJsonLd(t *testing.T) {
+	group := NewProductGroup("Coat", "44E01", nil, []string{"https://schema.org/size"}, []*Product{merchantVariant("44E01-S", "small")})
+	html, err := group.ToGoHTMLJsonLd()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !strings.Contains(string(html), `"hasVariant":[`) {
+		t.Errorf("expected hasVariant in output, got %s", html)
+	}
+}
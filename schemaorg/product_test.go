@@ -1,6 +1,9 @@
 package schemaorg
 
 import (
+	"encoding/json"
+	"slices"
+	"strings"
 	"testing"
 )
 
@@ -16,7 +19,7 @@ func TestNewProduct_SetsFieldsAndDefaults(t *testing.T) {
 		[]string{"https://example.com/image.jpg"},
 		"SKU123",
 		brand,
-		offer,
+		Offers{offer},
 		"Electronics",
 		agg,
 		[]*Review{review},
@@ -31,7 +34,7 @@ func TestNewProduct_SetsFieldsAndDefaults(t *testing.T) {
 	if product.Brand == nil || product.Brand.Type != "Brand" {
 		t.Errorf("expected brand type Brand, got %v", product.Brand)
 	}
-	if product.Offers == nil || product.Offers.Type != "Offer" {
+	if len(product.Offers) != 1 || product.Offers[0].Type != "Offer" {
 		t.Errorf("expected offer type Offer, got %v", product.Offers)
 	}
 	if product.AggregateRating == nil || product.AggregateRating.Type != "AggregateRating" {
@@ -40,7 +43,7 @@ func TestNewProduct_SetsFieldsAndDefaults(t *testing.T) {
 	if len(product.Review) != 1 || product.Review[0].Type != "Review" {
 		t.Errorf("expected review type Review, got %v", product.Review)
 	}
-	if product.Review[0].Author == nil || product.Review[0].Author.Type != "Person" {
+	if author, ok := product.Review[0].Author.(*Person); !ok || author.Type != "Person" {
 		t.Errorf("expected author type Person, got %v", product.Review[0].Author)
 	}
 	if product.Review[0].ReviewRating == nil || product.Review[0].ReviewRating.Type != "Rating" {
@@ -94,8 +97,8 @@ func TestReview_EnsureDefaults_WithNested(t *testing.T) {
 	if r.Type != "Review" {
 		t.Errorf("expected Review type, got %s", r.Type)
 	}
-	if r.Author.Type != "Person" {
-		t.Errorf("expected Author type Person, got %s", r.Author.Type)
+	if r.Author.(*Person).Type != "Person" {
+		t.Errorf("expected Author type Person, got %s", r.Author.(*Person).Type)
 	}
 	if r.ReviewRating.Type != "Rating" {
 		t.Errorf("expected Rating type Rating, got %s", r.ReviewRating.Type)
@@ -117,7 +120,7 @@ func TestProduct_ToGoHTMLJsonLd(t *testing.T) {
 		[]string{"https://example.com/image.jpg"},
 		"SKU123",
 		&Brand{Name: "TestBrand"},
-		&Offer{Price: "99.99", PriceCurrency: "USD"},
+		Offers{{Price: "99.99", PriceCurrency: "USD"}},
 		"Electronics",
 		nil,
 		nil,
@@ -131,3 +134,151 @@ func TestProduct_ToGoHTMLJsonLd(t *testing.T) {
 		t.Error("expected non-empty HTML output")
 	}
 }
+
+func TestProduct_MarshalJSON_AggregateOffer(t *testing.T) {
+	product := &Product{
+		Name:           "Example",
+		AggregateOffer: NewAggregateOffer("10.00", "20.00", "USD", 3),
+	}
+	product.ensureDefaults()
+
+	data, err := json.Marshal(product)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := `"offers":{"@type":"AggregateOffer","lowPrice":"10.00","highPrice":"20.00","priceCurrency":"USD","offerCount":3}`
+	if !strings.Contains(string(data), want) {
+		t.Errorf("expected %s in %s", want, data)
+	}
+}
+
+func TestProduct_UnmarshalJSON_Offers(t *testing.T) {
+	tests := []struct {
+		name      string
+		input     string
+		wantOffer bool
+		wantAgg   bool
+	}{
+		{"offer", `{"@type":"Product","offers":{"@type":"Offer","price":"9.99"}}`, true, false},
+		{"aggregate offer", `{"@type":"Product","offers":{"@type":"AggregateOffer","lowPrice":"1"}}`, false, true},
+		{"offer array", `{"@type":"Product","offers":[{"@type":"Offer","price":"9.99"},{"@type":"Offer","price":"12"}]}`, true, false},
+		{"offers and aggregate offer", `{"@type":"Product","offers":[{"@type":"Offer","price":"9.99"},{"@type":"AggregateOffer","lowPrice":"1"}]}`, true, true},
+		{"no offers", `{"@type":"Product","name":"X"}`, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var p Product
+			if err := json.Unmarshal([]byte(tt.input), &p); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if (p.Offers != nil) != tt.wantOffer || (p.AggregateOffer != nil) != tt.wantAgg {
+				t.Errorf("unexpected offers: %+v / %+v", p.Offers, p.AggregateOffer)
+			}
+		})
+	}
+}
+
+func TestProduct_Offers_RoundTrip(t *testing.T) {
+	tests := []struct {
+		name    string
+		product Product
+		want    string
+	}{
+		{"single offer", Product{Offers: Offers{{Type: "Offer", Price: "9.99"}}}, `"offers":{"@type":"Offer","price":"9.99"}`},
+		{"several offers", Product{Offers: Offers{{Type: "Offer", Price: "9.99"}, {Type: "Offer", Price: "12"}}}, `"offers":[{"@type":"Offer","price":"9.99"},{"@type":"Offer","price":"12"}]`},
+		{"offers and aggregate offer", Product{Offers: Offers{{Type: "Offer", Price: "9.99"}}, AggregateOffer: &AggregateOffer{Type: "AggregateOffer", LowPrice: "1"}}, `"offers":[{"@type":"Offer","price":"9.99"},{"@type":"AggregateOffer","lowPrice":"1"}]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.product)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !strings.Contains(string(data), tt.want) {
+				t.Fatalf("expected %s in %s", tt.want, data)
+			}
+			var decoded Product
+			if err := json.Unmarshal(data, &decoded); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			again, err := json.Marshal(decoded)
+			if err != nil || string(again) != string(data) {
+				t.Errorf("expected %s after a round trip, got %s (err: %v)", data, again, err)
+			}
+		})
+	}
+}
+
+func TestProduct_Validate_OffersAndAggregateOffer(t *testing.T) {
+	p := &Product{Name: "X", Offers: Offers{{Price: "9.99"}}, AggregateOffer: &AggregateOffer{LowPrice: "1"}}
+	expected := "offers: both Offers and AggregateOffer are set, AggregateOffer should summarise the offers on its own"
+	if got := p.Validate(); !slices.Contains(got, expected) {
+		t.Errorf("expected %q, got %v", expected, got)
+	}
+}
+
+func TestProduct_Validate(t *testing.T) {
+	p := &Product{}
+	got := p.Validate()
+	expected := []string{
+		"missing required field: name",
+		"missing required field: one of offers, review or aggregateRating",
+		"missing recommended field: image",
+		"missing recommended field: brand.name",
+	}
+	if !slices.Equal(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	p = &Product{Name: "X", Image: NewImages("https://www.example.com/x.jpg"), Brand: &Brand{Name: "B"}, AggregateRating: &AggregateRating{RatingValue: 4}}
+	if got := p.Validate(); len(got) != 0 {
+		t.Errorf("expected no warnings, got %v", got)
+	}
+}
+
+func TestProduct_ValidateMerchantListing(t *testing.T) {
+	p := &Product{}
+	got := p.ValidateMerchantListing()
+	expected := []string{
+		"missing required field: name",
+		"missing required field: image",
+		"missing required field: offers",
+		"missing recommended field: gtin or mpn",
+		"missing recommended field: brand.name",
+		"missing recommended field: description",
+	}
+	if !slices.Equal(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	p = &Product{
+		Name:           "X",
+		Image:          NewImages("https://www.example.com/x.jpg"),
+		GTIN:           "123",
+		Brand:          &Brand{Name: "B"},
+		Description:    "d",
+		AggregateOffer: &AggregateOffer{Offers: []*Offer{merchantOffer()}},
+	}
+	got = p.ValidateMerchantListing()
+	expected = []string{
+		"missing required field: offers.price (merchant listings need an Offer, AggregateOffer is only supported by product snippets)",
+	}
+	if !slices.Equal(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	p.AggregateOffer = nil
+	p.Offers = Offers{merchantOffer(), {Price: "5", Availability: InStock}}
+	got = p.ValidateMerchantListing()
+	expected = []string{
+		"missing required field: offers[1].priceCurrency",
+		"missing recommended field: offers[1].itemCondition",
+		"missing recommended field: offers[1].shippingDetails",
+		"missing recommended field: offers[1].hasMerchantReturnPolicy",
+	}
+	if !slices.Equal(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
@@ -0,0 +1,280 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+
+	"github.com/a-h/templ"
+	"github.com/indaco/teseo"
+	"github.com/indaco/teseo/opengraph"
+)
+
+// ProfilePage represents a Schema.org ProfilePage object, a page about a single
+// Person or Organization such as an author page or a user profile on a forum.
+// For more details about the meaning of the properties see: https://schema.org/ProfilePage
+//
+// Example usage:
+//
+// Pure struct usage:
+//
+//	profilePage := &schemaorg.ProfilePage{
+//		DateCreated:  "2024-12-23T12:34:00-05:00",
+//		DateModified: "2024-12-26T14:53:00-05:00",
+//		MainEntity: &schemaorg.Person{
+//			Name:          "Angelo Huff",
+//			AlternateName: "ahuff23",
+//			Description:   "Defender of Truth",
+//			Image:         &schemaorg.ImageObject{URL: "https://www.example.com/avatars/ahuff23.jpg"},
+//			InteractionStatistic: []*schemaorg.InteractionCounter{
+//				schemaorg.NewInteractionCounter(schemaorg.FollowAction, 1),
+//			},
+//			AgentInteractionStatistic: []*schemaorg.InteractionCounter{
+//				schemaorg.NewInteractionCounter(schemaorg.WriteAction, 8),
+//			},
+//		},
+//	}
+//
+// Factory method usage:
+//
+//	profilePage := schemaorg.NewProfilePage(person, "2024-12-23T12:34:00-05:00", "2024-12-26T14:53:00-05:00")
+//
+// // Rendering JSON-LD using templ:
+//
+//	templ Page() {
+//		@profilePage.ToJsonLd()
+//	}
+//
+// // Rendering JSON-LD as `template.HTML` value:
+//
+//	jsonLdHtml := profilePage.ToGoHTMLJsonLd()
+//
+// Expected output:
+//
+//	{
+//		"@context": "https://schema.org",
+//		"@type": "ProfilePage",
+//		"dateCreated": "2024-12-23T12:34:00-05:00",
+//		"dateModified": "2024-12-26T14:53:00-05:00",
+//		"mainEntity": {
+//			"@context": "https://schema.org",
+//			"@type": "Person",
+//			"name": "Angelo Huff",
+//			"alternateName": "ahuff23",
+//			"description": "Defender of Truth",
+//			"image": {"@type": "ImageObject", "url": "https://www.example.com/avatars/ahuff23.jpg"},
+//			"interactionStatistic": [
+//				{"@type": "InteractionCounter", "interactionType": "https://schema.org/FollowAction", "userInteractionCount": 1}
+//			],
+//			"agentInteractionStatistic": [
+//				{"@type": "InteractionCounter", "interactionType": "https://schema.org/WriteAction", "userInteractionCount": 8}
+//			]
+//		}
+//	}
+type ProfilePage struct {
+	Context      string         `json:"@context"`
+	Type         string         `json:"@type"`
+	ID           string         `json:"@id,omitempty"`
+	URL          string         `json:"url,omitempty"`
+	DateCreated  teseo.DateTime `json:"dateCreated,omitempty"`
+	DateModified teseo.DateTime `json:"dateModified,omitempty"`
+	MainEntity   Agent          `json:"mainEntity,omitempty"`
+	Extra        Extra          `json:"-"`
+}
+
+// NewProfilePage initializes a ProfilePage with default context and type.
+func NewProfilePage(mainEntity Agent, dateCreated, dateModified string) *ProfilePage {
+	profilePage := &ProfilePage{
+		MainEntity:   mainEntity,
+		DateCreated:  teseo.DateTime(dateCreated),
+		DateModified: teseo.DateTime(dateModified),
+	}
+	profilePage.ensureDefaults()
+	return profilePage
+}
+
+// Validate checks the ProfilePage against the requirements for profile page rich results.
+func (pp *ProfilePage) Validate() []string {
+	return issueMessages(pp.ValidationIssues())
+}
+
+// ValidationIssues returns the issues found by Validate on the ProfilePage as structured values.
+func (pp *ProfilePage) ValidationIssues() []teseo.ValidationIssue {
+	var found issues
+
+	switch nodes := agentList(pp.MainEntity); {
+	case len(nodes) == 0:
+		found.required("mainEntity")
+	case len(nodes) > 1:
+		found.warnf(teseo.RuleConstraint, "mainEntity", "mainEntity must be a single Person or Organization")
+	}
+	found.add(validateAgent("mainEntity", teseo.SeverityRequired, pp.MainEntity)...)
+	for _, agent := range agentList(pp.MainEntity) {
+		switch me := agent.(type) {
+		case *Person:
+			found.add(validateInteractionStatistics("mainEntity", me.InteractionStatistic, me.AgentInteractionStatistic)...)
+		case *Organization:
+			found.add(validateInteractionStatistics("mainEntity", me.InteractionStatistic, me.AgentInteractionStatistic)...)
+		}
+	}
+
+	if pp.DateCreated == "" {
+		found.recommended("dateCreated")
+	}
+	if pp.DateModified == "" {
+		found.recommended("dateModified")
+	}
+	found.add(validateDateTime("dateCreated", pp.DateCreated)...)
+	found.add(validateDateTime("dateModified", pp.DateModified)...)
+	found.add(validateTimeOrder("dateCreated", pp.DateCreated, "dateModified", pp.DateModified)...)
+
+	found.add(validateFormats(pp)...)
+	return found
+}
+
+// ToJsonLd converts the ProfilePage struct to a JSON-LD `templ.Component`.
+func (pp *ProfilePage) ToJsonLd() templ.Component {
+	pp.ensureDefaults()
+	id := fmt.Sprintf("%s-%s", "profilepage", teseo.GenerateUniqueKey())
+	return templ.JSONScript(id, pp).WithType("application/ld+json")
+}
+
+// ToGoHTMLJsonLd renders the ProfilePage struct as `template.HTML` value for Go's `html/template`.
+func (pp *ProfilePage) ToGoHTMLJsonLd() (template.HTML, error) {
+	return teseo.RenderToHTML(pp.ToJsonLd())
+}
+
+// MarshalJSON encodes a ProfilePage, merging the Extra properties into the JSON-LD object.
+func (pp ProfilePage) MarshalJSON() ([]byte, error) {
+	type alias ProfilePage
+	return marshalWithExtra(alias(pp), pp.Extra)
+}
+
+// UnmarshalJSON decodes a ProfilePage, resolving `mainEntity` to a Person, Organization or @id reference node based on its `@type`.
+func (pp *ProfilePage) UnmarshalJSON(data []byte) error {
+	type alias ProfilePage
+	aux := struct {
+		*alias
+		MainEntity json.RawMessage `json:"mainEntity,omitempty"`
+	}{alias: (*alias)(pp)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, aux)
+	if err != nil {
+		return err
+	}
+	pp.Extra = extra
+
+	mainEntity, err := unmarshalAgent(aux.MainEntity)
+	if err != nil {
+		return fmt.Errorf("ProfilePage: invalid mainEntity: %w", err)
+	}
+	pp.MainEntity = mainEntity
+
+	return nil
+}
+
+// ensureDefaults sets default values for ProfilePage and its main entity if they are not already set.
+func (pp *ProfilePage) ensureDefaults() {
+	if pp.Context == "" {
+		pp.Context = "https://schema.org"
+	}
+
+	if pp.Type == "" {
+		pp.Type = "ProfilePage"
+	}
+
+	ensureAgentDefaults(pp.MainEntity)
+}
+
+// AuthorRecord describes an author once, so that NewAuthorProfile can build
+// the structured data of their profile page from it. Counts left to zero are omitted.
+type AuthorRecord struct {
+	ProfileURL   string     // URL of the author page
+	Name         string     // full name, rendered as name and og:title
+	GivenName    string     // rendered as givenName and profile:first_name
+	FamilyName   string     // rendered as familyName and profile:last_name
+	Username     string     // rendered as alternateName and profile:username
+	Gender       GenderType // rendered as gender and profile:gender
+	Description  string     // short biography
+	Image        string     // URL of the avatar or photo
+	JobTitle     string     // job title of the author
+	SameAs       []string   // URLs of the author on other sites
+	DateCreated  string     // creation date of the profile, ISO 8601
+	DateModified string     // last modification date of the profile, ISO 8601
+	Followers    int        // number of users following the author
+	Following    int        // number of users the author follows
+	Posts        int        // number of posts written by the author
+}
+
+// NewAuthorProfile builds, from a single author record, the ProfilePage JSON-LD
+// of the author page, the Person used as its main entity (and as the author of
+// articles, posts or reviews) and the Open Graph profile meta tags.
+//
+// Example usage:
+//
+//	page, person, og := schemaorg.NewAuthorProfile(schemaorg.AuthorRecord{
+//		ProfileURL:  "https://www.example.com/authors/jane",
+//		Name:        "Jane Doe",
+//		GivenName:   "Jane",
+//		FamilyName:  "Doe",
+//		Username:    "janedoe",
+//		Image:       "https://www.example.com/authors/jane.jpg",
+//		DateCreated: "2024-01-10T09:00:00Z",
+//		Followers:   1200,
+//		Posts:       87,
+//	})
+//
+//	templ AuthorPage() {
+//		@page.ToJsonLd()
+//		@og.ToMetaTags()
+//	}
+//
+//	article.Author = person
+func NewAuthorProfile(author AuthorRecord) (*ProfilePage, *Person, *opengraph.Profile) {
+	person := &Person{
+		Name:          author.Name,
+		AlternateName: author.Username,
+		GivenName:     author.GivenName,
+		FamilyName:    author.FamilyName,
+		Description:   author.Description,
+		URL:           author.ProfileURL,
+		JobTitle:      author.JobTitle,
+		SameAs:        author.SameAs,
+		Gender:        author.Gender,
+	}
+	if author.Image != "" {
+		person.Image = &ImageObject{URL: author.Image}
+	}
+	if author.Followers > 0 {
+		person.InteractionStatistic = append(person.InteractionStatistic, NewInteractionCounter(FollowAction, author.Followers))
+	}
+	if author.Following > 0 {
+		person.AgentInteractionStatistic = append(person.AgentInteractionStatistic, NewInteractionCounter(FollowAction, author.Following))
+	}
+	if author.Posts > 0 {
+		person.AgentInteractionStatistic = append(person.AgentInteractionStatistic, NewInteractionCounter(WriteAction, author.Posts))
+	}
+	person.ensureDefaults()
+
+	page := NewProfilePage(person, author.DateCreated, author.DateModified)
+	page.URL = author.ProfileURL
+
+	og := opengraph.NewProfile(author.Name, author.GivenName, author.FamilyName, author.Username, ogGender(author.Gender), author.ProfileURL, author.Description, author.Image)
+
+	return page, person, og
+}
+
+// ogGender converts a GenderType to the values expected by profile:gender,
+// which only accepts male and female.
+func ogGender(g GenderType) string {
+	switch g.canonical() {
+	case GenderMale:
+		return "male"
+	case GenderFemale:
+		return "female"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,182 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"html/template"
+	"reflect"
+	"testing"
+)
+
+func TestNewProfilePage_SetsFieldsAndDefaults(t *testing.T) {
+	person := &Person{Name: "Angelo Huff", InteractionStatistic: []*InteractionCounter{{InteractionType: FollowAction, UserInteractionCount: 1}}}
+	pp := NewProfilePage(person, "2024-12-23T12:34:00-05:00", "2024-12-26T14:53:00-05:00")
+
+	if pp.Context != "https://schema.org" || pp.Type != "ProfilePage" {
+		t.Errorf("unexpected defaults: %q %q", pp.Context, pp.Type)
+	}
+	if person.Type != "Person" || person.InteractionStatistic[0].Type != "InteractionCounter" {
+		t.Errorf("expected main entity defaults, got %#v", person)
+	}
+}
+
+func TestProfilePage_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		pp       *ProfilePage
+		expected []string
+	}{
+		{
+			name: "valid person",
+			pp: &ProfilePage{
+				DateCreated:  "2024-12-23T12:34:00-05:00",
+				DateModified: "2024-12-26T14:53:00-05:00",
+				MainEntity: &Person{
+					Name:                      "Angelo Huff",
+					AgentInteractionStatistic: []*InteractionCounter{{InteractionType: WriteAction, UserInteractionCount: 8}},
+				},
+			},
+		},
+		{
+			name: "valid organization",
+			pp: &ProfilePage{
+				DateCreated:  "2024-12-23",
+				DateModified: "2024-12-26",
+				MainEntity:   &Organization{Name: "Example Corp"},
+			},
+		},
+		{
+			name: "missing main entity",
+			pp:   &ProfilePage{},
+			expected: []string{
+				"missing required field: mainEntity",
+				"missing recommended field: dateCreated",
+				"missing recommended field: dateModified",
+			},
+		},
+		{
+			name: "several main entities",
+			pp: &ProfilePage{
+				DateCreated:  "2024-12-23",
+				DateModified: "2024-12-26",
+				MainEntity:   NewAgents(&Person{Name: "Jane"}, &Person{}),
+			},
+			expected: []string{
+				"mainEntity must be a single Person or Organization",
+				"missing required field: mainEntity[1].name",
+			},
+		},
+		{
+			name: "invalid counters and dates",
+			pp: &ProfilePage{
+				DateCreated:  "2024-12-26",
+				DateModified: "2024-12-23",
+				MainEntity: &Person{
+					Name:                 "Angelo Huff",
+					InteractionStatistic: []*InteractionCounter{{InteractionType: "ClapAction", UserInteractionCount: -1}},
+				},
+			},
+			expected: []string{
+				`unknown mainEntity.interactionStatistic[0].interactionType value "ClapAction"`,
+				"mainEntity.interactionStatistic[0].userInteractionCount must not be negative, got -1",
+				`dateModified "2024-12-23" is before dateCreated "2024-12-26"`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if w := tt.pp.Validate(); !reflect.DeepEqual(w, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, w)
+			}
+		})
+	}
+}
+
+func TestProfilePage_UnmarshalJSON(t *testing.T) {
+	data := `{
+		"@type": "ProfilePage",
+		"mainEntity": {
+			"@type": "Person",
+			"name": "Angelo Huff",
+			"alternateName": "ahuff23",
+			"interactionStatistic": {"@type": "InteractionCounter", "interactionType": "https://schema.org/FollowAction", "userInteractionCount": 1},
+			"agentInteractionStatistic": [{"@type": "InteractionCounter", "interactionType": "WriteAction", "userInteractionCount": 8}],
+			"worksFor": {"@type": "Organization", "name": "Example", "agentInteractionStatistic": {"@type": "InteractionCounter", "interactionType": "WriteAction", "userInteractionCount": 3}}
+		}
+	}`
+	var pp ProfilePage
+	if err := json.Unmarshal([]byte(data), &pp); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	person, ok := pp.MainEntity.(*Person)
+	if !ok {
+		t.Fatalf("expected *Person main entity, got %T", pp.MainEntity)
+	}
+	if person.AlternateName != "ahuff23" || len(person.AgentInteractionStatistic) != 1 || person.AgentInteractionStatistic[0].InteractionType != WriteAction {
+		t.Errorf("unexpected main entity %#v", person)
+	}
+	if len(person.InteractionStatistic) != 1 || person.InteractionStatistic[0].InteractionType != FollowAction {
+		t.Errorf("expected a single interaction counter, got %#v", person.InteractionStatistic)
+	}
+	if len(person.WorksFor.AgentInteractionStatistic) != 1 || person.WorksFor.AgentInteractionStatistic[0].UserInteractionCount != 3 {
+		t.Errorf("expected the organization interaction counter, got %#v", person.WorksFor.AgentInteractionStatistic)
+	}
+}
+
+func TestProfilePage_ToGoHTMLJsonLd(t *testing.T) {
+	pp := NewProfilePage(&Person{Name: "Angelo Huff"}, "2024-12-23", "2024-12-26")
+	html, err := pp.ToGoHTMLJsonLd()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if html == template.HTML("") {
+		t.Errorf("expected non-empty HTML")
+	}
+}
+
+func TestNewAuthorProfile(t *testing.T) {
+	page, person, og := NewAuthorProfile(AuthorRecord{
+		ProfileURL:   "https://www.example.com/authors/jane",
+		Name:         "Jane Doe",
+		GivenName:    "Jane",
+		FamilyName:   "Doe",
+		Username:     "janedoe",
+		Gender:       GenderFemale,
+		Description:  "Food writer",
+		Image:        "https://www.example.com/authors/jane.jpg",
+		SameAs:       []string{"https://social.example.com/janedoe"},
+		DateCreated:  "2024-01-10T09:00:00Z",
+		DateModified: "2024-06-01T09:00:00Z",
+		Followers:    1200,
+		Following:    15,
+		Posts:        87,
+	})
+
+	if page.MainEntity != Agent(person) || page.URL != "https://www.example.com/authors/jane" || page.DateCreated != "2024-01-10T09:00:00Z" {
+		t.Errorf("unexpected profile page %#v", page)
+	}
+	if w := page.Validate(); len(w) != 0 {
+		t.Errorf("expected no warnings, got %v", w)
+	}
+
+	if person.Type != "Person" || person.AlternateName != "janedoe" || person.Image == nil || person.Image.URL != "https://www.example.com/authors/jane.jpg" {
+		t.Errorf("unexpected person %#v", person)
+	}
+	expectedStats := InteractionCounters{{Type: "InteractionCounter", InteractionType: FollowAction, UserInteractionCount: 1200}}
+	if !reflect.DeepEqual(person.InteractionStatistic, expectedStats) {
+		t.Errorf("unexpected interactionStatistic %v", person.InteractionStatistic)
+	}
+	expectedAgentStats := InteractionCounters{
+		{Type: "InteractionCounter", InteractionType: FollowAction, UserInteractionCount: 15},
+		{Type: "InteractionCounter", InteractionType: WriteAction, UserInteractionCount: 87},
+	}
+	if !reflect.DeepEqual(person.AgentInteractionStatistic, expectedAgentStats) {
+		t.Errorf("unexpected agentInteractionStatistic %v", person.AgentInteractionStatistic)
+	}
+
+	if og.Title != "Jane Doe" || og.FirstName != "Jane" || og.LastName != "Doe" || og.Username != "janedoe" || og.Gender != "female" {
+		t.Errorf("unexpected Open Graph profile %#v", og)
+	}
+	if og.URL != page.URL || og.Image != "https://www.example.com/authors/jane.jpg" || og.Description != "Food writer" {
+		t.Errorf("unexpected Open Graph profile %#v", og)
+	}
+}
@@ -0,0 +1,223 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+
+	"github.com/a-h/templ"
+	"github.com/indaco/teseo"
+)
+
+// QAPage represents a Schema.org QAPage object, a page centered on a single
+// question and its answers, as found on community forums and support sites.
+// For FAQs written by the site itself, use FAQPage instead.
+// For more details about the meaning of the properties see: https://schema.org/QAPage
+//
+// Example usage:
+//
+// Pure struct usage:
+//
+//	qaPage := &schemaorg.QAPage{
+//		MainEntity: &schemaorg.Question{
+//			Name:          "How many ounces are in a pound?",
+//			Text:          "I have taken up a new interest in baking and keep running across directions in ounces and pounds.",
+//			AnswerCount:   2,
+//			UpvoteCount:   26,
+//			DatePublished: "2024-11-02T21:11:00Z",
+//			Author:        &schemaorg.Person{Name: "Jane Doe", URL: "https://www.example.com/profile/janedoe"},
+//			AcceptedAnswer: &schemaorg.Answer{
+//				Text:          "1 pound (lb) is equal to 16 ounces (oz).",
+//				URL:           "https://www.example.com/question1#acceptedAnswer",
+//				UpvoteCount:   1337,
+//				DatePublished: "2024-11-02T21:11:00Z",
+//				Author:        &schemaorg.Person{Name: "John Doe"},
+//			},
+//			SuggestedAnswer: []*schemaorg.Answer{
+//				{Text: "Are you looking for ounces or fluid ounces?", URL: "https://www.example.com/question1#suggestedAnswer1", UpvoteCount: 42},
+//			},
+//		},
+//	}
+//
+// Factory method usage:
+//
+//	qaPage := schemaorg.NewQAPage(question)
+//
+// // Rendering JSON-LD using templ:
+//
+//	templ Page() {
+//		@qaPage.ToJsonLd()
+//	}
+//
+// // Rendering JSON-LD as `template.HTML` value:
+//
+//	jsonLdHtml := qaPage.ToGoHTMLJsonLd()
+//
+// Expected output:
+//
+//	{
+//		"@context": "https://schema.org",
+//		"@type": "QAPage",
+//		"mainEntity": {
+//			"@type": "Question",
+//			"name": "How many ounces are in a pound?",
+//			"answerCount": 2,
+//			"acceptedAnswer": {"@type": "Answer", "text": "1 pound (lb) is equal to 16 ounces (oz)."},
+//			"suggestedAnswer": [{"@type": "Answer", "text": "Are you looking for ounces or fluid ounces?"}]
+//		}
+//	}
+type QAPage struct {
+	Context    string    `json:"@context"`
+	Type       string    `json:"@type"`
+	MainEntity *Question `json:"mainEntity,omitempty"`
+	Extra      Extra     `json:"-"`
+}
+
+// NewQAPage initializes a QAPage with default context and type.
+func NewQAPage(question *Question) *QAPage {
+	qaPage := &QAPage{
+		MainEntity: question,
+	}
+	qaPage.ensureDefaults()
+	return qaPage
+}
+
+// Validate checks the QAPage against the requirements for Q&A rich results.
+func (qp *QAPage) Validate() []string {
+	return issueMessages(qp.ValidationIssues())
+}
+
+// ValidationIssues returns the issues found by Validate on the QAPage as structured values.
+func (qp *QAPage) ValidationIssues() []teseo.ValidationIssue {
+	var found issues
+	if qp.MainEntity == nil {
+		found.required("mainEntity")
+		return found
+	}
+	found.add(qp.MainEntity.validateQA("mainEntity")...)
+	found.add(validateFormats(qp)...)
+	return found
+}
+
+// validateQA checks a user-submitted Question and its answers, prefixing the issue paths with the given path.
+func (q *Question) validateQA(prefix string) []teseo.ValidationIssue {
+	var found issues
+
+	if q.Name == "" {
+		found.required(prefix + ".name")
+	}
+	if q.Text == "" {
+		found.recommended(prefix + ".text")
+	}
+	if isNilAgent(q.Author) {
+		found.recommended(prefix + ".author")
+	} else {
+		found.add(validateAgent(prefix+".author", teseo.SeverityRecommended, q.Author)...)
+	}
+	if q.DatePublished == "" {
+		found.recommended(prefix + ".datePublished")
+	}
+	found.add(validateDateTime(prefix+".datePublished", q.DatePublished)...)
+	found.add(validateDateTime(prefix+".dateModified", q.DateModified)...)
+	found.add(validateDateTime(prefix+".dateCreated", q.DateCreated)...)
+	found.add(validateTimeOrder(prefix+".datePublished", q.DatePublished, prefix+".dateModified", q.DateModified)...)
+	if q.UpvoteCount < 0 {
+		found.warnf(teseo.RuleNegativeValue, prefix+".upvoteCount", "%s.upvoteCount must not be negative, got %d", prefix, q.UpvoteCount)
+	}
+
+	answers := len(q.SuggestedAnswer)
+	if q.AcceptedAnswer != nil {
+		answers++
+	}
+	switch {
+	case answers == 0:
+		found.missing(teseo.SeverityRequired, prefix+".acceptedAnswer", fmt.Sprintf("%s.acceptedAnswer or %s.suggestedAnswer", prefix, prefix))
+	case q.AnswerCount == 0:
+		found.required(prefix + ".answerCount")
+	case q.AnswerCount < answers:
+		found.warnf(teseo.RuleConstraint, prefix+".answerCount", "%s.answerCount %d is less than the %d answers listed", prefix, q.AnswerCount, answers)
+	}
+
+	if q.AcceptedAnswer != nil {
+		found.add(q.AcceptedAnswer.validate(prefix + ".acceptedAnswer")...)
+	}
+	for i, a := range q.SuggestedAnswer {
+		if a != nil {
+			found.add(a.validate(fmt.Sprintf("%s.suggestedAnswer[%d]", prefix, i))...)
+		}
+	}
+
+	return found
+}
+
+// validate checks the Answer fields used by Q&A rich results, prefixing the issue paths with the given path.
+func (a *Answer) validate(prefix string) []teseo.ValidationIssue {
+	var found issues
+
+	if a.Text == "" {
+		found.required(prefix + ".text")
+	}
+	if a.URL == "" {
+		found.recommended(prefix + ".url")
+	}
+	if isNilAgent(a.Author) {
+		found.recommended(prefix + ".author")
+	} else {
+		found.add(validateAgent(prefix+".author", teseo.SeverityRecommended, a.Author)...)
+	}
+	found.add(validateDateTime(prefix+".datePublished", a.DatePublished)...)
+	found.add(validateDateTime(prefix+".dateModified", a.DateModified)...)
+	found.add(validateDateTime(prefix+".dateCreated", a.DateCreated)...)
+	if a.UpvoteCount < 0 {
+		found.warnf(teseo.RuleNegativeValue, prefix+".upvoteCount", "%s.upvoteCount must not be negative, got %d", prefix, a.UpvoteCount)
+	}
+
+	return found
+}
+
+// ToJsonLd converts the QAPage struct to a JSON-LD `templ.Component`.
+func (qp *QAPage) ToJsonLd() templ.Component {
+	qp.ensureDefaults()
+	id := fmt.Sprintf("%s-%s", "qapage", teseo.GenerateUniqueKey())
+	return templ.JSONScript(id, qp).WithType("application/ld+json")
+}
+
+// ToGoHTMLJsonLd renders the QAPage struct as `template.HTML` value for Go's `html/template`.
+func (qp *QAPage) ToGoHTMLJsonLd() (template.HTML, error) {
+	return teseo.RenderToHTML(qp.ToJsonLd())
+}
+
+// MarshalJSON encodes a QAPage, merging the Extra properties into the JSON-LD object.
+func (qp QAPage) MarshalJSON() ([]byte, error) {
+	type alias QAPage
+	return marshalWithExtra(alias(qp), qp.Extra)
+}
+
+// UnmarshalJSON decodes a QAPage, capturing properties without a dedicated field into Extra.
+func (qp *QAPage) UnmarshalJSON(data []byte) error {
+	type alias QAPage
+	if err := json.Unmarshal(data, (*alias)(qp)); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, (*alias)(qp))
+	if err != nil {
+		return err
+	}
+	qp.Extra = extra
+	return nil
+}
+
+// ensureDefaults sets default values for QAPage and its question if they are not already set.
+func (qp *QAPage) ensureDefaults() {
+	if qp.Context == "" {
+		qp.Context = "https://schema.org"
+	}
+
+	if qp.Type == "" {
+		qp.Type = "QAPage"
+	}
+
+	if qp.MainEntity != nil {
+		qp.MainEntity.ensureDefaults()
+	}
+}
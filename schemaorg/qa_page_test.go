@@ -0,0 +1,179 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func newTestQuestion() *Question {
+	return &Question{
+		Name:          "How many ounces are in a pound?",
+		Text:          "I keep running across directions in ounces and pounds.",
+		AnswerCount:   2,
+		UpvoteCount:   26,
+		DatePublished: "2024-11-02T21:11:00Z",
+		Author:        &Person{Name: "Jane Doe"},
+		AcceptedAnswer: &Answer{
+			Text:          "1 pound (lb) is equal to 16 ounces (oz).",
+			URL:           "https://www.example.com/question1#acceptedAnswer",
+			UpvoteCount:   1337,
+			DatePublished: "2024-11-02T21:11:00Z",
+			Author:        &Person{Name: "John Doe"},
+		},
+		SuggestedAnswer: []*Answer{
+			{
+				Text:   "Are you looking for ounces or fluid ounces?",
+				URL:    "https://www.example.com/question1#suggestedAnswer1",
+				Author: &Person{Name: "Mary Doe"},
+			},
+		},
+	}
+}
+
+func TestNewQAPage_SetsDefaults(t *testing.T) {
+	qp := NewQAPage(newTestQuestion())
+
+	if qp.Context != "https://schema.org" || qp.Type != "QAPage" {
+		t.Errorf("expected default context and type, got %s %s", qp.Context, qp.Type)
+	}
+	if qp.MainEntity.Type != "Question" {
+		t.Errorf("expected Question type, got %s", qp.MainEntity.Type)
+	}
+	if qp.MainEntity.SuggestedAnswer[0].Type != "Answer" {
+		t.Errorf("expected suggested Answer type, got %s", qp.MainEntity.SuggestedAnswer[0].Type)
+	}
+	if qp.MainEntity.AcceptedAnswer.Author.(*Person).Type != "Person" {
+		t.Errorf("expected answer author type Person")
+	}
+}
+
+func TestQAPage_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		modify   func(q *Question)
+		expected []string
+	}{
+		{
+			name:   "valid page",
+			modify: func(q *Question) {},
+		},
+		{
+			name: "missing answers",
+			modify: func(q *Question) {
+				q.AcceptedAnswer = nil
+				q.SuggestedAnswer = nil
+			},
+			expected: []string{"missing required field: mainEntity.acceptedAnswer or mainEntity.suggestedAnswer"},
+		},
+		{
+			name:     "missing answer count",
+			modify:   func(q *Question) { q.AnswerCount = 0 },
+			expected: []string{"missing required field: mainEntity.answerCount"},
+		},
+		{
+			name:     "answer count lower than the answers listed",
+			modify:   func(q *Question) { q.AnswerCount = 1 },
+			expected: []string{"mainEntity.answerCount 1 is less than the 2 answers listed"},
+		},
+		{
+			name: "modified before published",
+			modify: func(q *Question) {
+				q.DateModified = "2024-11-01T21:11:00Z"
+				q.DateCreated = "2024-10-30"
+			},
+			expected: []string{`mainEntity.dateModified "2024-11-01T21:11:00Z" is before mainEntity.datePublished "2024-11-02T21:11:00Z"`},
+		},
+		{
+			name: "incomplete question and answer",
+			modify: func(q *Question) {
+				q.Name = ""
+				q.Author = nil
+				q.DatePublished = ""
+				q.SuggestedAnswer[0] = &Answer{UpvoteCount: -1, DateCreated: "yesterday"}
+			},
+			expected: []string{
+				"missing required field: mainEntity.name",
+				"missing recommended field: mainEntity.author",
+				"missing recommended field: mainEntity.datePublished",
+				"missing required field: mainEntity.suggestedAnswer[0].text",
+				"missing recommended field: mainEntity.suggestedAnswer[0].url",
+				"missing recommended field: mainEntity.suggestedAnswer[0].author",
+				`invalid ISO 8601 date-time for mainEntity.suggestedAnswer[0].dateCreated: "yesterday"`,
+				"mainEntity.suggestedAnswer[0].upvoteCount must not be negative, got -1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q := newTestQuestion()
+			tt.modify(q)
+			if got := NewQAPage(q).Validate(); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+
+	expected := []string{"missing required field: mainEntity"}
+	if got := (&QAPage{}).Validate(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestQAPage_UnmarshalJSON(t *testing.T) {
+	data, err := json.Marshal(NewQAPage(newTestQuestion()))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var decoded QAPage
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if author, ok := decoded.MainEntity.AcceptedAnswer.Author.(*Person); !ok || author.Name != "John Doe" {
+		t.Errorf("expected accepted answer author John Doe, got %#v", decoded.MainEntity.AcceptedAnswer.Author)
+	}
+	if len(decoded.MainEntity.SuggestedAnswer) != 1 || decoded.MainEntity.AnswerCount != 2 {
+		t.Errorf("expected one suggested answer out of two, got %#v", decoded.MainEntity)
+	}
+
+	data = []byte(`{
+		"@type": "QAPage",
+		"mainEntity": {
+			"@type": "Question",
+			"name": "How many ounces are in a pound?",
+			"answerCount": 1,
+			"datePublished": "2024-11-02T21:11:00Z",
+			"commentCount": 3,
+			"acceptedAnswer": {"@type": "Answer", "text": "16", "datePublished": "2024-11-02T22:00:00Z", "commentCount": 1}
+		}
+	}`)
+	decoded = QAPage{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	question := decoded.MainEntity
+	if question.DatePublished != "2024-11-02T21:11:00Z" || question.AcceptedAnswer.DatePublished != "2024-11-02T22:00:00Z" {
+		t.Errorf("expected the publication dates to be decoded, got %#v", question)
+	}
+	if question.Extra["commentCount"] != float64(3) || question.AcceptedAnswer.Extra["commentCount"] != float64(1) {
+		t.Errorf("expected commentCount to be kept in Extra, got %v and %v", question.Extra, question.AcceptedAnswer.Extra)
+	}
+	out, err := json.Marshal(question)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !strings.Contains(string(out), `"commentCount":3`) {
+		t.Errorf("expected commentCount to be rendered, got %s", out)
+	}
+}
+
+func TestQAPage_ToGoHTMLJsonLd(t *testing.T) {
+	html, err := NewQAPage(newTestQuestion()).ToGoHTMLJsonLd()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if html == "" {
+		t.Errorf("expected non-empty HTML")
+	}
+}
@@ -0,0 +1,226 @@
+package schemaorg
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html/template"
+	"strings"
+	"sync"
+
+	"github.com/a-h/templ"
+	"github.com/indaco/teseo"
+)
+
+// Thing is implemented by every top-level Schema.org entity of this package and
+// by the application types registered with Register.
+type Thing interface {
+	ToJsonLd() templ.Component
+	ToGoHTMLJsonLd() (template.HTML, error)
+}
+
+// ThingFactory returns a new, empty value to decode a JSON-LD node into.
+type ThingFactory func() Thing
+
+// registry maps Schema.org type names to the factory used to decode them.
+var registry = struct {
+	sync.RWMutex
+	factories map[string]ThingFactory
+}{factories: map[string]ThingFactory{}}
+
+func init() {
+	for t := range articleParents {
+		Register(string(t), func() Thing { return &Article{} })
+	}
+	registerAll(func() Thing { return &Book{} }, "Book")
+	registerAll(func() Thing { return &BreadcrumbList{} }, "BreadcrumbList")
+	registerAll(func() Thing { return &Course{} }, "Course")
+	registerAll(func() Thing { return &Dataset{} }, "Dataset")
+	registerAll(func() Thing { return &DiscussionForumPosting{} }, "DiscussionForumPosting")
+	registerAll(func() Thing { return &EducationalOrganization{} }, "EducationalOrganization", "CollegeOrUniversity", "School", "HighSchool", "MiddleSchool", "ElementarySchool", "Preschool")
+	for t := range eventParents {
+		Register(string(t), func() Thing { return &Event{} })
+	}
+	registerAll(func() Thing { return &FAQPage{} }, "FAQPage")
+	registerAll(func() Thing { return &ImageObject{} }, "ImageObject")
+	registerAll(func() Thing { return &ItemList{} }, "ItemList")
+	for t := range localBusinessParents {
+		Register(string(t), func() Thing { return &LocalBusiness{} })
+	}
+	registerAll(func() Thing { return &Movie{} }, "Movie")
+	registerAll(func() Thing { return &MusicAlbum{} }, "MusicAlbum")
+	registerAll(func() Thing { return &MusicPlaylist{} }, "MusicPlaylist")
+	registerAll(func() Thing { return &MusicRecording{} }, "MusicRecording")
+	for t := range organizationParents {
+		Register(string(t), func() Thing { return &Organization{} })
+	}
+	registerAll(func() Thing { return &Person{} }, "Person")
+	registerAll(func() Thing { return &Product{} }, "Product")
+	registerAll(func() Thing { return &ProductGroup{} }, "ProductGroup")
+	registerAll(func() Thing { return &ProfilePage{} }, "ProfilePage")
+	registerAll(func() Thing { return &QAPage{} }, "QAPage")
+	registerAll(func() Thing { return &Review{} }, "Review", "CriticReview", "EmployerReview", "Recommendation", "UserReview")
+	registerAll(func() Thing { return &AggregateRating{} }, "AggregateRating")
+	registerAll(func() Thing { return &TVEpisode{} }, "TVEpisode")
+	registerAll(func() Thing { return &TVSeason{} }, "TVSeason")
+	registerAll(func() Thing { return &TVSeries{} }, "TVSeries")
+	registerAll(func() Thing { return &WebPage{} }, "WebPage", "AboutPage", "CheckoutPage", "CollectionPage", "ContactPage", "ItemPage", "MedicalWebPage", "SearchResultsPage")
+	registerAll(func() Thing { return &WebSite{} }, "WebSite")
+}
+
+// registerAll registers the same factory for a type and its subtypes.
+func registerAll(factory ThingFactory, typeNames ...string) {
+	for _, name := range typeNames {
+		Register(name, factory)
+	}
+}
+
+// Register associates a Schema.org type name with the factory used by Decode
+// for nodes of that type. Registering an existing name replaces its factory, so
+// applications can override the built-in types or add their own.
+//
+// Example usage:
+//
+//	schemaorg.Register("Recipe", func() schemaorg.Thing { return &Recipe{} })
+func Register(typeName string, factory ThingFactory) {
+	registry.Lock()
+	defer registry.Unlock()
+	registry.factories[normalizeTypeName(typeName)] = factory
+}
+
+// lookupFactory returns the factory registered for typeName, if any.
+func lookupFactory(typeName string) (ThingFactory, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+	factory, ok := registry.factories[normalizeTypeName(typeName)]
+	return factory, ok
+}
+
+// normalizeTypeName strips the schema.org prefixes from a type name.
+func normalizeTypeName(typeName string) string {
+	name := strings.TrimSpace(typeName)
+	for _, prefix := range []string{"https://schema.org/", "http://schema.org/", "schema:"} {
+		name = strings.TrimPrefix(name, prefix)
+	}
+	return name
+}
+
+// RawThing holds a JSON-LD node whose @type is not registered, or whose
+// properties do not fit the registered Go type, so that decoding never silently
+// drops data. It renders the node as decoded; Err records why a node with a
+// registered type could not be decoded into it.
+type RawThing struct {
+	Types []string
+	Data  json.RawMessage
+	Err   error
+}
+
+// MarshalJSON renders the node as it was decoded.
+func (rt *RawThing) MarshalJSON() ([]byte, error) {
+	return rt.Data, nil
+}
+
+// ensureDefaults is a no-op, a RawThing is rendered as decoded.
+func (rt *RawThing) ensureDefaults() {}
+
+// ToJsonLd converts the RawThing to a JSON-LD `templ.Component`.
+func (rt *RawThing) ToJsonLd() templ.Component {
+	id := fmt.Sprintf("%s-%s", "thing", teseo.GenerateUniqueKey())
+	return templ.JSONScript(id, rt).WithType("application/ld+json")
+}
+
+// ToGoHTMLJsonLd renders the RawThing as `template.HTML` value for Go's `html/template`.
+func (rt *RawThing) ToGoHTMLJsonLd() (template.HTML, error) {
+	return teseo.RenderToHTML(rt.ToJsonLd())
+}
+
+// Decode parses a JSON-LD document into the registered Go types.
+// It accepts a single node, an array of nodes or a document with a @graph.
+// For nodes with several types (e.g. ["Restaurant", "LocalBusiness"]) the first
+// registered one is used; nodes with no registered type are returned as *RawThing.
+// A node with a property of an unexpected shape is also returned as *RawThing,
+// with the decoding error in Err, so that one node never fails the whole document.
+func Decode(data []byte) ([]Thing, error) {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return nil, errors.New("schemaorg: empty JSON-LD document")
+	}
+
+	if data[0] == '[' {
+		var items []json.RawMessage
+		if err := json.Unmarshal(data, &items); err != nil {
+			return nil, fmt.Errorf("schemaorg: invalid JSON-LD document: %w", err)
+		}
+		var things []Thing
+		for _, item := range items {
+			decoded, err := Decode(item)
+			if err != nil {
+				return nil, err
+			}
+			things = append(things, decoded...)
+		}
+		return things, nil
+	}
+
+	var node map[string]json.RawMessage
+	if err := json.Unmarshal(data, &node); err != nil {
+		return nil, fmt.Errorf("schemaorg: invalid JSON-LD document: %w", err)
+	}
+
+	if graph, ok := node["@graph"]; ok {
+		return Decode(graph)
+	}
+
+	thing, err := decodeNode(node, data)
+	if err != nil {
+		return nil, err
+	}
+	return []Thing{thing}, nil
+}
+
+// decodeNode decodes a single JSON-LD node into the type registered for its @type.
+func decodeNode(node map[string]json.RawMessage, data []byte) (Thing, error) {
+	types, err := nodeTypes(node["@type"])
+	if err != nil {
+		return nil, err
+	}
+
+	raw := append(json.RawMessage(nil), data...)
+	for _, t := range types {
+		factory, ok := lookupFactory(t)
+		if !ok {
+			continue
+		}
+
+		// The Go types hold a single short @type, so multi-typed nodes are narrowed to the
+		// matched one and IRIs such as "https://schema.org/Restaurant" are shortened.
+		if name := normalizeTypeName(t); len(types) > 1 || name != t {
+			typeName, _ := json.Marshal(name)
+			node["@type"] = typeName
+			if data, err = json.Marshal(node); err != nil {
+				return nil, err
+			}
+		}
+
+		thing := factory()
+		if err := json.Unmarshal(data, thing); err != nil {
+			return &RawThing{Types: types, Data: raw, Err: fmt.Errorf("schemaorg: cannot decode %s: %w", t, err)}, nil
+		}
+		return thing, nil
+	}
+
+	return &RawThing{Types: types, Data: raw}, nil
+}
+
+// nodeTypes returns the values of a @type, which may be a string or an array of strings.
+func nodeTypes(raw json.RawMessage) ([]string, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+	var types StringList
+	if err := json.Unmarshal(raw, &types); err != nil {
+		return nil, fmt.Errorf("schemaorg: invalid @type: %s", string(raw))
+	}
+	return types, nil
+}
@@ -0,0 +1,189 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+func TestDecode_SingleObject(t *testing.T) {
+	things, err := Decode([]byte(`{"@context": "https://schema.org", "@type": "Person", "name": "Jane Doe"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(things) != 1 {
+		t.Fatalf("expected 1 thing, got %d", len(things))
+	}
+	p, ok := things[0].(*Person)
+	if !ok || p.Name != "Jane Doe" {
+		t.Errorf("expected *Person Jane Doe, got %#v", things[0])
+	}
+}
+
+func TestDecode_ArrayAndGraph(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+	}{
+		{
+			name: "array",
+			data: `[
+				{"@context": "https://schema.org", "@type": "WebSite", "name": "Example"},
+				{"@context": "https://schema.org", "@type": "Organization", "name": "Example Inc."}
+			]`,
+		},
+		{
+			name: "graph",
+			data: `{
+				"@context": "https://schema.org",
+				"@graph": [
+					{"@type": "WebSite", "name": "Example"},
+					{"@type": "Organization", "name": "Example Inc."}
+				]
+			}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			things, err := Decode([]byte(tt.data))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if len(things) != 2 {
+				t.Fatalf("expected 2 things, got %d", len(things))
+			}
+			if _, ok := things[0].(*WebSite); !ok {
+				t.Errorf("expected *WebSite, got %T", things[0])
+			}
+			if _, ok := things[1].(*Organization); !ok {
+				t.Errorf("expected *Organization, got %T", things[1])
+			}
+		})
+	}
+}
+
+func TestDecode_Subtypes(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     string
+		wantType string
+	}{
+		{"subtype", `{"@type": "Restaurant", "name": "Trattoria"}`, "Restaurant"},
+		{"type array", `{"@type": ["Restaurant", "LocalBusiness"], "name": "Trattoria"}`, "Restaurant"},
+		{"unregistered first type", `{"@type": ["Distillery", "LocalBusiness"], "name": "Trattoria"}`, "LocalBusiness"},
+		{"prefixed type", `{"@type": "https://schema.org/Restaurant", "name": "Trattoria"}`, "Restaurant"},
+		{"http prefixed type", `{"@type": "http://schema.org/Restaurant", "name": "Trattoria"}`, "Restaurant"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			things, err := Decode([]byte(tt.data))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			lb, ok := things[0].(*LocalBusiness)
+			if !ok {
+				t.Fatalf("expected *LocalBusiness, got %T", things[0])
+			}
+			if string(lb.Type) != tt.wantType || lb.Name != "Trattoria" {
+				t.Errorf("expected type %q and name Trattoria, got %q and %q", tt.wantType, lb.Type, lb.Name)
+			}
+		})
+	}
+}
+
+func TestDecode_UnknownType(t *testing.T) {
+	data := `{"@type":"Recipe-Unknown","name":"Pancakes"}`
+	things, err := Decode([]byte(data))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	raw, ok := things[0].(*RawThing)
+	if !ok {
+		t.Fatalf("expected *RawThing, got %T", things[0])
+	}
+	if len(raw.Types) != 1 || raw.Types[0] != "Recipe-Unknown" {
+		t.Errorf("unexpected types: %v", raw.Types)
+	}
+	out, err := json.Marshal(raw)
+	if err != nil || string(out) != data {
+		t.Errorf("expected raw node to be preserved, got %s (err: %v)", out, err)
+	}
+}
+
+func TestDecode_Errors(t *testing.T) {
+	inputs := []string{
+		``,
+		`not json`,
+		`{"@type": 42}`,
+		`[{"@type": "Person"}, "oops"]`,
+	}
+	for _, in := range inputs {
+		if _, err := Decode([]byte(in)); err == nil {
+			t.Errorf("expected error for %q", in)
+		}
+	}
+}
+
+func TestDecode_UnexpectedShapes(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want string
+	}{
+		{"numeric price", `{"@type":"Product","name":"Anvil","offers":{"@type":"Offer","price":29.99,"priceCurrency":"USD"}}`, "*schemaorg.Product"},
+		{"single sameAs", `{"@type":"Organization","name":"Acme","sameAs":"https://social.example.com/acme"}`, "*schemaorg.Organization"},
+		{"offers array", `{"@type":"Product","name":"Anvil","offers":[{"@type":"Offer","price":"10"},{"@type":"Offer","price":"12"}]}`, "*schemaorg.Product"},
+		{"string ratingValue", `{"@type":"Product","name":"Anvil","aggregateRating":{"@type":"AggregateRating","ratingValue":"4.5","reviewCount":3}}`, "*schemaorg.Product"},
+		{"single openingHours", `{"@type":"Restaurant","name":"Trattoria","openingHours":"Mo-Fr 09:00-17:00"}`, "*schemaorg.LocalBusiness"},
+		{"license object", `{"@type":"Dataset","name":"Data","license":{"@type":"CreativeWork","name":"CC BY 4.0"}}`, "*schemaorg.Dataset"},
+		{"nested agent @type array", `{"@type":"Article","headline":"News","author":{"@type":["Person","Patient"],"name":"Jane"}}`, "*schemaorg.Article"},
+		{"mistyped name", `{"@type":"Person","name":42}`, "*schemaorg.RawThing"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := `[{"@type":"WebSite","name":"Example"},` + tt.data + `]`
+			things, err := Decode([]byte(data))
+			if err != nil {
+				t.Fatalf("expected the document to decode, got %v", err)
+			}
+			if len(things) != 2 {
+				t.Fatalf("expected 2 things, got %d", len(things))
+			}
+			if got := fmt.Sprintf("%T", things[1]); got != tt.want {
+				t.Fatalf("expected %s, got %s", tt.want, got)
+			}
+			if raw, ok := things[1].(*RawThing); ok {
+				if raw.Err == nil {
+					t.Error("expected the decoding error to be recorded")
+				}
+				if string(raw.Data) != tt.data {
+					t.Errorf("expected the node to be preserved, got %s", raw.Data)
+				}
+			}
+		})
+	}
+}
+
+type testRecipe struct {
+	Type string `json:"@type"`
+	Name string `json:"name"`
+}
+
+func (r *testRecipe) ToJsonLd() templ.Component              { return templ.NopComponent }
+func (r *testRecipe) ToGoHTMLJsonLd() (template.HTML, error) { return "", nil }
+
+func TestRegister_CustomType(t *testing.T) {
+	Register("TestRecipe", func() Thing { return &testRecipe{} })
+
+	things, err := Decode([]byte(`{"@type": "schema:TestRecipe", "name": "Pancakes"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	r, ok := things[0].(*testRecipe)
+	if !ok || r.Name != "Pancakes" {
+		t.Errorf("expected *testRecipe Pancakes, got %#v", things[0])
+	}
+}
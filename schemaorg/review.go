@@ -0,0 +1,486 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+
+	"github.com/a-h/templ"
+	"github.com/indaco/teseo"
+)
+
+// Default rating bounds assumed by search engines when bestRating and worstRating are omitted.
+const (
+	defaultBestRating  = 5
+	defaultWorstRating = 1
+)
+
+// reviewableTypes lists the `@type` values accepted as `itemReviewed` for review snippets.
+// For more details see: https://developers.google.com/search/docs/appearance/structured-data/review-snippet
+var reviewableTypes = map[string]bool{
+	"Book":                    true,
+	"Course":                  true,
+	"CreativeWorkSeason":      true,
+	"CreativeWorkSeries":      true,
+	"EducationalOrganization": true,
+	"Episode":                 true,
+	"Event":                   true,
+	"Game":                    true,
+	"HowTo":                   true,
+	"LocalBusiness":           true,
+	"MediaObject":             true,
+	"Movie":                   true,
+	"MusicAlbum":              true,
+	"MusicPlaylist":           true,
+	"MusicRecording":          true,
+	"Organization":            true,
+	"Product":                 true,
+	"Recipe":                  true,
+	"SoftwareApplication":     true,
+	"TVEpisode":               true,
+	"TVSeason":                true,
+	"TVSeries":                true,
+}
+
+// Review represents a Schema.org Review object.
+// For more details about the meaning of the properties see: https://schema.org/Review
+//
+// A Review can be nested in a Product or LocalBusiness, or rendered on its own
+// with ItemReviewed pointing to the reviewed entity.
+//
+// Example usage:
+//
+// Pure struct usage:
+//
+//	review := &schemaorg.Review{
+//		Name:         "A great pair of headphones",
+//		ItemReviewed: &schemaorg.Product{Name: "Example Headphones"},
+//		Author:       &schemaorg.Person{Name: "Jane Doe"},
+//		ReviewRating: schemaorg.NewRating(4, 5, 1),
+//		ReviewBody:   "Comfortable and with a great sound.",
+//		PositiveNotes: schemaorg.NewNotesList([]string{"Great sound", "Long battery life"}),
+//		NegativeNotes: schemaorg.NewNotesList([]string{"No carrying case"}),
+//	}
+//
+// Factory method usage:
+//
+//	review := schemaorg.NewReview(
+//		"A great pair of headphones",
+//		&schemaorg.Product{Name: "Example Headphones"},
+//		&schemaorg.Person{Name: "Jane Doe"},
+//		schemaorg.NewRating(4, 5, 1),
+//		"Comfortable and with a great sound.",
+//		"2024-09-15",
+//	)
+//
+// // Rendering JSON-LD using templ:
+//
+//	templ Page() {
+//		@review.ToJsonLd()
+//	}
+//
+// // Rendering JSON-LD as `template.HTML` value:
+//
+//	jsonLdHtml := review.ToGoHTMLJsonLd()
+//
+// Expected output:
+//
+//	{
+//		"@context": "https://schema.org",
+//		"@type": "Review",
+//		"name": "A great pair of headphones",
+//		"itemReviewed": {"@context": "https://schema.org", "@type": "Product", "name": "Example Headphones"},
+//		"author": {"@context": "https://schema.org", "@type": "Person", "name": "Jane Doe"},
+//		"datePublished": "2024-09-15",
+//		"reviewBody": "Comfortable and with a great sound.",
+//		"reviewRating": {"@type": "Rating", "ratingValue": 4, "bestRating": 5, "worstRating": 1}
+//	}
+type Review struct {
+	Context       string         `json:"@context,omitempty"`
+	Type          string         `json:"@type"`
+	Name          string         `json:"name,omitempty"`
+	ItemReviewed  any            `json:"itemReviewed,omitempty"`
+	Author        Agent          `json:"author,omitempty"`
+	Publisher     *Organization  `json:"publisher,omitempty"`
+	DatePublished teseo.DateTime `json:"datePublished,omitempty"`
+	ReviewBody    string         `json:"reviewBody,omitempty"`
+	ReviewRating  *Rating        `json:"reviewRating,omitempty"`
+	PositiveNotes *ItemList      `json:"positiveNotes,omitempty"`
+	NegativeNotes *ItemList      `json:"negativeNotes,omitempty"`
+	Extra         Extra          `json:"-"`
+}
+
+// AggregateRating represents a Schema.org AggregateRating object.
+// For more details about the meaning of the properties see: https://schema.org/AggregateRating
+//
+// Like Review, it can be nested in another entity or rendered on its own with ItemReviewed set.
+//
+// Example usage:
+//
+//	rating := schemaorg.NewAggregateRating(&schemaorg.Product{Name: "Example Headphones"}, 4.4, 89, 0)
+//
+//	templ Page() {
+//		@rating.ToJsonLd()
+//	}
+//
+// Expected output:
+//
+//	{
+//		"@context": "https://schema.org",
+//		"@type": "AggregateRating",
+//		"itemReviewed": {"@context": "https://schema.org", "@type": "Product", "name": "Example Headphones"},
+//		"ratingValue": 4.4,
+//		"ratingCount": 89
+//	}
+type AggregateRating struct {
+	Context      string `json:"@context,omitempty"`
+	Type         string `json:"@type"`
+	ItemReviewed any    `json:"itemReviewed,omitempty"`
+	RatingValue  Float  `json:"ratingValue"`
+	BestRating   *Float `json:"bestRating,omitempty"`
+	WorstRating  *Float `json:"worstRating,omitempty"`
+	RatingCount  int    `json:"ratingCount,omitempty"`
+	ReviewCount  int    `json:"reviewCount,omitempty"`
+	Extra        Extra  `json:"-"`
+}
+
+// Rating represents a Schema.org Rating object
+// For more details about the meaning of the properties see: https://schema.org/Rating
+//
+// BestRating and WorstRating default to 5 and 1 when nil. A RatingValue of 0
+// is only a valid rating on a scale whose WorstRating is explicitly set to 0 or lower.
+type Rating struct {
+	Type        string `json:"@type"`
+	RatingValue Float  `json:"ratingValue"`
+	BestRating  *Float `json:"bestRating,omitempty"`
+	WorstRating *Float `json:"worstRating,omitempty"`
+}
+
+// NewReview initializes a Review with default type.
+func NewReview(name string, itemReviewed any, author Agent, rating *Rating, reviewBody, datePublished string) *Review {
+	review := &Review{
+		Name:          name,
+		ItemReviewed:  itemReviewed,
+		Author:        author,
+		ReviewRating:  rating,
+		ReviewBody:    reviewBody,
+		DatePublished: teseo.DateTime(datePublished),
+	}
+	review.ensureDefaults()
+	return review
+}
+
+// NewAggregateRating initializes an AggregateRating with default type.
+func NewAggregateRating(itemReviewed any, ratingValue float64, ratingCount, reviewCount int) *AggregateRating {
+	ar := &AggregateRating{
+		ItemReviewed: itemReviewed,
+		RatingValue:  Float(ratingValue),
+		RatingCount:  ratingCount,
+		ReviewCount:  reviewCount,
+	}
+	ar.ensureDefaults()
+	return ar
+}
+
+// NewRating initializes a Rating with default type and an explicit scale.
+func NewRating(ratingValue, bestRating, worstRating float64) *Rating {
+	best, worst := Float(bestRating), Float(worstRating)
+	rating := &Rating{
+		RatingValue: Float(ratingValue),
+		BestRating:  &best,
+		WorstRating: &worst,
+	}
+	rating.ensureDefaults()
+	return rating
+}
+
+// NewNotesList builds the ItemList used by Review.PositiveNotes and Review.NegativeNotes
+// (pros and cons) from a list of short statements.
+func NewNotesList(notes []string) *ItemList {
+	items := make([]ListItem, 0, len(notes))
+	for i, note := range notes {
+		items = append(items, ListItem{Type: "ListItem", Position: i + 1, Name: note})
+	}
+	list := &ItemList{ItemListElement: items}
+	list.ensureDefaults()
+	return list
+}
+
+// Validate checks if the Review has the required fields for review snippets
+// and that the rating value falls between worstRating and bestRating.
+func (r *Review) Validate() []string {
+	return issueMessages(r.ValidationIssues())
+}
+
+// ValidationIssues returns the issues found by Validate on the Review as structured values.
+func (r *Review) ValidationIssues() []teseo.ValidationIssue {
+	var found issues
+
+	found.add(validateItemReviewed(r.ItemReviewed)...)
+
+	if isNilAgent(r.Author) {
+		found.required("author.name")
+	} else {
+		found.add(validateAgent("author", teseo.SeverityRequired, r.Author)...)
+	}
+	if r.ReviewRating == nil {
+		found.required("reviewRating")
+	} else {
+		found.add(validateRatingRange("reviewRating", r.ReviewRating.RatingValue, r.ReviewRating.BestRating, r.ReviewRating.WorstRating)...)
+	}
+	if r.DatePublished == "" {
+		found.recommended("datePublished")
+	}
+	found.add(validateDateTime("datePublished", r.DatePublished)...)
+
+	found.add(validateNotes("positiveNotes", r.PositiveNotes)...)
+	found.add(validateNotes("negativeNotes", r.NegativeNotes)...)
+
+	found.add(validateFormats(r)...)
+	return found
+}
+
+// Validate checks if the AggregateRating has the required fields for review snippets
+// and that the rating value falls between worstRating and bestRating.
+func (ar *AggregateRating) Validate() []string {
+	return issueMessages(ar.ValidationIssues())
+}
+
+// ValidationIssues returns the issues found by Validate on the AggregateRating as structured values.
+func (ar *AggregateRating) ValidationIssues() []teseo.ValidationIssue {
+	var found issues
+
+	found.add(validateItemReviewed(ar.ItemReviewed)...)
+
+	if ar.RatingCount <= 0 && ar.ReviewCount <= 0 {
+		found.missing(teseo.SeverityRequired, "ratingCount", "ratingCount or reviewCount")
+	}
+	if ar.RatingCount < 0 {
+		found.warnf(teseo.RuleNegativeValue, "ratingCount", "ratingCount must not be negative")
+	}
+	if ar.ReviewCount < 0 {
+		found.warnf(teseo.RuleNegativeValue, "reviewCount", "reviewCount must not be negative")
+	}
+	if ar.RatingCount > 0 && ar.ReviewCount > ar.RatingCount {
+		found.warnf(teseo.RuleConstraint, "reviewCount", "reviewCount (%d) should not exceed ratingCount (%d)", ar.ReviewCount, ar.RatingCount)
+	}
+
+	found.add(validateRatingRange("", ar.RatingValue, ar.BestRating, ar.WorstRating)...)
+
+	found.add(validateFormats(ar)...)
+	return found
+}
+
+// ToJsonLd converts the Review struct to a JSON-LD `templ.Component`.
+func (r *Review) ToJsonLd() templ.Component {
+	r.ensureDefaults()
+	if r.Context == "" {
+		r.Context = "https://schema.org"
+	}
+	id := fmt.Sprintf("%s-%s", "review", teseo.GenerateUniqueKey())
+	return templ.JSONScript(id, r).WithType("application/ld+json")
+}
+
+// ToGoHTMLJsonLd renders the Review struct as `template.HTML` value for Go's `html/template`.
+func (r *Review) ToGoHTMLJsonLd() (template.HTML, error) {
+	return teseo.RenderToHTML(r.ToJsonLd())
+}
+
+// UnmarshalJSON decodes a Review, resolving `author` to Person, Organization or @id reference nodes based on their `@type`.
+func (r *Review) UnmarshalJSON(data []byte) error {
+	type alias Review
+	aux := struct {
+		*alias
+		Author json.RawMessage `json:"author,omitempty"`
+	}{alias: (*alias)(r)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, aux)
+	if err != nil {
+		return err
+	}
+	r.Extra = extra
+
+	author, err := unmarshalAgent(aux.Author)
+	if err != nil {
+		return fmt.Errorf("Review: invalid author: %w", err)
+	}
+	r.Author = author
+
+	return nil
+}
+
+// ToJsonLd converts the AggregateRating struct to a JSON-LD `templ.Component`.
+func (ar *AggregateRating) ToJsonLd() templ.Component {
+	ar.ensureDefaults()
+	if ar.Context == "" {
+		ar.Context = "https://schema.org"
+	}
+	id := fmt.Sprintf("%s-%s", "aggregateRating", teseo.GenerateUniqueKey())
+	return templ.JSONScript(id, ar).WithType("application/ld+json")
+}
+
+// ToGoHTMLJsonLd renders the AggregateRating struct as `template.HTML` value for Go's `html/template`.
+func (ar *AggregateRating) ToGoHTMLJsonLd() (template.HTML, error) {
+	return teseo.RenderToHTML(ar.ToJsonLd())
+}
+
+// MarshalJSON encodes a Review, merging the Extra properties into the JSON-LD object.
+func (r Review) MarshalJSON() ([]byte, error) {
+	type alias Review
+	return marshalWithExtra(alias(r), r.Extra)
+}
+
+// ensureDefaults sets default values for Review and its nested objects if they are not already set.
+// The `@context` is only set when the Review is rendered on its own.
+func (r *Review) ensureDefaults() {
+	if r.Type == "" {
+		r.Type = "Review"
+	}
+
+	if d, ok := r.ItemReviewed.(defaulter); ok {
+		d.ensureDefaults()
+	}
+
+	ensureAgentDefaults(r.Author)
+
+	if r.Publisher != nil {
+		r.Publisher.ensureDefaults()
+	}
+
+	if r.ReviewRating != nil {
+		r.ReviewRating.ensureDefaults()
+	}
+
+	if r.PositiveNotes != nil {
+		r.PositiveNotes.ensureDefaults()
+	}
+
+	if r.NegativeNotes != nil {
+		r.NegativeNotes.ensureDefaults()
+	}
+}
+
+// MarshalJSON encodes an AggregateRating, merging the Extra properties into the JSON-LD object.
+func (ar AggregateRating) MarshalJSON() ([]byte, error) {
+	type alias AggregateRating
+	return marshalWithExtra(alias(ar), ar.Extra)
+}
+
+// UnmarshalJSON decodes an AggregateRating, capturing properties without a dedicated field into Extra.
+func (ar *AggregateRating) UnmarshalJSON(data []byte) error {
+	type alias AggregateRating
+	if err := json.Unmarshal(data, (*alias)(ar)); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, (*alias)(ar))
+	if err != nil {
+		return err
+	}
+	ar.Extra = extra
+	return nil
+}
+
+// ensureDefaults sets default values for AggregateRating if they are not already set.
+// The `@context` is only set when the AggregateRating is rendered on its own.
+func (ar *AggregateRating) ensureDefaults() {
+	if ar.Type == "" {
+		ar.Type = "AggregateRating"
+	}
+
+	if d, ok := ar.ItemReviewed.(defaulter); ok {
+		d.ensureDefaults()
+	}
+}
+
+// ensureDefaults sets default values for Rating if they are not already set.
+func (ra *Rating) ensureDefaults() {
+	if ra.Type == "" {
+		ra.Type = "Rating"
+	}
+}
+
+// validateItemReviewed checks that itemReviewed is set to one of the supported types.
+func validateItemReviewed(item any) []teseo.ValidationIssue {
+	var found issues
+	if item == nil {
+		found.required("itemReviewed")
+		return found
+	}
+	t := entityTypeOf(item)
+	if !isReviewableType(t) {
+		found.warnf(teseo.RuleUnknownType, "itemReviewed", "itemReviewed has unsupported type %q", t)
+	}
+	return found
+}
+
+// isReviewableType reports whether t is one of the reviewableTypes or one of
+// their subtypes, following the type catalogues and the types registered for
+// Decode, e.g. Corporation for Organization or CollegeOrUniversity for
+// EducationalOrganization.
+func isReviewableType(t string) bool {
+	if OrganizationType(t).IsValid() || LocalBusinessType(t).IsValid() || EventType(t).IsValid() {
+		return true
+	}
+	for reviewable := range reviewableTypes {
+		if matchesType([]string{t}, reviewable) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateRatingRange checks that value lies between worst and best, applying
+// the default 1-5 scale when the bounds are not set. A value of 0 is reported
+// as missing unless the scale starts at 0 or lower.
+func validateRatingRange(prefix string, value Float, bestRating, worstRating *Float) []teseo.ValidationIssue {
+	var found issues
+
+	field := func(name string) string {
+		if prefix == "" {
+			return name
+		}
+		return prefix + "." + name
+	}
+
+	best, worst := Float(defaultBestRating), Float(defaultWorstRating)
+	if bestRating != nil {
+		best = *bestRating
+	}
+	if worstRating != nil {
+		worst = *worstRating
+	}
+
+	if value == 0 && worst > 0 {
+		found.required(field("ratingValue"))
+		return found
+	}
+	if worst >= best {
+		found.warnf(teseo.RuleOutOfRange, field("worstRating"), "%s (%g) must be lower than %s (%g)", field("worstRating"), worst, field("bestRating"), best)
+		return found
+	}
+	if value < worst || value > best {
+		found.warnf(teseo.RuleOutOfRange, field("ratingValue"), "%s %g is out of range [%g, %g]", field("ratingValue"), value, worst, best)
+	}
+
+	return found
+}
+
+// validateNotes checks that every pro or con statement has a name and a position.
+func validateNotes(field string, notes *ItemList) []teseo.ValidationIssue {
+	if notes == nil {
+		return nil
+	}
+
+	var found issues
+	if len(notes.ItemListElement) == 0 {
+		found.warnf(teseo.RuleMissingField, field+".itemListElement", "%s should contain at least one item", field)
+	}
+	for i, item := range notes.ItemListElement {
+		if item.Name == "" {
+			found.warnf(teseo.RuleMissingField, fmt.Sprintf("%s.itemListElement[%d].name", field, i), "%s item %d is missing a name", field, i+1)
+		}
+	}
+	return found
+}
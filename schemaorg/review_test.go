@@ -0,0 +1,245 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func ratingBound(v Float) *Float {
+	return &v
+}
+
+func TestNewReview_SetsFieldsAndDefaults(t *testing.T) {
+	product := &Product{Name: "Headphones"}
+	review := NewReview("Great", product, &Person{Name: "Jane"}, NewRating(4, 5, 1), "Nice", "2024-09-15")
+
+	if review.Type != "Review" {
+		t.Errorf("expected type Review, got %s", review.Type)
+	}
+	if review.Context != "" {
+		t.Errorf("expected nested-safe empty context, got %s", review.Context)
+	}
+	if product.Type != "Product" {
+		t.Errorf("expected itemReviewed type Product, got %s", product.Type)
+	}
+	if review.ReviewRating.Type != "Rating" {
+		t.Errorf("expected rating type Rating, got %s", review.ReviewRating.Type)
+	}
+}
+
+func TestNewNotesList(t *testing.T) {
+	list := NewNotesList([]string{"Sound", "Battery"})
+
+	if list.Type != "ItemList" || list.NumberOfItems != 2 {
+		t.Errorf("unexpected list: %+v", list)
+	}
+	for i, item := range list.ItemListElement {
+		if item.Position != i+1 || item.Type != "ListItem" {
+			t.Errorf("unexpected item %d: %+v", i, item)
+		}
+	}
+}
+
+func TestReview_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		review   *Review
+		expected []string
+	}{
+		{
+			name: "all good",
+			review: &Review{
+				ItemReviewed:  &Product{Name: "Headphones"},
+				Author:        &Person{Name: "Jane"},
+				ReviewRating:  &Rating{RatingValue: 4},
+				DatePublished: "2024-09-15",
+				PositiveNotes: NewNotesList([]string{"Sound"}),
+			},
+			expected: nil,
+		},
+		{
+			name:   "missing fields",
+			review: &Review{},
+			expected: []string{
+				"missing required field: itemReviewed",
+				"missing required field: author.name",
+				"missing required field: reviewRating",
+				"missing recommended field: datePublished",
+			},
+		},
+		{
+			name: "out of range and bad notes",
+			review: &Review{
+				ItemReviewed:  &WebPage{Name: "Page"},
+				Author:        &Person{Name: "Jane"},
+				ReviewRating:  NewRating(11, 10, 1),
+				DatePublished: "2024-09-15",
+				NegativeNotes: &ItemList{ItemListElement: []ListItem{{Position: 1}}},
+			},
+			expected: []string{
+				`itemReviewed has unsupported type "WebPage"`,
+				"reviewRating.ratingValue 11 is out of range [1, 10]",
+				"negativeNotes item 1 is missing a name",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.review.Validate()
+			if len(got) != len(tt.expected) {
+				t.Fatalf("expected %d warnings, got %d: %v", len(tt.expected), len(got), got)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] {
+					t.Errorf("warning %d: expected %q, got %q", i, tt.expected[i], got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestAggregateRating_Validate(t *testing.T) {
+	tests := []struct {
+		name     string
+		rating   *AggregateRating
+		expected []string
+	}{
+		{
+			name:     "all good",
+			rating:   NewAggregateRating(&Course{Name: "Go"}, 4.4, 89, 12),
+			expected: nil,
+		},
+		{
+			name:   "missing fields",
+			rating: &AggregateRating{},
+			expected: []string{
+				"missing required field: itemReviewed",
+				"missing required field: ratingCount or reviewCount",
+				"missing required field: ratingValue",
+			},
+		},
+		{
+			name: "invalid counts and bounds",
+			rating: &AggregateRating{
+				ItemReviewed: &LocalBusiness{Name: "Shop"},
+				RatingValue:  3,
+				BestRating:   ratingBound(2),
+				WorstRating:  ratingBound(4),
+				RatingCount:  5,
+				ReviewCount:  10,
+			},
+			expected: []string{
+				"reviewCount (10) should not exceed ratingCount (5)",
+				"worstRating (4) must be lower than bestRating (2)",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.rating.Validate()
+			if len(got) != len(tt.expected) {
+				t.Fatalf("expected %d warnings, got %d: %v", len(tt.expected), len(got), got)
+			}
+			for i := range got {
+				if got[i] != tt.expected[i] {
+					t.Errorf("warning %d: expected %q, got %q", i, tt.expected[i], got[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRating_ZeroBasedScale(t *testing.T) {
+	tests := []struct {
+		name     string
+		rating   *Rating
+		expected []string
+	}{
+		{name: "fractional value", rating: NewRating(0.5, 1, 0)},
+		{name: "zero value", rating: NewRating(0, 10, 0)},
+		{name: "zero value on default scale", rating: &Rating{}, expected: []string{"missing required field: ratingValue"}},
+		{name: "below zero-based scale", rating: NewRating(-1, 10, 0), expected: []string{"ratingValue -1 is out of range [0, 10]"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := issueMessages(validateRatingRange("", tt.rating.RatingValue, tt.rating.BestRating, tt.rating.WorstRating))
+			if strings.Join(got, "|") != strings.Join(tt.expected, "|") {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+
+	data, err := json.Marshal(NewRating(0, 10, 0))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := `{"@type":"Rating","ratingValue":0,"bestRating":10,"worstRating":0}`; string(data) != expected {
+		t.Errorf("expected %s, got %s", expected, data)
+	}
+}
+
+func TestValidateItemReviewed_Subtypes(t *testing.T) {
+	tests := []struct {
+		name     string
+		item     any
+		expected []string
+	}{
+		{"organization subtype", &Organization{Type: "Corporation", Name: "Acme"}, nil},
+		{"educational organization subtype", &EducationalOrganization{Type: "CollegeOrUniversity", Name: "Example University"}, nil},
+		{"local business subtype", &LocalBusiness{Type: TypeRestaurant, Name: "Trattoria"}, nil},
+		{"event subtype", &Event{Type: "MusicEvent", Name: "Concert"}, nil},
+		{"unsupported type", &WebPage{Name: "Page"}, []string{`itemReviewed has unsupported type "WebPage"`}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := issueMessages(validateItemReviewed(tt.item))
+			if strings.Join(got, "|") != strings.Join(tt.expected, "|") {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestAggregateRating_UnmarshalJSON_StringValues(t *testing.T) {
+	var ar AggregateRating
+	data := `{"@type":"AggregateRating","ratingValue":"4.5","bestRating":"10","worstRating":0,"reviewCount":3}`
+	if err := json.Unmarshal([]byte(data), &ar); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if ar.RatingValue != 4.5 || ar.BestRating == nil || *ar.BestRating != 10 || ar.WorstRating == nil || *ar.WorstRating != 0 {
+		t.Errorf("unexpected rating: %v %v %v", ar.RatingValue, ar.BestRating, ar.WorstRating)
+	}
+
+	out, err := json.Marshal(ar)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if expected := `"ratingValue":4.5,"bestRating":10,"worstRating":0`; !strings.Contains(string(out), expected) {
+		t.Errorf("expected %s in %s", expected, out)
+	}
+}
+
+func TestReview_ToGoHTMLJsonLd(t *testing.T) {
+	review := NewReview("Great", &Product{Name: "Headphones"}, &Person{Name: "Jane"}, NewRating(4, 5, 1), "", "")
+	html, err := review.ToGoHTMLJsonLd()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !strings.Contains(string(html), `"@context":"https://schema.org","@type":"Review"`) {
+		t.Errorf("expected standalone Review JSON-LD, got %s", html)
+	}
+}
+
+func TestAggregateRating_ToGoHTMLJsonLd(t *testing.T) {
+	rating := NewAggregateRating(&Product{Name: "Headphones"}, 4.4, 89, 0)
+	html, err := rating.ToGoHTMLJsonLd()
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !strings.Contains(string(html), `"@context":"https://schema.org","@type":"AggregateRating"`) {
+		t.Errorf("expected standalone AggregateRating JSON-LD, got %s", html)
+	}
+}
@@ -198,26 +198,33 @@ func (sne *SiteNavigationElement) ensureDefaults() {
 // Validation
 // --------------------------
 
-func (snl *SiteNavigationElementList) Validate() (bool, []string) {
-	var warnings []string
+// Validate checks that the list has items, each with a name, a URL and a position.
+func (snl *SiteNavigationElementList) Validate() []string {
+	return issueMessages(snl.ValidationIssues())
+}
+
+// ValidationIssues returns the issues found by Validate on the SiteNavigationElementList as structured values.
+func (snl *SiteNavigationElementList) ValidationIssues() []teseo.ValidationIssue {
+	var found issues
 
 	if len(snl.ItemListElement) == 0 {
-		warnings = append(warnings, "ItemList should contain at least one item")
+		found.warnf(teseo.RuleMissingField, "itemListElement", "ItemList should contain at least one item")
 	} else {
 		for i, item := range snl.ItemListElement {
 			if item.Name == "" {
-				warnings = append(warnings, fmt.Sprintf("missing name in ItemListElement at position %d", i+1))
+				found.required(fmt.Sprintf("itemListElement[%d].name", i))
 			}
 			if item.URL == "" {
-				warnings = append(warnings, fmt.Sprintf("missing url in ItemListElement at position %d", i+1))
+				found.required(fmt.Sprintf("itemListElement[%d].url", i))
 			}
 			if item.Position == 0 {
-				warnings = append(warnings, fmt.Sprintf("missing position in ItemListElement at index %d", i))
+				found.required(fmt.Sprintf("itemListElement[%d].position", i))
 			}
 		}
 	}
 
-	return len(warnings) == 0, warnings
+	found.add(validateFormats(snl)...)
+	return found
 }
 
 // --------------------------
@@ -228,13 +235,6 @@ func (snl *SiteNavigationElementList) Validate() (bool, []string) {
 func (snl *SiteNavigationElementList) ToJsonLd() templ.Component {
 	snl.ensureDefaults()
 
-	ok, msgs := snl.Validate()
-	if !ok {
-		for _, msg := range msgs {
-			fmt.Fprintln(os.Stderr, "⚠️", msg)
-		}
-	}
-
 	for i := range snl.ItemListElement {
 		snl.ItemListElement[i].ensureDefaults()
 	}
@@ -1,8 +1,9 @@
 package schemaorg
 
 import (
-	"bytes"
+	"context"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -10,6 +11,8 @@ import (
 	"slices"
 	"strings"
 	"testing"
+
+	"github.com/indaco/teseo"
 )
 
 // Sample XML data for testing
@@ -95,16 +98,16 @@ func TestSiteNavigationElement_Validate(t *testing.T) {
 				},
 			},
 			expected: []string{
-				"missing name in ItemListElement at position 1",
-				"missing url in ItemListElement at position 1",
-				"missing position in ItemListElement at index 0",
+				"missing required field: itemListElement[0].name",
+				"missing required field: itemListElement[0].url",
+				"missing required field: itemListElement[0].position",
 			},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			_, warnings := tt.input.Validate()
+			warnings := tt.input.Validate()
 			if len(warnings) != len(tt.expected) {
 				t.Errorf("expected %d warnings, got %d: %v", len(tt.expected), len(warnings), warnings)
 				return
@@ -158,19 +161,7 @@ func TestNewSiteNavigationElementsFromLinks(t *testing.T) {
 	}
 }
 
-func TestSiteNavigationElementList_ToJsonLd_WritesWarnings(t *testing.T) {
-	// Save original stderr and restore after test
-	origStderr := os.Stderr
-	defer func() { os.Stderr = origStderr }()
-
-	// Create pipe to capture stderr
-	r, w, err := os.Pipe()
-	if err != nil {
-		t.Fatalf("failed to create pipe: %v", err)
-	}
-	os.Stderr = w
-
-	// Create input with missing fields to trigger warnings
+func TestSiteNavigationElementList_ToJsonLd_StrictMode(t *testing.T) {
 	list := &SiteNavigationElementList{
 		Identifier: "test",
 		ItemListElement: []SiteNavigationElement{
@@ -178,36 +169,23 @@ func TestSiteNavigationElementList_ToJsonLd_WritesWarnings(t *testing.T) {
 		},
 	}
 
-	// Run ToJsonLd to capture stderr output
-	component := list.ToJsonLd()
-
-	// Close writer and read stderr content
-	if err := w.Close(); err != nil {
-		t.Fatalf("failed to close pipe writer: %v", err)
+	err := teseo.Strict(list, list.ToJsonLd()).Render(context.Background(), io.Discard)
+	var verr *teseo.ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("expected *teseo.ValidationError, got %v", err)
 	}
-	var buf bytes.Buffer
-	if _, err := io.Copy(&buf, r); err != nil {
-		t.Fatalf("failed to read stderr: %v", err)
+	var paths []string
+	for _, issue := range verr.Issues {
+		paths = append(paths, issue.Path)
 	}
-
-	output := buf.String()
-
-	// Check expected messages
-	expectedWarnings := []string{
-		"missing name in ItemListElement at position 1",
-		"missing url in ItemListElement at position 1",
-		"missing position in ItemListElement at index 0",
-	}
-
-	for _, warning := range expectedWarnings {
-		if !strings.Contains(output, warning) {
-			t.Errorf("expected warning %q in stderr output, got:\n%s", warning, output)
-		}
+	expected := []string{"itemListElement[0].name", "itemListElement[0].url", "itemListElement[0].position"}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Errorf("expected paths %v, got %v", expected, paths)
 	}
 
-	// Basic check that a templ.Component was returned
-	if component == nil {
-		t.Errorf("expected non-nil component from ToJsonLd")
+	list.ItemListElement[0] = NewSimpleSiteNavigationElement(1, "Home", "https://example.com")
+	if err := teseo.Strict(list, list.ToJsonLd()).Render(context.Background(), io.Discard); err != nil {
+		t.Errorf("unexpected error: %v", err)
 	}
 }
 
@@ -0,0 +1,443 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"fmt"
+	"html/template"
+
+	"github.com/a-h/templ"
+	"github.com/indaco/teseo"
+)
+
+// TVSeries represents a Schema.org TVSeries object, with its seasons listed in ContainsSeason.
+// For more details about the meaning of the properties see: https://schema.org/TVSeries
+//
+// TVSeries, TVSeason and TVEpisode can be rendered on their own or nested in
+// each other; the `@context` is only set when they are rendered on their own.
+//
+// Example usage:
+//
+// Pure struct usage:
+//
+//	series := &schemaorg.TVSeries{
+//		Name:      "Example Series",
+//		URL:       "https://www.example.com/series/example-series",
+//		StartDate: "2024-01-10",
+//		ContainsSeason: []*schemaorg.TVSeason{
+//			{SeasonNumber: 1, NumberOfEpisodes: 8},
+//		},
+//	}
+//
+//	episode := &schemaorg.TVEpisode{
+//		Name:          "Pilot",
+//		EpisodeNumber: 1,
+//		PartOfSeason:  &schemaorg.TVSeason{SeasonNumber: 1},
+//		PartOfSeries:  &schemaorg.TVSeries{Name: "Example Series", URL: "https://www.example.com/series/example-series"},
+//	}
+//
+// Factory method usage:
+//
+//	series := schemaorg.NewTVSeries("Example Series", "https://www.example.com/series/example-series", seasons...)
+//	episode := schemaorg.NewTVEpisode("Pilot", "https://www.example.com/series/example-series/1/1", 1, series)
+//
+// // Rendering JSON-LD using templ:
+//
+//	templ Page() {
+//		@series.ToJsonLd()
+//	}
+//
+// // Rendering JSON-LD as `template.HTML` value:
+//
+//	jsonLdHtml := series.ToGoHTMLJsonLd()
+//
+// Expected output:
+//
+//	{
+//		"@context": "https://schema.org",
+//		"@type": "TVSeries",
+//		"name": "Example Series",
+//		"url": "https://www.example.com/series/example-series",
+//		"startDate": "2024-01-10",
+//		"containsSeason": [{"@type": "TVSeason", "seasonNumber": 1, "numberOfEpisodes": 8}]
+//	}
+type TVSeries struct {
+	Context          string         `json:"@context,omitempty"`
+	Type             string         `json:"@type"`
+	Name             string         `json:"name,omitempty"`
+	URL              string         `json:"url,omitempty"`
+	Description      string         `json:"description,omitempty"`
+	Image            Images         `json:"image,omitempty"`
+	Genre            StringList     `json:"genre,omitempty"`
+	StartDate        teseo.DateTime `json:"startDate,omitempty"`
+	EndDate          teseo.DateTime `json:"endDate,omitempty"`
+	NumberOfSeasons  int            `json:"numberOfSeasons,omitempty"`
+	NumberOfEpisodes int            `json:"numberOfEpisodes,omitempty"`
+	Director         Agent          `json:"director,omitempty"`
+	Actor            Agent          `json:"actor,omitempty"`
+	ContainsSeason   []*TVSeason    `json:"containsSeason,omitempty"`
+	Extra            Extra          `json:"-"`
+}
+
+// TVSeason represents a Schema.org TVSeason object.
+// For more details about the meaning of the properties see: https://schema.org/TVSeason
+type TVSeason struct {
+	Context          string         `json:"@context,omitempty"`
+	Type             string         `json:"@type"`
+	Name             string         `json:"name,omitempty"`
+	URL              string         `json:"url,omitempty"`
+	SeasonNumber     int            `json:"seasonNumber,omitempty"`
+	NumberOfEpisodes int            `json:"numberOfEpisodes,omitempty"`
+	StartDate        teseo.DateTime `json:"startDate,omitempty"`
+	EndDate          teseo.DateTime `json:"endDate,omitempty"`
+	PartOfSeries     *TVSeries      `json:"partOfSeries,omitempty"`
+	Episode          []*TVEpisode   `json:"episode,omitempty"`
+	Extra            Extra          `json:"-"`
+}
+
+// TVEpisode represents a Schema.org TVEpisode object.
+// For more details about the meaning of the properties see: https://schema.org/TVEpisode
+type TVEpisode struct {
+	Context       string         `json:"@context,omitempty"`
+	Type          string         `json:"@type"`
+	Name          string         `json:"name,omitempty"`
+	URL           string         `json:"url,omitempty"`
+	Description   string         `json:"description,omitempty"`
+	Image         Images         `json:"image,omitempty"`
+	EpisodeNumber int            `json:"episodeNumber,omitempty"`
+	DatePublished teseo.DateTime `json:"datePublished,omitempty"`
+	Duration      teseo.Duration `json:"duration,omitempty"`
+	Director      Agent          `json:"director,omitempty"`
+	Actor         Agent          `json:"actor,omitempty"`
+	PartOfSeason  *TVSeason      `json:"partOfSeason,omitempty"`
+	PartOfSeries  *TVSeries      `json:"partOfSeries,omitempty"`
+	Extra         Extra          `json:"-"`
+}
+
+// NewTVSeries initializes a TVSeries with default type.
+func NewTVSeries(name, url string, seasons ...*TVSeason) *TVSeries {
+	series := &TVSeries{
+		Name:           name,
+		URL:            url,
+		ContainsSeason: seasons,
+	}
+	series.ensureDefaults()
+	return series
+}
+
+// NewTVSeason initializes a TVSeason with default type.
+func NewTVSeason(seasonNumber int, series *TVSeries, episodes ...*TVEpisode) *TVSeason {
+	season := &TVSeason{
+		SeasonNumber: seasonNumber,
+		PartOfSeries: series,
+		Episode:      episodes,
+	}
+	season.ensureDefaults()
+	return season
+}
+
+// NewTVEpisode initializes a TVEpisode with default type.
+func NewTVEpisode(name, url string, episodeNumber int, series *TVSeries) *TVEpisode {
+	episode := &TVEpisode{
+		Name:          name,
+		URL:           url,
+		EpisodeNumber: episodeNumber,
+		PartOfSeries:  series,
+	}
+	episode.ensureDefaults()
+	return episode
+}
+
+// Validate returns warnings for missing recommended TVSeries fields, including its seasons.
+func (s *TVSeries) Validate() []string {
+	return issueMessages(s.ValidationIssues())
+}
+
+// ValidationIssues returns the issues found by Validate on the TVSeries as structured values.
+func (s *TVSeries) ValidationIssues() []teseo.ValidationIssue {
+	return append(s.validate(""), validateFormats(s)...)
+}
+
+// validate checks the TVSeries fields, prefixing the issue paths with the given path.
+func (s *TVSeries) validate(prefix string) []teseo.ValidationIssue {
+	var found issues
+
+	if s.Name == "" {
+		found.required(fieldPath(prefix, "name"))
+	}
+	found.add(validateAgent(fieldPath(prefix, "director"), teseo.SeverityRecommended, s.Director)...)
+	found.add(validateAgent(fieldPath(prefix, "actor"), teseo.SeverityRecommended, s.Actor)...)
+	found.add(validateDateTime(fieldPath(prefix, "startDate"), s.StartDate)...)
+	found.add(validateDateTime(fieldPath(prefix, "endDate"), s.EndDate)...)
+	found.add(validateTimeOrder(fieldPath(prefix, "startDate"), s.StartDate, fieldPath(prefix, "endDate"), s.EndDate)...)
+	if s.NumberOfSeasons < 0 {
+		found.warnf(teseo.RuleNegativeValue, fieldPath(prefix, "numberOfSeasons"), "%s must not be negative, got %d", fieldPath(prefix, "numberOfSeasons"), s.NumberOfSeasons)
+	}
+	if s.NumberOfEpisodes < 0 {
+		found.warnf(teseo.RuleNegativeValue, fieldPath(prefix, "numberOfEpisodes"), "%s must not be negative, got %d", fieldPath(prefix, "numberOfEpisodes"), s.NumberOfEpisodes)
+	}
+
+	for i, season := range s.ContainsSeason {
+		if season != nil {
+			found.add(season.validate(fieldPath(prefix, fmt.Sprintf("containsSeason[%d]", i)))...)
+		}
+	}
+	found.add(s.Image.validate(fieldPath(prefix, "image"))...)
+
+	return found
+}
+
+// Validate returns warnings for missing recommended TVSeason fields, including its episodes.
+func (s *TVSeason) Validate() []string {
+	return issueMessages(s.ValidationIssues())
+}
+
+// ValidationIssues returns the issues found by Validate on the TVSeason as structured values.
+func (s *TVSeason) ValidationIssues() []teseo.ValidationIssue {
+	return append(s.validate(""), validateFormats(s)...)
+}
+
+// validate checks the TVSeason fields, prefixing the issue paths with the given path.
+func (s *TVSeason) validate(prefix string) []teseo.ValidationIssue {
+	var found issues
+
+	if s.SeasonNumber <= 0 && s.Name == "" {
+		found.recommended(fieldPath(prefix, "seasonNumber"))
+	}
+	if s.NumberOfEpisodes < 0 {
+		found.warnf(teseo.RuleNegativeValue, fieldPath(prefix, "numberOfEpisodes"), "%s must not be negative, got %d", fieldPath(prefix, "numberOfEpisodes"), s.NumberOfEpisodes)
+	}
+	found.add(validateDateTime(fieldPath(prefix, "startDate"), s.StartDate)...)
+	found.add(validateDateTime(fieldPath(prefix, "endDate"), s.EndDate)...)
+	found.add(validateTimeOrder(fieldPath(prefix, "startDate"), s.StartDate, fieldPath(prefix, "endDate"), s.EndDate)...)
+	if s.PartOfSeries != nil && s.PartOfSeries.Name == "" && s.PartOfSeries.URL == "" {
+		found.recommended(fieldPath(prefix, "partOfSeries.name"))
+	}
+
+	for i, episode := range s.Episode {
+		if episode != nil {
+			found.add(episode.validate(fieldPath(prefix, fmt.Sprintf("episode[%d]", i)))...)
+		}
+	}
+
+	return found
+}
+
+// Validate returns warnings for missing recommended TVEpisode fields.
+func (e *TVEpisode) Validate() []string {
+	return issueMessages(e.ValidationIssues())
+}
+
+// ValidationIssues returns the issues found by Validate on the TVEpisode as structured values.
+func (e *TVEpisode) ValidationIssues() []teseo.ValidationIssue {
+	return append(e.validate(""), validateFormats(e)...)
+}
+
+// validate checks the TVEpisode fields, prefixing the issue paths with the given path.
+func (e *TVEpisode) validate(prefix string) []teseo.ValidationIssue {
+	var found issues
+
+	if e.Name == "" {
+		found.required(fieldPath(prefix, "name"))
+	}
+	if e.EpisodeNumber <= 0 {
+		found.recommended(fieldPath(prefix, "episodeNumber"))
+	}
+	if e.PartOfSeries != nil && e.PartOfSeries.Name == "" && e.PartOfSeries.URL == "" {
+		found.recommended(fieldPath(prefix, "partOfSeries.name"))
+	}
+	found.add(validateAgent(fieldPath(prefix, "director"), teseo.SeverityRecommended, e.Director)...)
+	found.add(validateAgent(fieldPath(prefix, "actor"), teseo.SeverityRecommended, e.Actor)...)
+	found.add(validateDateTime(fieldPath(prefix, "datePublished"), e.DatePublished)...)
+	found.add(validateDuration(fieldPath(prefix, "duration"), e.Duration)...)
+	found.add(e.Image.validate(fieldPath(prefix, "image"))...)
+
+	return found
+}
+
+// ToJsonLd converts the TVSeries struct to a JSON-LD `templ.Component`.
+func (s *TVSeries) ToJsonLd() templ.Component {
+	s.ensureDefaults()
+	if s.Context == "" {
+		s.Context = "https://schema.org"
+	}
+	id := fmt.Sprintf("%s-%s", "tvseries", teseo.GenerateUniqueKey())
+	return templ.JSONScript(id, s).WithType("application/ld+json")
+}
+
+// ToGoHTMLJsonLd renders the TVSeries struct as `template.HTML` value for Go's `html/template`.
+func (s *TVSeries) ToGoHTMLJsonLd() (template.HTML, error) {
+	return teseo.RenderToHTML(s.ToJsonLd())
+}
+
+// ToJsonLd converts the TVSeason struct to a JSON-LD `templ.Component`.
+func (s *TVSeason) ToJsonLd() templ.Component {
+	s.ensureDefaults()
+	if s.Context == "" {
+		s.Context = "https://schema.org"
+	}
+	id := fmt.Sprintf("%s-%s", "tvseason", teseo.GenerateUniqueKey())
+	return templ.JSONScript(id, s).WithType("application/ld+json")
+}
+
+// ToGoHTMLJsonLd renders the TVSeason struct as `template.HTML` value for Go's `html/template`.
+func (s *TVSeason) ToGoHTMLJsonLd() (template.HTML, error) {
+	return teseo.RenderToHTML(s.ToJsonLd())
+}
+
+// ToJsonLd converts the TVEpisode struct to a JSON-LD `templ.Component`.
+func (e *TVEpisode) ToJsonLd() templ.Component {
+	e.ensureDefaults()
+	if e.Context == "" {
+		e.Context = "https://schema.org"
+	}
+	id := fmt.Sprintf("%s-%s", "tvepisode", teseo.GenerateUniqueKey())
+	return templ.JSONScript(id, e).WithType("application/ld+json")
+}
+
+// ToGoHTMLJsonLd renders the TVEpisode struct as `template.HTML` value for Go's `html/template`.
+func (e *TVEpisode) ToGoHTMLJsonLd() (template.HTML, error) {
+	return teseo.RenderToHTML(e.ToJsonLd())
+}
+
+// MarshalJSON encodes a TVSeries, merging the Extra properties into the JSON-LD object.
+func (s TVSeries) MarshalJSON() ([]byte, error) {
+	type alias TVSeries
+	return marshalWithExtra(alias(s), s.Extra)
+}
+
+// UnmarshalJSON decodes a TVSeries, resolving `director` and `actor` to Person, Organization or @id reference nodes based on their `@type`.
+func (s *TVSeries) UnmarshalJSON(data []byte) error {
+	type alias TVSeries
+	aux := struct {
+		*alias
+		Director json.RawMessage `json:"director,omitempty"`
+		Actor    json.RawMessage `json:"actor,omitempty"`
+	}{alias: (*alias)(s)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, aux)
+	if err != nil {
+		return err
+	}
+	s.Extra = extra
+
+	if s.Director, err = unmarshalAgent(aux.Director); err != nil {
+		return fmt.Errorf("TVSeries: invalid director: %w", err)
+	}
+	if s.Actor, err = unmarshalAgent(aux.Actor); err != nil {
+		return fmt.Errorf("TVSeries: invalid actor: %w", err)
+	}
+
+	return nil
+}
+
+// MarshalJSON encodes a TVSeason, merging the Extra properties into the JSON-LD object.
+func (s TVSeason) MarshalJSON() ([]byte, error) {
+	type alias TVSeason
+	return marshalWithExtra(alias(s), s.Extra)
+}
+
+// UnmarshalJSON decodes a TVSeason, capturing properties without a dedicated field into Extra.
+func (s *TVSeason) UnmarshalJSON(data []byte) error {
+	type alias TVSeason
+	if err := json.Unmarshal(data, (*alias)(s)); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, (*alias)(s))
+	if err != nil {
+		return err
+	}
+	s.Extra = extra
+	return nil
+}
+
+// MarshalJSON encodes a TVEpisode, merging the Extra properties into the JSON-LD object.
+func (e TVEpisode) MarshalJSON() ([]byte, error) {
+	type alias TVEpisode
+	return marshalWithExtra(alias(e), e.Extra)
+}
+
+// UnmarshalJSON decodes a TVEpisode, resolving `director` and `actor` to Person, Organization or @id reference nodes based on their `@type`.
+func (e *TVEpisode) UnmarshalJSON(data []byte) error {
+	type alias TVEpisode
+	aux := struct {
+		*alias
+		Director json.RawMessage `json:"director,omitempty"`
+		Actor    json.RawMessage `json:"actor,omitempty"`
+	}{alias: (*alias)(e)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, aux)
+	if err != nil {
+		return err
+	}
+	e.Extra = extra
+
+	if e.Director, err = unmarshalAgent(aux.Director); err != nil {
+		return fmt.Errorf("TVEpisode: invalid director: %w", err)
+	}
+	if e.Actor, err = unmarshalAgent(aux.Actor); err != nil {
+		return fmt.Errorf("TVEpisode: invalid actor: %w", err)
+	}
+
+	return nil
+}
+
+// ensureDefaults sets default values for TVSeries and its seasons if they are not already set.
+func (s *TVSeries) ensureDefaults() {
+	if s.Type == "" {
+		s.Type = "TVSeries"
+	}
+
+	ensureAgentDefaults(s.Director)
+
+	ensureAgentDefaults(s.Actor)
+
+	for _, season := range s.ContainsSeason {
+		if season != nil {
+			season.ensureDefaults()
+		}
+	}
+
+	s.Image.ensureDefaults()
+}
+
+// ensureDefaults sets default values for TVSeason, its series and its episodes if they are not already set.
+func (s *TVSeason) ensureDefaults() {
+	if s.Type == "" {
+		s.Type = "TVSeason"
+	}
+
+	if s.PartOfSeries != nil {
+		s.PartOfSeries.ensureDefaults()
+	}
+
+	for _, episode := range s.Episode {
+		if episode != nil {
+			episode.ensureDefaults()
+		}
+	}
+}
+
+// ensureDefaults sets default values for TVEpisode and its nested objects if they are not already set.
+func (e *TVEpisode) ensureDefaults() {
+	if e.Type == "" {
+		e.Type = "TVEpisode"
+	}
+
+	ensureAgentDefaults(e.Director)
+
+	ensureAgentDefaults(e.Actor)
+
+	if e.PartOfSeason != nil {
+		e.PartOfSeason.ensureDefaults()
+	}
+
+	if e.PartOfSeries != nil {
+		e.PartOfSeries.ensureDefaults()
+	}
+
+	e.Image.ensureDefaults()
+}
@@ -0,0 +1,76 @@
+package schemaorg
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestTVSeries_ContextOnlyWhenStandalone(t *testing.T) {
+	series := NewTVSeries("Example Series", "https://www.example.com/series", NewTVSeason(1, nil, NewTVEpisode("Pilot", "", 1, nil)))
+	if _, err := series.ToGoHTMLJsonLd(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	data, err := json.Marshal(series)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := `{"@context":"https://schema.org","@type":"TVSeries","name":"Example Series","url":"https://www.example.com/series","containsSeason":[{"@type":"TVSeason","seasonNumber":1,"episode":[{"@type":"TVEpisode","name":"Pilot","episodeNumber":1}]}]}`
+	if string(data) != expected {
+		t.Errorf("expected %s, got %s", expected, data)
+	}
+}
+
+func TestTVSeries_Validate(t *testing.T) {
+	series := &TVSeries{
+		StartDate:        "2024-05-01",
+		EndDate:          "2024-01-01",
+		NumberOfEpisodes: -1,
+		ContainsSeason: []*TVSeason{
+			{Episode: []*TVEpisode{{Name: "Pilot", Duration: "45m"}}},
+		},
+	}
+	expected := []string{
+		"missing required field: name",
+		`endDate "2024-01-01" is before startDate "2024-05-01"`,
+		"numberOfEpisodes must not be negative, got -1",
+		"missing recommended field: containsSeason[0].seasonNumber",
+		"missing recommended field: containsSeason[0].episode[0].episodeNumber",
+		`invalid duration for containsSeason[0].episode[0].duration: "45m"`,
+	}
+	if got := series.Validate(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+}
+
+func TestTVEpisode_Validate(t *testing.T) {
+	episode := &TVEpisode{Name: "Pilot", EpisodeNumber: 1, PartOfSeries: &TVSeries{}}
+	expected := []string{"missing recommended field: partOfSeries.name"}
+	if got := episode.Validate(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+
+	episode.PartOfSeries.Name = "Example Series"
+	if got := episode.Validate(); len(got) != 0 {
+		t.Errorf("expected no warnings, got %v", got)
+	}
+}
+
+func TestDecode_TVEpisode(t *testing.T) {
+	data := `{"@context":"https://schema.org","@type":"TVEpisode","name":"Pilot","actor":{"@type":"Person","name":"Jane Doe"},"partOfSeason":{"@type":"TVSeason","seasonNumber":2}}`
+	things, err := Decode([]byte(data))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	episode, ok := things[0].(*TVEpisode)
+	if !ok {
+		t.Fatalf("expected *TVEpisode, got %T", things[0])
+	}
+	if episode.PartOfSeason == nil || episode.PartOfSeason.SeasonNumber != 2 {
+		t.Errorf("expected season 2, got %#v", episode.PartOfSeason)
+	}
+	if _, ok := episode.Actor.(*Person); !ok {
+		t.Errorf("expected actor to be *Person, got %T", episode.Actor)
+	}
+}
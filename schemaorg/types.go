@@ -4,7 +4,11 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"strconv"
 	"strings"
+
+	"github.com/indaco/teseo"
 )
 
 // Common type definitions used across multiple JSON-LD entities
@@ -85,6 +89,60 @@ func (s StringList) String() string {
 	}
 }
 
+// Number holds a numeric property, such as a price, that JSON-LD documents give
+// either as a number (29.99) or as a string ("29.99"). The value is kept as
+// written and rendered as a string.
+type Number string
+
+// UnmarshalJSON accepts both a JSON number and a string, and trims the value.
+func (n *Number) UnmarshalJSON(data []byte) error {
+	trimmed := bytes.TrimSpace(data)
+	if bytes.Equal(trimmed, []byte("null")) {
+		*n = ""
+		return nil
+	}
+
+	var text string
+	if err := json.Unmarshal(trimmed, &text); err == nil {
+		*n = Number(strings.TrimSpace(text))
+		return nil
+	}
+
+	var number json.Number
+	if err := json.Unmarshal(trimmed, &number); err != nil {
+		return fmt.Errorf("Number: invalid JSON input: %s", string(trimmed))
+	}
+	*n = Number(number.String())
+	return nil
+}
+
+// Float64 returns the value as a float64.
+func (n Number) Float64() (float64, error) {
+	return strconv.ParseFloat(strings.TrimSpace(string(n)), 64)
+}
+
+// Float holds a numeric property, such as a rating, that JSON-LD documents give
+// either as a number (4.5) or as a string ("4.5"). It is rendered as a number.
+type Float float64
+
+// UnmarshalJSON accepts both a JSON number and a string holding a number.
+func (f *Float) UnmarshalJSON(data []byte) error {
+	var n Number
+	if err := n.UnmarshalJSON(data); err != nil {
+		return fmt.Errorf("Float: invalid JSON input: %s", string(bytes.TrimSpace(data)))
+	}
+	if n == "" {
+		*f = 0
+		return nil
+	}
+	value, err := n.Float64()
+	if err != nil {
+		return fmt.Errorf("Float: invalid number: %q", string(n))
+	}
+	*f = Float(value)
+	return nil
+}
+
 // ContactPoint represents a Schema.org ContactPoint object
 // For more details about the meaning of the properties see: https://schema.org/ContactPoint
 type ContactPoint struct {
@@ -96,25 +154,258 @@ type ContactPoint struct {
 	AvailableLanguage string     `json:"availableLanguage,omitempty"`
 }
 
-// ImageObject represents a Schema.org ImageObject object
-// For more details about the meaning of the properties see: https://schema.org/ImageObject
-type ImageObject struct {
-	Type string `json:"@type"`
-	URL  string `json:"url,omitempty"`
-}
-
-// ensureDefaults sets default values for ImageObject if they are not already set.
-func (img *ImageObject) ensureDefaults() {
-	if img.Type == "" {
-		img.Type = "ImageObject"
-	}
-}
-
 // ListItem represents a Schema.org ListItem object
 // For more details about the meaning of the properties see: https://schema.org/ListItem
+//
+// Item holds the URL of the item, as used by BreadcrumbList. Entity holds an
+// embedded entity such as a *Course or *Product, as used by ItemList carousels,
+// and is rendered as item in place of Item. URL is used by the summary page
+// pattern, where each element only links to the page describing the item.
 type ListItem struct {
 	Type     string `json:"@type"`
 	Position int    `json:"position,omitempty"`
 	Name     string `json:"name,omitempty"`
+	URL      string `json:"url,omitempty"`
 	Item     string `json:"item,omitempty"`
+	Entity   any    `json:"-"`
+}
+
+// ItemURL returns the URL the ListItem points to, either from Item or from URL.
+func (li ListItem) ItemURL() string {
+	if li.Item != "" {
+		return li.Item
+	}
+	return li.URL
+}
+
+// hasItem reports whether the ListItem carries an item URL or an embedded entity.
+func (li ListItem) hasItem() bool {
+	return li.Item != "" || li.Entity != nil
+}
+
+// MarshalJSON encodes a ListItem, rendering Entity as item when set.
+func (li ListItem) MarshalJSON() ([]byte, error) {
+	type alias ListItem
+	if li.Entity == nil {
+		return json.Marshal(alias(li))
+	}
+	return json.Marshal(struct {
+		alias
+		Item any `json:"item"`
+	}{alias(li), li.Entity})
+}
+
+// UnmarshalJSON decodes a ListItem. An item given as a string is stored in Item,
+// an embedded node is decoded into Entity with the type registered for its @type.
+func (li *ListItem) UnmarshalJSON(data []byte) error {
+	type alias ListItem
+	aux := struct {
+		*alias
+		Item json.RawMessage `json:"item,omitempty"`
+	}{alias: (*alias)(li)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+
+	li.Item, li.Entity = "", nil
+	raw := bytes.TrimSpace(aux.Item)
+	switch {
+	case len(raw) == 0 || string(raw) == "null":
+		return nil
+	case raw[0] == '"':
+		return json.Unmarshal(raw, &li.Item)
+	case raw[0] == '{':
+		var node map[string]json.RawMessage
+		if err := json.Unmarshal(raw, &node); err != nil {
+			return err
+		}
+		entity, err := decodeNode(node, raw)
+		if err != nil {
+			return err
+		}
+		li.Entity = entity
+		return nil
+	default:
+		return fmt.Errorf("ListItem: invalid item: %s", string(raw))
+	}
+}
+
+// ensureDefaults sets default values for ListItem and its embedded entity if they are not already set.
+func (li *ListItem) ensureDefaults() {
+	if li.Type == "" {
+		li.Type = "ListItem"
+	}
+
+	if d, ok := li.Entity.(defaulter); ok {
+		d.ensureDefaults()
+	}
+}
+
+// defaulter is implemented by every schemaorg type able to fill in its own
+// default context and type.
+type defaulter interface {
+	ensureDefaults()
+}
+
+// Schedule represents a Schema.org Schedule object
+// For more details about the meaning of the properties see: https://schema.org/Schedule
+type Schedule struct {
+	Type             string         `json:"@type"`
+	Duration         teseo.Duration `json:"duration,omitempty"`
+	RepeatFrequency  string         `json:"repeatFrequency,omitempty"`
+	RepeatCount      int            `json:"repeatCount,omitempty"`
+	ByDay            StringList     `json:"byDay,omitempty"`
+	StartDate        teseo.DateTime `json:"startDate,omitempty"`
+	EndDate          teseo.DateTime `json:"endDate,omitempty"`
+	StartTime        string         `json:"startTime,omitempty"`
+	EndTime          string         `json:"endTime,omitempty"`
+	ScheduleTimezone string         `json:"scheduleTimezone,omitempty"`
+}
+
+// validate checks the Schedule fields of a recurring event, prefixing the issue paths with the given path.
+func (s *Schedule) validate(prefix string) []teseo.ValidationIssue {
+	var found issues
+
+	if s.StartDate == "" {
+		found.recommended(prefix + ".startDate")
+	}
+	if s.RepeatFrequency == "" {
+		found.recommended(prefix + ".repeatFrequency")
+	} else if !isRepeatFrequency(s.RepeatFrequency) {
+		found.warnf(teseo.RuleInvalidDuration, prefix+".repeatFrequency", "invalid repeatFrequency for %s: %q", prefix, s.RepeatFrequency)
+	}
+	if s.RepeatCount < 0 {
+		found.warnf(teseo.RuleNegativeValue, prefix+".repeatCount", "%s.repeatCount must not be negative, got %d", prefix, s.RepeatCount)
+	}
+	for i, day := range s.ByDay {
+		if !DayOfWeek(day).IsValid() && !isICalDay(day) {
+			found.warnf(teseo.RuleUnknownValue, fmt.Sprintf("%s.byDay[%d]", prefix, i), "unknown %s.byDay[%d] value %q", prefix, i, day)
+		}
+	}
+	for _, t := range []struct{ field, value string }{{"startTime", s.StartTime}, {"endTime", s.EndTime}} {
+		if t.value != "" && !timeOfDayRe.MatchString(t.value) {
+			found.warnf(teseo.RuleInvalidTime, prefix+"."+t.field, "invalid time for %s.%s: %q", prefix, t.field, t.value)
+		}
+	}
+
+	found.add(validateDuration(prefix+".duration", s.Duration)...)
+	found.add(validateDateTime(prefix+".startDate", s.StartDate)...)
+	found.add(validateDateTime(prefix+".endDate", s.EndDate)...)
+	found.add(validateTimeOrder(prefix+".startDate", s.StartDate, prefix+".endDate", s.EndDate)...)
+
+	return found
+}
+
+// isRepeatFrequency reports whether v is an ISO 8601 duration (e.g. "P1W") or
+// one of the iCal frequencies (e.g. "Weekly").
+func isRepeatFrequency(v string) bool {
+	switch strings.ToLower(v) {
+	case "daily", "weekly", "monthly", "yearly":
+		return true
+	}
+	return teseo.Duration(v).IsValid()
+}
+
+// isICalDay reports whether v is an iCal day of the week ("MO" to "SU"),
+// optionally prefixed by an ordinal such as "1MO" or "-1FR".
+func isICalDay(v string) bool {
+	day := strings.TrimLeft(v, "+-0123456789")
+	if day == "" {
+		return false
+	}
+	switch day {
+	case "MO", "TU", "WE", "TH", "FR", "SA", "SU":
+		return true
+	}
+	return false
+}
+
+// ensureDefaults sets default values for Schedule if they are not already set.
+func (s *Schedule) ensureDefaults() {
+	if s.Type == "" {
+		s.Type = "Schedule"
+	}
+}
+
+// unmarshalObjects decodes a single JSON object or an array of objects into a
+// slice of T, as Schema.org properties accept either for repeated values.
+func unmarshalObjects[T any](data []byte) ([]*T, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+		return nil, nil
+	}
+	if trimmed[0] != '[' {
+		item := new(T)
+		if err := json.Unmarshal(trimmed, item); err != nil {
+			return nil, err
+		}
+		return []*T{item}, nil
+	}
+	var items []*T
+	if err := json.Unmarshal(trimmed, &items); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+// isNilValue reports whether v is nil or an interface holding a nil pointer.
+func isNilValue(v any) bool {
+	if v == nil {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	return rv.Kind() == reflect.Ptr && rv.IsNil()
+}
+
+// isNearMiss reports whether name is outside the catalogue but looks like a
+// misspelling of a catalogued name: it differs only by case, or by one edit for
+// short names and two edits otherwise, e.g. "Resturant" for "Restaurant".
+// Other schema.org types, such as "Florist", are not near misses.
+func isNearMiss[T ~string](name T, catalogue map[T]T) bool {
+	if _, ok := catalogue[name]; ok || name == "" {
+		return false
+	}
+	maxEdits := 2
+	if len(name) <= 5 {
+		maxEdits = 1
+	}
+	lower := strings.ToLower(string(name))
+	for known := range catalogue {
+		if editDistance(lower, strings.ToLower(string(known))) <= maxEdits {
+			return true
+		}
+	}
+	return false
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
+
+// fieldPath joins a field name to the path of its parent object, if any.
+func fieldPath(prefix, name string) string {
+	switch {
+	case prefix == "":
+		return name
+	case name == "":
+		return prefix
+	}
+	return prefix + "." + name
 }
@@ -180,6 +180,73 @@ func TestStringList_String(t *testing.T) {
 	}
 }
 
+func TestNumber_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected Number
+		wantErr  bool
+	}{
+		{"null input", `null`, "", false},
+		{"string", `" 29.99 "`, "29.99", false},
+		{"number", `29.99`, "29.99", false},
+		{"integer", `10`, "10", false},
+		{"invalid type", `true`, "", true},
+		{"invalid json", `{`, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Number
+			err := json.Unmarshal([]byte(tt.input), &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.expected {
+				t.Errorf("UnmarshalJSON() = %q, want %q", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestNumber_Float64(t *testing.T) {
+	if got, err := Number("4.5").Float64(); err != nil || got != 4.5 {
+		t.Errorf("Float64() = %v, %v, want 4.5", got, err)
+	}
+	if _, err := Number("free").Float64(); err == nil {
+		t.Error("expected an error for a non-numeric value")
+	}
+}
+
+func TestFloat_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected Float
+		wantErr  bool
+	}{
+		{"null input", `null`, 0, false},
+		{"number", `4.5`, 4.5, false},
+		{"string", `" 4.5 "`, 4.5, false},
+		{"empty string", `""`, 0, false},
+		{"non-numeric string", `"great"`, 0, true},
+		{"invalid type", `true`, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got Float
+			err := json.Unmarshal([]byte(tt.input), &got)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("UnmarshalJSON() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.expected {
+				t.Errorf("UnmarshalJSON() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
 func equalSlices(a, b []string) bool {
 	if a == nil && b == nil {
 		return true
@@ -194,3 +261,19 @@ func equalSlices(a, b []string) bool {
 	}
 	return true
 }
+
+func TestIsNearMiss(t *testing.T) {
+	tests := map[LocalBusinessType]bool{
+		TypeRestaurant: false,
+		"":             false,
+		"Resturant":    true,
+		"restaurant":   true,
+		"Florist":      false,
+		"Winery":       false,
+	}
+	for name, want := range tests {
+		if got := isNearMiss(name, localBusinessParents); got != want {
+			t.Errorf("isNearMiss(%q) = %v, want %v", name, got, want)
+		}
+	}
+}
@@ -1,6 +1,15 @@
 package schemaorg
 
-import "log"
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/indaco/teseo"
+)
 
 // SchemaValidator defines an interface for types that can be validated for schema.org compliance.
 type SchemaValidator interface {
@@ -13,3 +22,132 @@ func LogValidationWarnings(v SchemaValidator) {
 		log.Printf("schema warning: %s", warning)
 	}
 }
+
+// issues collects the validation issues found by the validators of this package.
+// Each check builds its issue with the severity, path and rule it knows about,
+// and Validate returns the messages of the issues.
+type issues []teseo.ValidationIssue
+
+// required adds a missing required field issue for path.
+func (is *issues) required(path string) {
+	is.missing(teseo.SeverityRequired, path, path)
+}
+
+// recommended adds a missing recommended field issue for path.
+func (is *issues) recommended(path string) {
+	is.missing(teseo.SeverityRecommended, path, path)
+}
+
+// missing adds a missing field issue for path, naming the field as given,
+// e.g. "author or publisher" for the path "author".
+func (is *issues) missing(severity teseo.Severity, path, field string) {
+	is.addf(severity, teseo.RuleMissingField, path, "missing %s field: %s", severity, field)
+}
+
+// warnf adds a recommended issue with a formatted message.
+func (is *issues) warnf(rule, path, format string, args ...any) {
+	is.addf(teseo.SeverityRecommended, rule, path, format, args...)
+}
+
+// addf adds an issue with a formatted message.
+func (is *issues) addf(severity teseo.Severity, rule, path, format string, args ...any) {
+	*is = append(*is, teseo.ValidationIssue{Severity: severity, Path: path, Rule: rule, Message: fmt.Sprintf(format, args...)})
+}
+
+// add adds the issues found by another check.
+func (is *issues) add(found ...teseo.ValidationIssue) {
+	*is = append(*is, found...)
+}
+
+// nested adds the issues of a nested value, prefixing their path and message
+// with the path of the value, e.g. "trails[1]: missing required field: itemListElement".
+func (is *issues) nested(prefix string, found []teseo.ValidationIssue) {
+	for _, issue := range found {
+		issue.Path = fieldPath(prefix, issue.Path)
+		issue.Message = prefix + ": " + issue.Message
+		*is = append(*is, issue)
+	}
+}
+
+// issueMessages returns the messages of the issues.
+func issueMessages(found []teseo.ValidationIssue) []string {
+	var messages []string
+	for _, issue := range found {
+		messages = append(messages, issue.Message)
+	}
+	return messages
+}
+
+// formatChecks maps the properties holding URLs, emails, telephone numbers,
+// currency and country codes to the check of their format.
+var formatChecks = map[string]func(path, value string) *teseo.ValidationIssue{
+	"url":                teseo.CheckURL,
+	"sameAs":             teseo.CheckURL,
+	"contentUrl":         teseo.CheckURL,
+	"acquireLicensePage": teseo.CheckURL,
+	"license":            teseo.CheckURL,
+	"logo":               teseo.CheckURL,
+	"image":              teseo.CheckURL,
+	"item":               teseo.CheckURL,
+	"mainEntityOfPage":   teseo.CheckURL,
+	"menu":               teseo.CheckURL,
+	"merchantReturnLink": teseo.CheckURL,
+	"email":              teseo.CheckEmail,
+	"telephone":          teseo.CheckTelephone,
+	"faxNumber":          teseo.CheckTelephone,
+	"priceCurrency":      teseo.CheckCurrency,
+	"currency":           teseo.CheckCurrency,
+	"addressCountry":     teseo.CheckCountry,
+	"applicableCountry":  teseo.CheckCountry,
+}
+
+// validateFormats checks the format of the properties listed in formatChecks,
+// walking v through its JSON-LD encoding so that nested entities are checked
+// too. The top-level properties in skip are not walked, e.g. when validated on their own.
+func validateFormats(v any, skip ...string) []teseo.ValidationIssue {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	root, err := decodeOrdered(dec)
+	if err != nil {
+		return nil
+	}
+	obj, ok := root.(jsonObject)
+	if !ok {
+		return nil
+	}
+
+	var found []teseo.ValidationIssue
+	for _, m := range obj.properties() {
+		if !slices.Contains(skip, m.key) {
+			found = checkFormats(found, m.key, m.key, m.value)
+		}
+	}
+	return found
+}
+
+// checkFormats appends the issues for the value of the property key found at path.
+func checkFormats(found []teseo.ValidationIssue, path, key string, value any) []teseo.ValidationIssue {
+	switch v := value.(type) {
+	case string:
+		if check, ok := formatChecks[key]; ok {
+			if issue := check(path, v); issue != nil {
+				found = append(found, *issue)
+			}
+		}
+	case []any:
+		for i, item := range v {
+			found = checkFormats(found, fmt.Sprintf("%s[%d]", path, i), key, item)
+		}
+	case jsonObject:
+		for _, m := range v {
+			if !strings.HasPrefix(m.key, "@") {
+				found = checkFormats(found, path+"."+m.key, m.key, m.value)
+			}
+		}
+	}
+	return found
+}
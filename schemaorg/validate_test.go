@@ -2,9 +2,17 @@ package schemaorg
 
 import (
 	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
 	"log"
+	"reflect"
+	"slices"
 	"strings"
 	"testing"
+
+	"github.com/indaco/teseo"
 )
 
 // mockValidator is a helper for simulating a SchemaValidator
@@ -67,3 +75,137 @@ func TestLogValidationWarnings(t *testing.T) {
 		})
 	}
 }
+
+func TestValidationIssues(t *testing.T) {
+	tests := []struct {
+		name      string
+		validator teseo.Validator
+		expected  teseo.ValidationIssue
+	}{
+		{"required field", &Product{}, teseo.ValidationIssue{Severity: teseo.SeverityRequired, Path: "name", Rule: teseo.RuleMissingField, Message: "missing required field: name"}},
+		{"recommended field", &Product{Name: "Anvil"}, teseo.ValidationIssue{Severity: teseo.SeverityRecommended, Path: "image", Rule: teseo.RuleMissingField, Message: "missing recommended field: image"}},
+		{"alternative fields", &Product{Name: "Anvil"}, teseo.ValidationIssue{Severity: teseo.SeverityRequired, Path: "offers", Rule: teseo.RuleMissingField, Message: "missing required field: one of offers, review or aggregateRating"}},
+		{"invalid date", &Event{StartDate: "tomorrow"}, teseo.ValidationIssue{Severity: teseo.SeverityRecommended, Path: "startDate", Rule: teseo.RuleInvalidDate, Message: `invalid ISO 8601 date-time for startDate: "tomorrow"`, Value: "tomorrow"}},
+		{"unknown value", &Event{EventStatus: "Maybe"}, teseo.ValidationIssue{Severity: teseo.SeverityRecommended, Path: "eventStatus", Rule: teseo.RuleUnknownValue, Message: `unknown eventStatus value "Maybe"`}},
+		{"unknown subtype", &Event{Type: "MusicEvnt"}, teseo.ValidationIssue{Severity: teseo.SeverityRecommended, Path: "@type", Rule: teseo.RuleUnknownType, Message: `unrecognized Event subtype "MusicEvnt"`}},
+		{"date order", &Article{DatePublished: "2024-02-01", DateModified: "2024-01-01"}, teseo.ValidationIssue{Severity: teseo.SeverityRecommended, Path: "datePublished", Rule: teseo.RuleDateOrder, Message: `dateModified "2024-01-01" is before datePublished "2024-02-01"`}},
+		{"negative value", &Article{WordCount: -1}, teseo.ValidationIssue{Severity: teseo.SeverityRecommended, Path: "wordCount", Rule: teseo.RuleNegativeValue, Message: "wordCount must not be negative, got -1"}},
+		{"logo size", &Organization{Logo: &ImageObject{URL: "https://www.example.com/logo.png", Width: 50}}, teseo.ValidationIssue{Severity: teseo.SeverityRecommended, Path: "logo.width", Rule: teseo.RuleImageSize, Message: "logo.width must be at least 112 pixels, got 50"}},
+		{"nested trail", BreadcrumbTrails{{}, {}}, teseo.ValidationIssue{Severity: teseo.SeverityRequired, Path: "trails[1].itemListElement", Rule: teseo.RuleMissingField, Message: "trails[1]: BreadcrumbList should contain at least one item"}},
+		{"missing trail", BreadcrumbTrails{nil}, teseo.ValidationIssue{Severity: teseo.SeverityRequired, Path: "trails[0]", Rule: teseo.RuleMissingField, Message: "trails[0]: missing BreadcrumbList"}},
+		{"FAQ answer", &FAQPage{MainEntity: []*Question{{Name: "Q1"}}}, teseo.ValidationIssue{Severity: teseo.SeverityRequired, Path: "mainEntity[0].acceptedAnswer", Rule: teseo.RuleMissingField, Message: "Question 1 is missing an accepted answer"}},
+		{"invalid currency", &Product{Name: "Anvil", Offers: Offers{{Price: "10", PriceCurrency: "$"}}}, teseo.ValidationIssue{Severity: teseo.SeverityRecommended, Path: "offers.priceCurrency", Rule: teseo.RuleInvalidCurrency, Message: `invalid ISO 4217 currency code for offers.priceCurrency: "$"`, Value: "$"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := tt.validator.ValidationIssues()
+			if !slices.Contains(issues, tt.expected) {
+				t.Errorf("expected %#v, got %#v", tt.expected, issues)
+			}
+			if !reflect.DeepEqual(tt.validator.Validate(), issueMessages(issues)) {
+				t.Errorf("expected Validate to return the messages of the issues, got %v", tt.validator.Validate())
+			}
+		})
+	}
+}
+
+func TestCheckRequired_GoogleRequiredFields(t *testing.T) {
+	tests := []struct {
+		validator teseo.Validator
+		paths     []string
+	}{
+		{&Article{}, []string{"headline"}},
+		{&Event{}, []string{"name", "startDate", "location"}},
+		{&LocalBusiness{}, []string{"name", "address"}},
+		{&BreadcrumbList{}, []string{"itemListElement"}},
+		{&FAQPage{}, []string{"mainEntity"}},
+		{&ItemList{}, []string{"itemListElement"}},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("%T", tt.validator), func(t *testing.T) {
+			var verr *teseo.ValidationError
+			if err := teseo.CheckRequired(tt.validator); !errors.As(err, &verr) {
+				t.Fatalf("expected *teseo.ValidationError, got %v", err)
+			}
+			var paths []string
+			for _, issue := range verr.Issues {
+				paths = append(paths, issue.Path)
+			}
+			if !reflect.DeepEqual(paths, tt.paths) {
+				t.Errorf("expected required paths %v, got %v", tt.paths, paths)
+			}
+		})
+	}
+}
+
+func TestValidateFormats(t *testing.T) {
+	org := &Organization{
+		Name:          "Example Corp",
+		URL:           "www.example.com",
+		Logo:          &ImageObject{URL: "https://www.example.com/logo.png"},
+		Email:         "info@example.com",
+		ContactPoints: []ContactPoint{{Telephone: "+1-800-555-1212"}, {Telephone: "call us"}},
+		Address:       &PostalAddress{AddressCountry: "USA"},
+		SameAs:        []string{"https://twitter.com/example", "/example"},
+	}
+	expected := []string{
+		`invalid URL for url: "www.example.com"`,
+		`invalid ISO 3166-1 country code for address.addressCountry: "USA"`,
+		`invalid telephone number for contactPoint[1].telephone: "call us"`,
+		`invalid URL for sameAs[1]: "/example"`,
+	}
+	if got := issueMessages(validateFormats(org)); !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected %v, got %v", expected, got)
+	}
+	if got := validateFormats(org, "url", "contactPoint", "address", "sameAs"); got != nil {
+		t.Errorf("expected the skipped properties not to be checked, got %v", got)
+	}
+
+	person := &Person{Name: "Jane Doe", Email: "jane.example.com", Telephone: "+1 555 010 0100"}
+	if got := person.Validate(); !slices.Contains(got, `invalid email for email: "jane.example.com"`) {
+		t.Errorf("expected an invalid email warning, got %v", got)
+	}
+
+	product := &Product{Name: "Anvil", Offers: Offers{{URL: "/anvil", Price: "10", PriceCurrency: "dollars"}}}
+	issues := product.ValidationIssues()
+	var rules []string
+	for _, issue := range issues {
+		if issue.Rule == teseo.RuleInvalidURL || issue.Rule == teseo.RuleInvalidCurrency {
+			rules = append(rules, issue.Path+" "+issue.Rule)
+		}
+	}
+	if expected := []string{"offers.url invalid-url", "offers.priceCurrency invalid-currency"}; !reflect.DeepEqual(rules, expected) {
+		t.Errorf("expected %v, got %v", expected, rules)
+	}
+
+	opts := teseo.URLOptions{BaseURL: "https://www.example.com"}
+	for _, issue := range opts.Issues(product) {
+		if issue.Rule == teseo.RuleInvalidURL {
+			t.Errorf("expected the relative URL to be resolved against the base URL, got %v", issue)
+		}
+	}
+}
+
+func TestToJsonLd_StrictMode(t *testing.T) {
+	product := &Product{Description: "An anvil"}
+	var verr *teseo.ValidationError
+	if err := teseo.Strict(product, product.ToJsonLd()).Render(context.Background(), io.Discard); !errors.As(err, &verr) {
+		t.Fatalf("expected *teseo.ValidationError, got %v", err)
+	}
+	if verr.Type != "*schemaorg.Product" || len(verr.Issues) == 0 || verr.Issues[0].Path != "name" {
+		t.Errorf("unexpected validation error %#v", verr)
+	}
+	if err := teseo.Strict(product, ToMicrodata(product)).Render(context.Background(), io.Discard); !errors.As(err, &verr) {
+		t.Errorf("expected *teseo.ValidationError from the microdata renderer, got %v", err)
+	}
+
+	if err := product.ToJsonLd().Render(context.Background(), io.Discard); err != nil {
+		t.Errorf("expected the component to render without Strict, got %v", err)
+	}
+
+	product.Name = "Executive Anvil"
+	product.Offers = Offers{{Price: "119.99", PriceCurrency: "USD"}}
+	if err := teseo.Strict(product, product.ToJsonLd()).Render(context.Background(), io.Discard); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
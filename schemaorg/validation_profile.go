@@ -0,0 +1,417 @@
+package schemaorg
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/indaco/teseo"
+)
+
+// ValidationProfile checks entities against the properties documented by a
+// consumer of structured data, such as a search engine, instead of the
+// built-in checks of Validate. Profiles are plain values: build your own or
+// extend the ones returned by SchemaOrgProfile, GoogleProfile and BingProfile.
+//
+// Example usage:
+//
+//	profile := schemaorg.GoogleProfile()
+//	issues, err := profile.Validate(product)
+//	for _, issue := range issues {
+//		fmt.Println(issue.Message)
+//	}
+//
+// Expected output:
+//
+//	missing required field: offers.priceCurrency (ineligible for Merchant listings)
+//	missing recommended field: offers.availability (recommended for Merchant listings)
+type ValidationProfile struct {
+	Name  string
+	Rules []ProfileRule
+}
+
+// ProfileRule lists the properties a rich result feature needs on a Schema.org type.
+//
+// A property is a dotted path such as "offers.price"; the last segment may list
+// alternatives separated by "|", e.g. "gtin|mpn|sku". Arrays are checked item by
+// item, and a path is only checked when its parent is set, so list the parent
+// as well when it is needed.
+type ProfileRule struct {
+	Type        string   // Schema.org type, also matching the subtypes decoded into the same struct
+	Feature     string   // rich result feature, e.g. "Product snippets"
+	Required    []string // properties without which the feature is ineligible
+	Recommended []string // properties improving the feature
+}
+
+// Names of the built-in profiles, as accepted by LookupProfile.
+const (
+	ProfileSchemaOrg = "schema.org"
+	ProfileGoogle    = "google"
+	ProfileBing      = "bing"
+)
+
+// LookupProfile returns the built-in profile named name, e.g. to select the
+// profile enforced by a CI job from a flag.
+func LookupProfile(name string) (*ValidationProfile, bool) {
+	switch strings.ToLower(name) {
+	case ProfileSchemaOrg:
+		return SchemaOrgProfile(), true
+	case ProfileGoogle:
+		return GoogleProfile(), true
+	case ProfileBing:
+		return BingProfile(), true
+	}
+	return nil, false
+}
+
+// SchemaOrgProfile checks conformance to the Schema.org vocabulary only.
+// Schema.org has no required properties, so the profile has no rules and
+// reports the format checks of the entities: dates, durations, enumeration
+// values, subtypes, ranges and identifiers.
+func SchemaOrgProfile() *ValidationProfile {
+	return &ValidationProfile{Name: ProfileSchemaOrg}
+}
+
+// GoogleProfile checks the required and recommended properties documented for
+// the Google Search rich results.
+// For more details see: https://developers.google.com/search/docs/appearance/structured-data/search-gallery
+func GoogleProfile() *ValidationProfile {
+	return &ValidationProfile{Name: ProfileGoogle, Rules: []ProfileRule{
+		{
+			Type:        "Article",
+			Feature:     "Article",
+			Recommended: []string{"author", "author.name", "author.url", "dateModified", "datePublished", "headline", "image"},
+		},
+		{
+			Type:     "BreadcrumbList",
+			Feature:  "Breadcrumb",
+			Required: []string{"itemListElement", "itemListElement.name", "itemListElement.position"},
+		},
+		{
+			Type:        "Book",
+			Feature:     "Book actions",
+			Required:    []string{"@id", "name", "url", "author", "workExample", "workExample.@id", "workExample.bookFormat", "workExample.inLanguage", "workExample.isbn", "workExample.potentialAction"},
+			Recommended: []string{"sameAs", "workExample.bookEdition"},
+		},
+		{
+			Type:        "Course",
+			Feature:     "Course list",
+			Required:    []string{"description", "name"},
+			Recommended: []string{"provider", "provider.name"},
+		},
+		{
+			Type:        "Dataset",
+			Feature:     "Dataset",
+			Required:    []string{"description", "name"},
+			Recommended: []string{"creator", "distribution", "includedInDataCatalog", "keywords", "license", "sameAs", "spatialCoverage", "temporalCoverage", "url", "version"},
+		},
+		{
+			Type:        "DiscussionForumPosting",
+			Feature:     "Discussion forum",
+			Required:    []string{"author", "author.name", "datePublished", "text|image|video"},
+			Recommended: []string{"author.url", "comment", "dateModified", "headline", "interactionStatistic", "url"},
+		},
+		{
+			Type:        "Event",
+			Feature:     "Event",
+			Required:    []string{"location", "name", "startDate"},
+			Recommended: []string{"description", "endDate", "eventAttendanceMode", "eventStatus", "image", "offers", "organizer", "performer"},
+		},
+		{
+			Type:     "FAQPage",
+			Feature:  "FAQ",
+			Required: []string{"mainEntity", "mainEntity.name", "mainEntity.acceptedAnswer", "mainEntity.acceptedAnswer.text"},
+		},
+		{
+			Type:        "ImageObject",
+			Feature:     "Image metadata",
+			Required:    []string{"contentUrl", "creator|creditText|copyrightNotice|license"},
+			Recommended: []string{"acquireLicensePage", "copyrightNotice", "creator", "creditText", "license"},
+		},
+		{
+			Type:     "ItemList",
+			Feature:  "Carousel",
+			Required: []string{"itemListElement", "itemListElement.position"},
+		},
+		{
+			Type:        "LocalBusiness",
+			Feature:     "Local business",
+			Required:    []string{"address", "name"},
+			Recommended: []string{"aggregateRating", "geo", "geo.latitude", "geo.longitude", "openingHoursSpecification", "priceRange", "review", "telephone", "url"},
+		},
+		{
+			Type:        "Movie",
+			Feature:     "Movie carousel",
+			Required:    []string{"image", "name"},
+			Recommended: []string{"aggregateRating", "dateCreated", "director", "review"},
+		},
+		{
+			Type:        "Organization",
+			Feature:     "Organization",
+			Recommended: []string{"address", "description", "email", "logo", "name", "sameAs", "telephone", "url"},
+		},
+		{
+			Type:        "Product",
+			Feature:     "Product snippets",
+			Required:    []string{"name", "review|aggregateRating|offers"},
+			Recommended: []string{"aggregateRating", "offers", "review"},
+		},
+		{
+			Type:        "Product",
+			Feature:     "Merchant listings",
+			Required:    []string{"name", "image", "offers", "offers.price", "offers.priceCurrency"},
+			Recommended: []string{"brand.name", "description", "gtin|mpn|sku", "offers.availability", "offers.hasMerchantReturnPolicy", "offers.itemCondition", "offers.shippingDetails"},
+		},
+		{
+			Type:        "ProductGroup",
+			Feature:     "Product variants",
+			Required:    []string{"name", "hasVariant"},
+			Recommended: []string{"productGroupID", "variesBy"},
+		},
+		{
+			Type:        "ProfilePage",
+			Feature:     "Profile page",
+			Required:    []string{"mainEntity", "mainEntity.name"},
+			Recommended: []string{"dateCreated", "dateModified", "mainEntity.alternateName", "mainEntity.description", "mainEntity.image", "mainEntity.sameAs"},
+		},
+		{
+			Type:        "QAPage",
+			Feature:     "Q&A",
+			Required:    []string{"mainEntity", "mainEntity.name", "mainEntity.answerCount", "mainEntity.acceptedAnswer|suggestedAnswer"},
+			Recommended: []string{"mainEntity.author", "mainEntity.datePublished", "mainEntity.text"},
+		},
+		{
+			Type:        "Review",
+			Feature:     "Review snippet",
+			Required:    []string{"author", "itemReviewed", "itemReviewed.name", "reviewRating", "reviewRating.ratingValue"},
+			Recommended: []string{"datePublished", "reviewRating.bestRating", "reviewRating.worstRating"},
+		},
+		{
+			Type:        "AggregateRating",
+			Feature:     "Review snippet",
+			Required:    []string{"itemReviewed", "itemReviewed.name", "ratingCount|reviewCount", "ratingValue"},
+			Recommended: []string{"bestRating", "worstRating"},
+		},
+		{
+			Type:        "WebSite",
+			Feature:     "Site name",
+			Required:    []string{"name", "url"},
+			Recommended: []string{"alternateName"},
+		},
+	}}
+}
+
+// BingProfile checks the properties Bing uses for its rich snippets. Bing
+// documents fewer requirements than Google, so the rules are limited to the
+// properties each snippet cannot do without.
+func BingProfile() *ValidationProfile {
+	return &ValidationProfile{Name: ProfileBing, Rules: []ProfileRule{
+		{
+			Type:        "Article",
+			Feature:     "Article rich snippet",
+			Required:    []string{"headline"},
+			Recommended: []string{"author", "datePublished", "image"},
+		},
+		{
+			Type:        "BreadcrumbList",
+			Feature:     "Breadcrumb",
+			Required:    []string{"itemListElement", "itemListElement.name", "itemListElement.position"},
+			Recommended: []string{"itemListElement.item"},
+		},
+		{
+			Type:        "Event",
+			Feature:     "Event rich snippet",
+			Required:    []string{"location", "name", "startDate"},
+			Recommended: []string{"endDate", "offers", "url"},
+		},
+		{
+			Type:     "FAQPage",
+			Feature:  "FAQ",
+			Required: []string{"mainEntity", "mainEntity.name", "mainEntity.acceptedAnswer", "mainEntity.acceptedAnswer.text"},
+		},
+		{
+			Type:        "LocalBusiness",
+			Feature:     "Local business rich snippet",
+			Required:    []string{"address", "name"},
+			Recommended: []string{"geo", "openingHoursSpecification", "telephone", "url"},
+		},
+		{
+			Type:        "Movie",
+			Feature:     "Movie rich snippet",
+			Required:    []string{"name"},
+			Recommended: []string{"aggregateRating", "dateCreated", "director", "image"},
+		},
+		{
+			Type:        "Organization",
+			Feature:     "Organization",
+			Required:    []string{"name"},
+			Recommended: []string{"logo", "sameAs", "url"},
+		},
+		{
+			Type:        "Product",
+			Feature:     "Product rich snippet",
+			Required:    []string{"name", "offers|aggregateRating|review"},
+			Recommended: []string{"brand.name", "description", "image", "offers.availability", "offers.price", "offers.priceCurrency"},
+		},
+		{
+			Type:     "QAPage",
+			Feature:  "Q&A",
+			Required: []string{"mainEntity", "mainEntity.name", "mainEntity.acceptedAnswer|suggestedAnswer"},
+		},
+		{
+			Type:        "Review",
+			Feature:     "Review rich snippet",
+			Required:    []string{"author", "itemReviewed", "reviewRating"},
+			Recommended: []string{"datePublished", "reviewRating.ratingValue"},
+		},
+		{
+			Type:     "AggregateRating",
+			Feature:  "Review rich snippet",
+			Required: []string{"itemReviewed", "ratingCount|reviewCount", "ratingValue"},
+		},
+		{
+			Type:     "WebSite",
+			Feature:  "Site name",
+			Required: []string{"name", "url"},
+		},
+	}}
+}
+
+// Validate checks v, a schemaorg entity or a list of entities such as
+// BreadcrumbTrails, against the rules matching its type. Missing properties are
+// reported with the affected feature; the format checks of the entity's own
+// Validate are reported as well, its missing field checks being replaced by the rules.
+func (p *ValidationProfile) Validate(v any) ([]teseo.ValidationIssue, error) {
+	root, err := encodeNode(v)
+	if err != nil {
+		return nil, fmt.Errorf("[ValidationProfile.Validate] %w", err)
+	}
+
+	var issues []teseo.ValidationIssue
+	switch node := root.(type) {
+	case jsonObject:
+		issues = p.validateNode(node, "")
+	case []any:
+		for i, item := range node {
+			if obj, ok := item.(jsonObject); ok {
+				issues = append(issues, p.validateNode(obj, fmt.Sprintf("[%d]", i))...)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("[ValidationProfile.Validate] %T is not a Schema.org entity", v)
+	}
+
+	if validator, ok := v.(teseo.Validator); ok {
+		for _, issue := range validator.ValidationIssues() {
+			if issue.Rule != teseo.RuleMissingField {
+				issues = append(issues, issue)
+			}
+		}
+	}
+	return issues, nil
+}
+
+// validateNode checks the rules matching the types of node.
+func (p *ValidationProfile) validateNode(node jsonObject, prefix string) []teseo.ValidationIssue {
+	types, _ := node.typesAndID()
+
+	var issues []teseo.ValidationIssue
+	for _, rule := range p.Rules {
+		if !matchesType(types, rule.Type) {
+			continue
+		}
+		for _, property := range rule.Required {
+			issues = append(issues, missingProperties(node, prefix, property, teseo.SeverityRequired, rule.Feature)...)
+		}
+		for _, property := range rule.Recommended {
+			issues = append(issues, missingProperties(node, prefix, property, teseo.SeverityRecommended, rule.Feature)...)
+		}
+	}
+	return issues
+}
+
+// missingProperties returns an issue for every place under node where property is missing.
+func missingProperties(node jsonObject, prefix, property string, severity teseo.Severity, feature string) []teseo.ValidationIssue {
+	segments := strings.Split(property, ".")
+	alternatives := strings.Split(segments[len(segments)-1], "|")
+
+	var issues []teseo.ValidationIssue
+	for _, parent := range missingParents(node, prefix, segments[:len(segments)-1], alternatives) {
+		field := fieldPath(parent, strings.Join(alternatives, " or "))
+		message := fmt.Sprintf("missing %s field: %s (recommended for %s)", severity, field, feature)
+		if severity == teseo.SeverityRequired {
+			message = fmt.Sprintf("missing %s field: %s (ineligible for %s)", severity, field, feature)
+		}
+		issues = append(issues, teseo.ValidationIssue{
+			Severity: severity,
+			Path:     fieldPath(parent, alternatives[0]),
+			Rule:     teseo.RuleMissingField,
+			Message:  message,
+			Feature:  feature,
+		})
+	}
+	return issues
+}
+
+// missingParents walks the parent segments from node and returns the paths of
+// the objects that have none of the alternatives set. Missing parents are skipped.
+func missingParents(node any, prefix string, parents, alternatives []string) []string {
+	switch n := node.(type) {
+	case []any:
+		var paths []string
+		for i, item := range n {
+			paths = append(paths, missingParents(item, fmt.Sprintf("%s[%d]", prefix, i), parents, alternatives)...)
+		}
+		return paths
+	case jsonObject:
+		if len(parents) == 0 {
+			for _, alt := range alternatives {
+				if value, _ := n.get(alt); !isEmptyJSON(value) {
+					return nil
+				}
+			}
+			return []string{prefix}
+		}
+		child, _ := n.get(parents[0])
+		if isEmptyJSON(child) {
+			return nil
+		}
+		return missingParents(child, fieldPath(prefix, parents[0]), parents[1:], alternatives)
+	}
+	// A scalar where an object is expected, such as a URL, has no nested properties to check.
+	return nil
+}
+
+// isEmptyJSON reports whether a decoded JSON value is null, an empty string, array or object.
+func isEmptyJSON(value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case string:
+		return v == ""
+	case []any:
+		return len(v) == 0
+	case jsonObject:
+		return len(v) == 0
+	}
+	return false
+}
+
+// matchesType reports whether one of the types is typeName or one of its
+// subtypes decoded into the same struct, e.g. "NewsArticle" for "Article".
+func matchesType(types []string, typeName string) bool {
+	for _, t := range types {
+		name := normalizeTypeName(t)
+		if name == typeName {
+			return true
+		}
+		factory, ok := lookupFactory(name)
+		if !ok {
+			continue
+		}
+		if parent, ok := lookupFactory(typeName); ok && reflect.TypeOf(factory()) == reflect.TypeOf(parent()) {
+			return true
+		}
+	}
+	return false
+}
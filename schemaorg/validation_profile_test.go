@@ -0,0 +1,170 @@
+package schemaorg
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/indaco/teseo"
+)
+
+func TestLookupProfile(t *testing.T) {
+	for _, name := range []string{ProfileSchemaOrg, ProfileGoogle, "Bing"} {
+		p, ok := LookupProfile(name)
+		if !ok || p.Name != strings.ToLower(name) {
+			t.Errorf("expected profile %q, got %v", name, p)
+		}
+	}
+	if _, ok := LookupProfile("yandex"); ok {
+		t.Errorf("expected unknown profile")
+	}
+}
+
+func TestBuiltinProfiles_RequiredPropertiesAreFields(t *testing.T) {
+	for _, p := range []*ValidationProfile{GoogleProfile(), BingProfile()} {
+		for _, rule := range p.Rules {
+			factory, ok := lookupFactory(rule.Type)
+			if !ok {
+				t.Errorf("%s: type %s is not registered", p.Name, rule.Type)
+				continue
+			}
+			known := jsonFieldNames(reflect.TypeOf(factory()))
+			for _, property := range rule.Required {
+				first, _, _ := strings.Cut(property, ".")
+				if !slices.ContainsFunc(strings.Split(first, "|"), func(alt string) bool { return known[alt] }) {
+					t.Errorf("%s: %s.%s is not a field", p.Name, rule.Type, first)
+				}
+			}
+		}
+	}
+}
+
+func TestGoogleProfile_Product(t *testing.T) {
+	product := &Product{
+		Name:   "Executive Anvil",
+		Image:  NewImages("https://www.example.com/anvil.jpg"),
+		Brand:  &Brand{Name: "ACME"},
+		SKU:    "0446310786",
+		Offers: Offers{{Price: "119.99", Availability: InStock}},
+	}
+	issues, err := GoogleProfile().Validate(product)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	expected := []teseo.ValidationIssue{
+		{Severity: teseo.SeverityRecommended, Path: "aggregateRating", Rule: teseo.RuleMissingField, Message: "missing recommended field: aggregateRating (recommended for Product snippets)", Feature: "Product snippets"},
+		{Severity: teseo.SeverityRecommended, Path: "review", Rule: teseo.RuleMissingField, Message: "missing recommended field: review (recommended for Product snippets)", Feature: "Product snippets"},
+		{Severity: teseo.SeverityRequired, Path: "offers.priceCurrency", Rule: teseo.RuleMissingField, Message: "missing required field: offers.priceCurrency (ineligible for Merchant listings)", Feature: "Merchant listings"},
+		{Severity: teseo.SeverityRecommended, Path: "description", Rule: teseo.RuleMissingField, Message: "missing recommended field: description (recommended for Merchant listings)", Feature: "Merchant listings"},
+		{Severity: teseo.SeverityRecommended, Path: "offers.hasMerchantReturnPolicy", Rule: teseo.RuleMissingField, Message: "missing recommended field: offers.hasMerchantReturnPolicy (recommended for Merchant listings)", Feature: "Merchant listings"},
+		{Severity: teseo.SeverityRecommended, Path: "offers.itemCondition", Rule: teseo.RuleMissingField, Message: "missing recommended field: offers.itemCondition (recommended for Merchant listings)", Feature: "Merchant listings"},
+		{Severity: teseo.SeverityRecommended, Path: "offers.shippingDetails", Rule: teseo.RuleMissingField, Message: "missing recommended field: offers.shippingDetails (recommended for Merchant listings)", Feature: "Merchant listings"},
+	}
+	if !reflect.DeepEqual(issues, expected) {
+		t.Errorf("expected\n%v\ngot\n%v", expected, issues)
+	}
+}
+
+func TestGoogleProfile_ArraysAndAlternatives(t *testing.T) {
+	faq := &FAQPage{MainEntity: []*Question{
+		{Name: "What is teseo?", AcceptedAnswer: &Answer{Text: "A Go package."}},
+		{Name: "Does it support templ?"},
+	}}
+	issues, err := GoogleProfile().Validate(faq)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(issues) != 1 || issues[0].Path != "mainEntity[1].acceptedAnswer" || issues[0].Feature != "FAQ" {
+		t.Errorf("unexpected issues %v", issues)
+	}
+
+	posting := &DiscussionForumPosting{Author: &Person{Name: "Jane"}, DatePublished: "2024-03-01T08:34:34+02:00", Headline: "Hello", URL: "https://www.example.com/post"}
+	issues, err = GoogleProfile().Validate(posting)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if issues[0].Path != "text" || issues[0].Message != "missing required field: text or image or video (ineligible for Discussion forum)" {
+		t.Errorf("unexpected issue %v", issues[0])
+	}
+}
+
+func TestGoogleProfile_Subtypes(t *testing.T) {
+	article := &Article{Type: "NewsArticle", Headline: "Title", Image: NewImages("https://www.example.com/1x1.jpg"),
+		DatePublished: "2024-01-05T08:00:00+08:00", DateModified: "2024-02-05T09:20:00+08:00",
+		Author: &Person{Name: "Jane Doe", URL: "https://www.example.com/jane"}}
+	issues, err := GoogleProfile().Validate(article)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(issues) != 0 {
+		t.Errorf("expected no issues, got %v", issues)
+	}
+
+	article.Headline = ""
+	if issues, _ = GoogleProfile().Validate(article); len(issues) != 1 || issues[0].Path != "headline" || issues[0].Feature != "Article" {
+		t.Errorf("expected NewsArticle to use the Article rule, got %v", issues)
+	}
+}
+
+func TestGoogleProfile_PersonHasNoRule(t *testing.T) {
+	issues, err := GoogleProfile().Validate(&Person{Name: "Jane Doe"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(issues) != 0 {
+		t.Errorf("expected no issues, got %v", issues)
+	}
+}
+
+func TestSchemaOrgProfile_FormatChecksOnly(t *testing.T) {
+	event := &Event{StartDate: "tomorrow"}
+	issues, err := SchemaOrgProfile().Validate(event)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	expected := []teseo.ValidationIssue{{
+		Severity: teseo.SeverityRecommended,
+		Path:     "startDate",
+		Rule:     teseo.RuleInvalidDate,
+		Message:  `invalid ISO 8601 date-time for startDate: "tomorrow"`,
+		Value:    "tomorrow",
+	}}
+	if !reflect.DeepEqual(issues, expected) {
+		t.Errorf("expected %v, got %v", expected, issues)
+	}
+}
+
+func TestValidationProfile_CustomRuleAndLists(t *testing.T) {
+	things, err := Decode([]byte(`{"@context": "https://schema.org", "@type": "Recipe", "name": "Pancakes"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	profile := &ValidationProfile{Name: "recipes", Rules: []ProfileRule{
+		{Type: "Recipe", Feature: "Recipe", Required: []string{"name", "image"}},
+	}}
+	issues, err := profile.Validate(things[0])
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(issues) != 1 || issues[0].Path != "image" || issues[0].Severity != teseo.SeverityRequired {
+		t.Errorf("unexpected issues %v", issues)
+	}
+
+	trails := NewBreadcrumbTrails(
+		NewBreadcrumbList([]ListItem{{Name: "Books", Item: "https://example.com/books", Position: 1}}),
+		NewBreadcrumbList([]ListItem{{Item: "https://example.com/books", Position: 1}}),
+	)
+	issues, err = GoogleProfile().Validate(trails)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(issues) == 0 || issues[0].Path != "[1].itemListElement[0].name" {
+		t.Errorf("unexpected issues %v", issues)
+	}
+
+	if _, err := profile.Validate(42); err == nil {
+		t.Errorf("expected error for a value that is not an entity")
+	}
+}
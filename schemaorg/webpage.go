@@ -1,6 +1,7 @@
 package schemaorg
 
 import (
+	"encoding/json"
 	"fmt"
 	"html/template"
 
@@ -65,20 +66,21 @@ import (
 //		"keywords": "example, webpage, demo"
 //	}
 type WebPage struct {
-	Context       string `json:"@context"`
-	Type          string `json:"@type"`
-	URL           string `json:"url,omitempty"`
-	Name          string `json:"name,omitempty"`
-	Headline      string `json:"headline,omitempty"`
-	Description   string `json:"description,omitempty"`
-	About         string `json:"about,omitempty"`
-	Keywords      string `json:"keywords,omitempty"`
-	InLanguage    string `json:"inLanguage,omitempty"`
-	IsPartOf      string `json:"isPartOf,omitempty"`
-	LastReviewed  string `json:"lastReviewed,omitempty"`
-	PrimaryImage  string `json:"primaryImageOfPage,omitempty"`
-	DatePublished string `json:"datePublished,omitempty"`
-	DateModified  string `json:"dateModified,omitempty"`
+	Context       string         `json:"@context"`
+	Type          string         `json:"@type"`
+	URL           string         `json:"url,omitempty"`
+	Name          string         `json:"name,omitempty"`
+	Headline      string         `json:"headline,omitempty"`
+	Description   string         `json:"description,omitempty"`
+	About         string         `json:"about,omitempty"`
+	Keywords      string         `json:"keywords,omitempty"`
+	InLanguage    string         `json:"inLanguage,omitempty"`
+	IsPartOf      string         `json:"isPartOf,omitempty"`
+	LastReviewed  string         `json:"lastReviewed,omitempty"`
+	PrimaryImage  string         `json:"primaryImageOfPage,omitempty"`
+	DatePublished teseo.DateTime `json:"datePublished,omitempty"`
+	DateModified  teseo.DateTime `json:"dateModified,omitempty"`
+	Extra         Extra          `json:"-"`
 }
 
 func NewWebPage(url string, name string, headline string, description string, about string, keywords string, inLanguage string, isPartOf string, lastReviewed string, primaryImage string, datePublished string, dateModified string) *WebPage {
@@ -93,30 +95,39 @@ func NewWebPage(url string, name string, headline string, description string, ab
 		IsPartOf:      isPartOf,
 		LastReviewed:  lastReviewed,
 		PrimaryImage:  primaryImage,
-		DatePublished: datePublished,
-		DateModified:  dateModified,
+		DatePublished: teseo.DateTime(datePublished),
+		DateModified:  teseo.DateTime(dateModified),
 	}
 	webpage.ensureDefaults()
 	return webpage
 }
 
 func (wp *WebPage) Validate() []string {
-	var warnings []string
+	return issueMessages(wp.ValidationIssues())
+}
+
+// ValidationIssues returns the issues found by Validate on the WebPage as structured values.
+func (wp *WebPage) ValidationIssues() []teseo.ValidationIssue {
+	var found issues
 
 	if wp.URL == "" {
-		warnings = append(warnings, "missing recommended field: url")
+		found.recommended("url")
 	}
 	if wp.Name == "" {
-		warnings = append(warnings, "missing recommended field: name")
+		found.recommended("name")
 	}
 	if wp.Headline == "" {
-		warnings = append(warnings, "missing recommended field: headline")
+		found.recommended("headline")
 	}
 	if wp.Description == "" {
-		warnings = append(warnings, "missing recommended field: description")
+		found.recommended("description")
 	}
+	found.add(validateDateTime("datePublished", wp.DatePublished)...)
+	found.add(validateDateTime("dateModified", wp.DateModified)...)
+	found.add(validateTimeOrder("datePublished", wp.DatePublished, "dateModified", wp.DateModified)...)
 
-	return warnings
+	found.add(validateFormats(wp)...)
+	return found
 }
 
 // ToJsonLd converts the WebPage struct to a JSON-LD `templ.Component`.
@@ -131,6 +142,26 @@ func (wp *WebPage) ToGoHTMLJsonLd() (template.HTML, error) {
 	return teseo.RenderToHTML(wp.ToJsonLd())
 }
 
+// MarshalJSON encodes a WebPage, merging the Extra properties into the JSON-LD object.
+func (wp WebPage) MarshalJSON() ([]byte, error) {
+	type alias WebPage
+	return marshalWithExtra(alias(wp), wp.Extra)
+}
+
+// UnmarshalJSON decodes a WebPage, capturing properties without a dedicated field into Extra.
+func (wp *WebPage) UnmarshalJSON(data []byte) error {
+	type alias WebPage
+	if err := json.Unmarshal(data, (*alias)(wp)); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, (*alias)(wp))
+	if err != nil {
+		return err
+	}
+	wp.Extra = extra
+	return nil
+}
+
 func (wp *WebPage) ensureDefaults() {
 	if wp.Context == "" {
 		wp.Context = "https://schema.org"
@@ -1,6 +1,7 @@
 package schemaorg
 
 import (
+	"encoding/json"
 	"fmt"
 	"html/template"
 
@@ -54,8 +55,9 @@ import (
 
 // Target represents the target of an action in Schema.org
 type Target struct {
-	Type        string `json:"@type"`
-	URLTemplate string `json:"urlTemplate"`
+	Type           string     `json:"@type"`
+	URLTemplate    string     `json:"urlTemplate"`
+	ActionPlatform StringList `json:"actionPlatform,omitempty"`
 }
 
 // Action represents a Schema.org Action object
@@ -74,6 +76,7 @@ type WebSite struct {
 	AlternateName   string  `json:"alternateName,omitempty"`
 	Description     string  `json:"description,omitempty"`
 	PotentialAction *Action `json:"potentialAction,omitempty"`
+	Extra           Extra   `json:"-"`
 }
 
 func NewWebSite(url string, name string, alternateName string, description string, potentialAction *Action) *WebSite {
@@ -89,27 +92,33 @@ func NewWebSite(url string, name string, alternateName string, description strin
 }
 
 func (ws *WebSite) Validate() []string {
-	var warnings []string
+	return issueMessages(ws.ValidationIssues())
+}
+
+// ValidationIssues returns the issues found by Validate on the WebSite as structured values.
+func (ws *WebSite) ValidationIssues() []teseo.ValidationIssue {
+	var found issues
 
 	if ws.URL == "" {
-		warnings = append(warnings, "missing recommended field: url")
+		found.recommended("url")
 	}
 
 	if ws.Name == "" {
-		warnings = append(warnings, "missing recommended field: name")
+		found.recommended("name")
 	}
 
 	if ws.Description == "" {
-		warnings = append(warnings, "missing recommended field: description")
+		found.recommended("description")
 	}
 
 	if ws.PotentialAction != nil {
 		if ws.PotentialAction.Target == nil || ws.PotentialAction.Target.URLTemplate == "" {
-			warnings = append(warnings, "potentialAction.target.urlTemplate is recommended when potentialAction is set")
+			found.warnf(teseo.RuleMissingField, "potentialAction.target.urlTemplate", "potentialAction.target.urlTemplate is recommended when potentialAction is set")
 		}
 	}
 
-	return warnings
+	found.add(validateFormats(ws)...)
+	return found
 }
 
 // ToJsonLd converts the WebSite struct to a JSON-LD `templ.Component`.
@@ -124,6 +133,26 @@ func (ws *WebSite) ToGoHTMLJsonLd() (template.HTML, error) {
 	return teseo.RenderToHTML(ws.ToJsonLd())
 }
 
+// MarshalJSON encodes a WebSite, merging the Extra properties into the JSON-LD object.
+func (ws WebSite) MarshalJSON() ([]byte, error) {
+	type alias WebSite
+	return marshalWithExtra(alias(ws), ws.Extra)
+}
+
+// UnmarshalJSON decodes a WebSite, capturing properties without a dedicated field into Extra.
+func (ws *WebSite) UnmarshalJSON(data []byte) error {
+	type alias WebSite
+	if err := json.Unmarshal(data, (*alias)(ws)); err != nil {
+		return err
+	}
+	extra, err := unmarshalExtra(data, (*alias)(ws))
+	if err != nil {
+		return err
+	}
+	ws.Extra = extra
+	return nil
+}
+
 func (ws *WebSite) ensureDefaults() {
 	if ws.Context == "" {
 		ws.Context = "https://schema.org"
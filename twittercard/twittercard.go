@@ -2,6 +2,7 @@ package twittercard
 
 import (
 	"context"
+	"fmt"
 	"html/template"
 	"io"
 
@@ -293,6 +294,65 @@ func (tc *TwitterCard) ToGoHTMLMetaTags() (template.HTML, error) {
 	return teseo.RenderToHTML(tc.ToMetaTags())
 }
 
+// Validate returns the messages of the issues found by ValidationIssues.
+func (tc *TwitterCard) Validate() []string {
+	var warnings []string
+	for _, issue := range tc.ValidationIssues() {
+		warnings = append(warnings, issue.Message)
+	}
+	return warnings
+}
+
+// ValidationIssues checks the markup required by the card type: a title for
+// every card but app, an app ID and a site for app cards, a player URL, an
+// image and a site for player cards. A missing card type defaults to summary.
+// The image and player must be absolute URLs.
+// For more details see: https://developer.x.com/en/docs/x-for-websites/cards/overview/markup
+func (tc *TwitterCard) ValidationIssues() []teseo.ValidationIssue {
+	var issues []teseo.ValidationIssue
+	check := func(severity teseo.Severity, name, value string) {
+		if value == "" {
+			issues = append(issues, teseo.ValidationIssue{
+				Severity: severity,
+				Path:     name,
+				Rule:     teseo.RuleMissingField,
+				Message:  "missing " + string(severity) + " field: " + name,
+			})
+		}
+	}
+
+	switch tc.Card {
+	case "", CardSummary, CardSummaryLargeImage:
+		check(teseo.SeverityRequired, "twitter:title", tc.Title)
+		check(teseo.SeverityRecommended, "twitter:image", tc.Image)
+		check(teseo.SeverityRecommended, "twitter:site", tc.Site)
+	case CardApp:
+		check(teseo.SeverityRequired, "twitter:site", tc.Site)
+		check(teseo.SeverityRequired, "twitter:app:id:iphone", tc.AppID)
+	case CardPlayer:
+		check(teseo.SeverityRequired, "twitter:title", tc.Title)
+		check(teseo.SeverityRequired, "twitter:site", tc.Site)
+		check(teseo.SeverityRequired, "twitter:player", tc.PlayerURL)
+		check(teseo.SeverityRequired, "twitter:image", tc.Image)
+	default:
+		issues = append(issues, teseo.ValidationIssue{
+			Severity: teseo.SeverityRequired,
+			Path:     "twitter:card",
+			Rule:     teseo.RuleUnknownValue,
+			Message:  fmt.Sprintf("unknown twitter:card value %q", tc.Card),
+		})
+	}
+	check(teseo.SeverityRecommended, "twitter:description", tc.Description)
+
+	for _, tag := range []metaTag{{"twitter:image", tc.Image}, {"twitter:player", tc.PlayerURL}} {
+		if issue := teseo.CheckURL(tag.name, tag.content); issue != nil {
+			issues = append(issues, *issue)
+		}
+	}
+
+	return issues
+}
+
 // metaTag represents a single Twitter Card meta tag with a name and content.
 // Used internally to collect metadata before rendering as HTML <meta> elements.
 type metaTag struct {
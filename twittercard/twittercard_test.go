@@ -3,10 +3,14 @@ package twittercard
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"reflect"
 	"strings"
 	"testing"
+
+	"github.com/indaco/teseo"
 )
 
 func TestNewCard(t *testing.T) {
@@ -154,3 +158,73 @@ func TestToMetaTags_WriteMetaTagError(t *testing.T) {
 		t.Errorf("expected simulated failure, got: %v", err)
 	}
 }
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		card     *TwitterCard
+		expected []string
+	}{
+		{
+			name: "valid summary card",
+			card: NewSummaryCard("Title", "Desc", "https://www.example.com/image.jpg", "@site", "@creator"),
+		},
+		{
+			name: "summary card without title",
+			card: &TwitterCard{Description: "Desc"},
+			expected: []string{
+				"missing required field: twitter:title",
+				"missing recommended field: twitter:image",
+				"missing recommended field: twitter:site",
+			},
+		},
+		{
+			name: "app card without app ID",
+			card: NewAppCard("App", "Desc", "", "@site", ""),
+			expected: []string{
+				"missing required field: twitter:app:id:iphone",
+			},
+		},
+		{
+			name: "player card without player",
+			card: NewPlayerCard("Player", "", "https://www.example.com/image.jpg", "@site", ""),
+			expected: []string{
+				"missing required field: twitter:player",
+				"missing recommended field: twitter:description",
+			},
+		},
+		{
+			name: "player card with relative URLs",
+			card: NewPlayerCard("Player", "Desc", "/image.jpg", "@site", "player.html"),
+			expected: []string{
+				`invalid URL for twitter:image: "/image.jpg"`,
+				`invalid URL for twitter:player: "player.html"`,
+			},
+		},
+		{
+			name: "unknown card type",
+			card: &TwitterCard{Card: "gallery", Description: "Desc"},
+			expected: []string{
+				`unknown twitter:card value "gallery"`,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.card.Validate(); !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("expected %v, got %v", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestToMetaTags_StrictMode(t *testing.T) {
+	card := NewPlayerCard("Player", "Desc", "https://www.example.com/image.jpg", "@site", "")
+	var verr *teseo.ValidationError
+	if err := teseo.Strict(card, card.ToMetaTags()).Render(context.Background(), io.Discard); !errors.As(err, &verr) {
+		t.Fatalf("expected *teseo.ValidationError, got %v", err)
+	}
+	if len(verr.Issues) != 1 || verr.Issues[0].Path != "twitter:player" || verr.Issues[0].Rule != teseo.RuleMissingField {
+		t.Errorf("unexpected issues %v", verr.Issues)
+	}
+}
@@ -0,0 +1,114 @@
+package teseo
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/a-h/templ"
+)
+
+// Severity tells how a validation issue affects the structured data.
+type Severity string
+
+const (
+	// SeverityRequired marks a missing required field: the data is not eligible for rich results.
+	SeverityRequired Severity = "required"
+	// SeverityRecommended marks a missing recommended field or a value that should be fixed.
+	SeverityRecommended Severity = "recommended"
+)
+
+// Rule codes identifying the check behind a ValidationIssue.
+const (
+	RuleMissingField     = "missing-field"
+	RuleInvalidDate      = "invalid-date"
+	RuleInvalidDuration  = "invalid-duration"
+	RuleInvalidTime      = "invalid-time"
+	RuleInvalidFormat    = "invalid-format"
+	RuleUnknownValue     = "unknown-value"
+	RuleUnknownType      = "unknown-type"
+	RuleDateOrder        = "date-order"
+	RuleNegativeValue    = "negative-value"
+	RuleOutOfRange       = "out-of-range"
+	RuleImageSize        = "image-size"
+	RuleImageAspectRatio = "image-aspect-ratio"
+	RuleConstraint       = "constraint"
+	RuleInvalidURL       = "invalid-url"
+	RuleInvalidEmail     = "invalid-email"
+	RuleInvalidTelephone = "invalid-telephone"
+	RuleInvalidCurrency  = "invalid-currency"
+	RuleInvalidCountry   = "invalid-country"
+)
+
+// ValidationIssue is a single problem found while validating structured data.
+type ValidationIssue struct {
+	Severity Severity // required or recommended
+	Path     string   // path of the field, e.g. "offers.price" or "og:title"
+	Rule     string   // code of the check, e.g. RuleMissingField
+	Message  string   // human readable description, as returned by Validate
+	Value    string   // invalid value reported by the Check format functions, e.g. "/logo.png"
+	Feature  string   // rich result feature affected, when validated against a profile
+}
+
+// String returns the message of the issue.
+func (vi ValidationIssue) String() string {
+	return vi.Message
+}
+
+// Validator is implemented by the schemaorg, opengraph and twittercard types.
+type Validator interface {
+	// Validate returns the messages of the validation issues.
+	Validate() []string
+	// ValidationIssues returns the structured validation issues.
+	ValidationIssues() []ValidationIssue
+}
+
+// ValidationError is returned by CheckRequired and by the components wrapped
+// with Strict when a value misses required fields.
+type ValidationError struct {
+	Type   string            // Go type of the value, e.g. "*schemaorg.Product"
+	Issues []ValidationIssue // issues with SeverityRequired
+}
+
+// Error returns the messages of the issues prefixed by the value type.
+func (e *ValidationError) Error() string {
+	messages := make([]string, len(e.Issues))
+	for i, issue := range e.Issues {
+		messages[i] = issue.Message
+	}
+	return fmt.Sprintf("[%s] %s", e.Type, strings.Join(messages, "; "))
+}
+
+// RequiredIssues returns the issues with SeverityRequired.
+func RequiredIssues(issues []ValidationIssue) []ValidationIssue {
+	var required []ValidationIssue
+	for _, issue := range issues {
+		if issue.Severity == SeverityRequired {
+			required = append(required, issue)
+		}
+	}
+	return required
+}
+
+// CheckRequired returns a *ValidationError when v misses required fields, nil otherwise.
+func CheckRequired(v Validator) error {
+	if required := RequiredIssues(v.ValidationIssues()); len(required) > 0 {
+		return &ValidationError{Type: fmt.Sprintf("%T", v), Issues: required}
+	}
+	return nil
+}
+
+// Strict wraps the component rendering v so that it fails with a
+// *ValidationError, rendering nothing, when v misses required fields, instead
+// of producing structured data that search engines ignore:
+//
+//	html, err := teseo.RenderToHTML(teseo.Strict(product, product.ToJsonLd()))
+func Strict(v Validator, c templ.Component) templ.Component {
+	return templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		if err := CheckRequired(v); err != nil {
+			return err
+		}
+		return c.Render(ctx, w)
+	})
+}
@@ -0,0 +1,93 @@
+package teseo
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/a-h/templ"
+)
+
+type mockValidator struct {
+	issues []ValidationIssue
+}
+
+func (m *mockValidator) Validate() []string {
+	var warnings []string
+	for _, issue := range m.issues {
+		warnings = append(warnings, issue.Message)
+	}
+	return warnings
+}
+
+func (m *mockValidator) ValidationIssues() []ValidationIssue {
+	return m.issues
+}
+
+func TestRequiredIssues(t *testing.T) {
+	name := ValidationIssue{Severity: SeverityRequired, Path: "name", Rule: RuleMissingField, Message: "missing required field: name"}
+	image := ValidationIssue{Severity: SeverityRecommended, Path: "image", Rule: RuleMissingField, Message: "missing recommended field: image"}
+
+	if got := RequiredIssues([]ValidationIssue{name, image}); !reflect.DeepEqual(got, []ValidationIssue{name}) {
+		t.Errorf("expected only the required issue, got %v", got)
+	}
+	if got := RequiredIssues([]ValidationIssue{image}); got != nil {
+		t.Errorf("expected no required issues, got %v", got)
+	}
+}
+
+func TestCheckRequired(t *testing.T) {
+	v := &mockValidator{issues: []ValidationIssue{
+		{Severity: SeverityRequired, Path: "name", Rule: RuleMissingField, Message: "missing required field: name"},
+		{Severity: SeverityRecommended, Path: "image", Rule: RuleMissingField, Message: "missing recommended field: image"},
+		{Severity: SeverityRequired, Path: "offers.price", Rule: RuleMissingField, Message: "missing required field: offers.price"},
+	}}
+
+	err := CheckRequired(v)
+	var verr *ValidationError
+	if !errors.As(err, &verr) {
+		t.Fatalf("expected *ValidationError, got %v", err)
+	}
+	if len(verr.Issues) != 2 {
+		t.Errorf("expected 2 required issues, got %v", verr.Issues)
+	}
+	expected := "[*teseo.mockValidator] missing required field: name; missing required field: offers.price"
+	if err.Error() != expected {
+		t.Errorf("expected %q, got %q", expected, err.Error())
+	}
+
+	if err := CheckRequired(&mockValidator{}); err != nil {
+		t.Errorf("expected nil error, got %v", err)
+	}
+}
+
+func TestStrict(t *testing.T) {
+	render := templ.ComponentFunc(func(ctx context.Context, w io.Writer) error {
+		_, err := io.WriteString(w, "rendered")
+		return err
+	})
+
+	var sb strings.Builder
+	if err := Strict(&mockValidator{}, render).Render(context.Background(), &sb); err != nil || sb.String() != "rendered" {
+		t.Errorf("expected the component to render without required issues, got %q, %v", sb.String(), err)
+	}
+
+	v := &mockValidator{issues: []ValidationIssue{
+		{Severity: SeverityRequired, Path: "name", Rule: RuleMissingField, Message: "missing required field: name"},
+	}}
+	sb.Reset()
+	var verr *ValidationError
+	if err := Strict(v, render).Render(context.Background(), &sb); !errors.As(err, &verr) {
+		t.Errorf("expected *ValidationError, got %v", err)
+	}
+	if sb.Len() != 0 {
+		t.Errorf("expected nothing rendered, got %q", sb.String())
+	}
+
+	if err := render.Render(context.Background(), &sb); err != nil || sb.String() != "rendered" {
+		t.Errorf("expected the unwrapped component to render, got %q, %v", sb.String(), err)
+	}
+}